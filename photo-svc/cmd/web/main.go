@@ -5,6 +5,7 @@ import (
 	"be-yourmoments/photo-svc/internal/config"
 	grpcHandler "be-yourmoments/photo-svc/internal/delivery/grpc"
 	"be-yourmoments/photo-svc/internal/delivery/http"
+	"be-yourmoments/photo-svc/internal/delivery/http/middleware"
 	discovery "be-yourmoments/photo-svc/internal/helper"
 	"os"
 	"os/signal"
@@ -105,6 +106,11 @@ func webServer() error {
 		logs.Error(err)
 	}
 
+	userAdapter, err := adapter.NewUserAdapter(ctx, registry)
+	if err != nil {
+		logs.Error(err)
+	}
+
 	logs.Log(fmt.Sprintf("Succsess connected http service at port: %v", serverConfig.HTTP))
 
 	uploadAdapter := adapter.NewUploadAdapter(minioConfig)
@@ -113,12 +119,32 @@ func webServer() error {
 	photoDetailRepo := repository.NewPhotoDetailRepository()
 	facecamRepo := repository.NewFacecamRepository()
 	userSimilarRepo := repository.NewUserSimilarRepository()
+	userSettingRepo := repository.NewUserSettingRepository()
+	eventRepo := repository.NewEventRepository()
 
-	photoUsecase := usecase.NewPhotoUsecase(dbConfig, photoRepo, photoDetailRepo, userSimilarRepo, aiAdapter, uploadAdapter)
+	photoUsecase := usecase.NewPhotoUsecase(dbConfig, photoRepo, photoDetailRepo, userSimilarRepo, eventRepo, aiAdapter, uploadAdapter,
+		serverConfig.ReservationTimeout)
 	faceCamUseCase := usecase.NewFacecamUseCase(dbConfig, facecamRepo, userSimilarRepo, aiAdapter, uploadAdapter)
-	userSimilarPhotoUsecase := usecase.NewUserSimilarUsecase(dbConfig, photoRepo, photoDetailRepo, facecamRepo, userSimilarRepo)
+	userSimilarPhotoUsecase := usecase.NewUserSimilarUsecase(dbConfig, photoRepo, photoDetailRepo, facecamRepo, userSimilarRepo,
+		userSettingRepo, uploadAdapter)
+	eventUsecase := usecase.NewEventUsecase(dbConfig, eventRepo, uploadAdapter)
+
+	authMiddleware := middleware.NewUserAuth(userAdapter)
+	adminMiddleware := middleware.RequireAdmin(serverConfig.AdminUserIds)
 
-	photoController := http.NewPhotoController(photoUsecase)
+	photoController := http.NewPhotoController(photoUsecase, authMiddleware, adminMiddleware)
+	cartController := http.NewCartController(userSimilarPhotoUsecase, authMiddleware)
+	reservationController := http.NewReservationController(photoUsecase, authMiddleware)
+	matchedPhotoController := http.NewMatchedPhotoController(userSimilarPhotoUsecase, authMiddleware)
+	eventController := http.NewEventController(eventUsecase, authMiddleware)
+	creatorController := http.NewCreatorController(photoUsecase, authMiddleware)
+
+	go func() {
+		for {
+			time.Sleep(time.Minute)
+			photoUsecase.ReleaseExpiredReservations(ctx)
+		}
+	}()
 
 	go func() {
 		grpcServer := grpc.NewServer()
@@ -139,6 +165,11 @@ func webServer() error {
 	}()
 
 	photoController.Route(app)
+	cartController.Route(app)
+	reservationController.Route(app)
+	matchedPhotoController.Route(app)
+	eventController.Route(app)
+	creatorController.Route(app)
 	logs.Log(fmt.Sprintf("Succsess connected http service at port: %v", serverConfig.HTTP))
 
 	err = app.Listen(serverConfig.HTTP)
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- matches of other users are moved here when a photo is sold and moved back on refund
+CREATE TABLE IF NOT EXISTS sold_user_similar_photos (
+    photo_id CHAR(26) NOT NULL,
+    user_id CHAR(26) NOT NULL,
+    PRIMARY KEY (photo_id, user_id),
+    similarity similarity_level NOT NULL,
+    is_wishlist boolean DEFAULT false,
+    is_resend boolean DEFAULT false,
+    is_cart boolean DEFAULT false,
+    is_favorite boolean DEFAULT false,
+    created_at TIMESTAMPTZ not null,
+    updated_at TIMESTAMPTZ not null,
+    sold_at TIMESTAMPTZ not null,
+    FOREIGN KEY(photo_id) REFERENCES photos(id)
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS sold_user_similar_photos;
+
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE photo_status AS ENUM ('AVAILABLE', 'RESERVED', 'OWNED');
+
+ALTER TABLE photos
+    ADD COLUMN IF NOT EXISTS status photo_status NOT NULL DEFAULT 'AVAILABLE',
+    ADD COLUMN IF NOT EXISTS reserved_by_user_id CHAR(26),
+    ADD COLUMN IF NOT EXISTS reserved_until TIMESTAMPTZ;
+
+UPDATE photos SET status = 'OWNED' WHERE owned_by_user_id IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_photos_reserved_until ON photos(reserved_until) WHERE status = 'RESERVED';
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_photos_reserved_until;
+
+ALTER TABLE photos
+    DROP COLUMN IF EXISTS status,
+    DROP COLUMN IF EXISTS reserved_by_user_id,
+    DROP COLUMN IF EXISTS reserved_until;
+
+DROP TYPE photo_status;
+
+-- +goose StatementEnd
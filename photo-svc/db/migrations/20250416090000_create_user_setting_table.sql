@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- copy of the matching preferences users set in user-svc, pushed here whenever they change
+CREATE TABLE IF NOT EXISTS user_settings (
+    user_id CHAR(26) PRIMARY KEY NOT NULL,
+    similarity similarity_level NOT NULL DEFAULT '3',
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_settings;
+
+-- +goose StatementEnd
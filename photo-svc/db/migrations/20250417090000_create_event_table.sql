@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS events (
+    id CHAR(26) PRIMARY KEY NOT NULL,
+    creator_id CHAR(26) NOT NULL,
+    name VARCHAR(150) NOT NULL,
+    description TEXT,
+    location VARCHAR(255),
+    event_date DATE NOT NULL,
+    cover_url TEXT,
+    default_price INT NOT NULL DEFAULT 0,
+    default_price_str VARCHAR(100) NOT NULL DEFAULT '0',
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_events_event_date ON events(event_date DESC);
+
+ALTER TABLE photos ADD COLUMN IF NOT EXISTS event_id CHAR(26) REFERENCES events(id);
+
+CREATE INDEX IF NOT EXISTS idx_photos_event_id ON photos(event_id) WHERE event_id IS NOT NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_photos_event_id;
+
+ALTER TABLE photos DROP COLUMN IF EXISTS event_id;
+
+DROP TABLE IF EXISTS events;
+
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+ALTER TYPE your_moments_type ADD VALUE IF NOT EXISTS 'THUMBNAIL';
+
+ALTER TYPE your_moments_type ADD VALUE IF NOT EXISTS 'PREVIEW';
+
+-- +goose Down
+-- enum values can not be dropped, THUMBNAIL and PREVIEW are left in place
+SELECT 1;
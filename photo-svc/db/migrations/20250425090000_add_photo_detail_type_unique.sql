@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- retried compressions used to register a rendition again, keep the newest row of every type
+DELETE FROM photo_details older USING photo_details newer
+WHERE older.photo_id = newer.photo_id
+  AND older.your_moments_type = newer.your_moments_type
+  AND (older.created_at, older.id) < (newer.created_at, newer.id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_photo_details_photo_id_your_moments_type
+    ON photo_details(photo_id, your_moments_type);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_photo_details_photo_id_your_moments_type;
+
+-- +goose StatementEnd
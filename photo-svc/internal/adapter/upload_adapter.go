@@ -16,6 +16,8 @@ import (
 type UploadAdapter interface {
 	UploadFile(ctx context.Context, file *multipart.FileHeader, uploadFile multipart.File, path string) (*model.MinioFileResponse, error)
 	DeleteFile(ctx context.Context, fileName string) (bool, error)
+	// PresignFile signs a download url for a stored file that stays valid for expiry.
+	PresignFile(ctx context.Context, fileKey string, expiry time.Duration) (string, error)
 }
 
 type uploadAdapter struct {
@@ -74,3 +76,14 @@ func (a *uploadAdapter) DeleteFile(ctx context.Context, fileName string) (bool,
 
 	return true, nil
 }
+
+func (a *uploadAdapter) PresignFile(ctx context.Context, fileKey string, expiry time.Duration) (string, error) {
+	fileURL, err := a.minio.MinioClient.PresignedGetObject(ctx, a.minio.GetBucketName(), fileKey, expiry, nil)
+	if err != nil {
+		a.minio.Logs.Error("failed to generate presigned URL:" + err.Error())
+
+		return "", fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	return fileURL.String(), nil
+}
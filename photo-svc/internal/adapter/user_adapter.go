@@ -0,0 +1,51 @@
+package adapter
+
+import (
+	discovery "be-yourmoments/photo-svc/internal/helper"
+	"be-yourmoments/photo-svc/internal/model"
+	"be-yourmoments/photo-svc/internal/pb"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type UserAdapter interface {
+	// VerifyToken checks an access token issued by user-svc, including whether the user signed out.
+	VerifyToken(ctx context.Context, token string) (*model.AuthResponse, error)
+}
+
+type userAdapter struct {
+	client pb.UserServiceClient
+}
+
+func NewUserAdapter(ctx context.Context, registry discovery.Registry) (UserAdapter, error) {
+	conn, err := discovery.ServiceConnection(ctx, "user-svc-grpc", registry)
+	if err != nil {
+		return nil, err
+	}
+
+	client := pb.NewUserServiceClient(conn)
+
+	return &userAdapter{
+		client: client,
+	}, nil
+}
+
+func (a *userAdapter) VerifyToken(ctx context.Context, token string) (*model.AuthResponse, error) {
+	res, err := a.client.VerifyToken(ctx, &pb.VerifyTokenRequest{Token: token})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Status >= 400 || res.Error != "" {
+		return nil, fiber.NewError(int(res.Status), res.Error)
+	}
+
+	return &model.AuthResponse{
+		UserId:    res.GetUserId(),
+		Username:  res.GetUsername(),
+		Email:     res.GetEmail(),
+		Token:     token,
+		ExpiresAt: res.GetExpiresAt().AsTime(),
+	}, nil
+}
@@ -4,6 +4,8 @@ import (
 	"be-yourmoments/photo-svc/internal/helper/utils"
 	"fmt"
 	"log"
+	"strings"
+	"time"
 )
 
 var EndpointPrefix = utils.GetEnv("ENDPOINT_PREFIX")
@@ -17,6 +19,10 @@ type ServerConfig struct {
 	GRPCPort   string
 	ConsulAddr string
 	Name       string
+	// ReservationTimeout is how long a reserved photo is held for the buyer, 15 minutes by default.
+	ReservationTimeout time.Duration
+	// AdminUserIds are the users allowed on the admin endpoints, until user-svc has roles.
+	AdminUserIds []string
 }
 
 func NewServerConfig() ServerConfig {
@@ -44,6 +50,19 @@ func NewServerConfig() ServerConfig {
 	if name == "" {
 		log.Fatal("SERVICE_NAME environment variable is not set")
 	}
+	reservationTimeout := 15 * time.Minute
+	if value := utils.GetEnv("PHOTO_RESERVATION_TIMEOUT"); value != "" {
+		var err error
+		if reservationTimeout, err = time.ParseDuration(value); err != nil || reservationTimeout <= 0 {
+			log.Fatal("PHOTO_RESERVATION_TIMEOUT must be a positive duration like 15m")
+		}
+	}
+	var adminUserIds []string
+	for _, userId := range strings.Split(utils.GetEnv("ADMIN_USER_IDS"), ",") {
+		if userId = strings.TrimSpace(userId); userId != "" {
+			adminUserIds = append(adminUserIds, userId)
+		}
+	}
 	return ServerConfig{
 		HTTP:       fmt.Sprintf("%s:%s", httpAddr, port),
 		HTTPAddr:   httpAddr,
@@ -53,5 +72,8 @@ func NewServerConfig() ServerConfig {
 		GRPCPort:   grpcPort,
 		ConsulAddr: consulAddr,
 		Name:       name,
+
+		ReservationTimeout: reservationTimeout,
+		AdminUserIds:       adminUserIds,
 	}
 }
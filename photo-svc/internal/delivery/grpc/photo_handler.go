@@ -1,15 +1,19 @@
 package grpc
 
 import (
+	"be-yourmoments/photo-svc/internal/model"
+	"be-yourmoments/photo-svc/internal/model/converter"
 	"be-yourmoments/photo-svc/internal/pb"
 	"be-yourmoments/photo-svc/internal/usecase"
 	"context"
 	"log"
 	"net/http"
 
+	"github.com/gofiber/fiber/v2"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type PhotoGRPCHandler struct {
@@ -35,7 +39,7 @@ func (h *PhotoGRPCHandler) CreatePhoto(ctx context.Context, pbReq *pb.CreatePhot
 	log.Println("----  CreatePhoto Requets via GRPC in photo-svc ------")
 	if err := h.photoUseCase.CreatePhoto(context.Background(), pbReq); err != nil {
 		return &pb.CreatePhotoResponse{
-			Status: http.StatusBadRequest,
+			Status: errorStatus(err),
 			Error:  err.Error(),
 		}, nil
 	}
@@ -107,6 +111,171 @@ func (h *PhotoGRPCHandler) CreateUserSimilarFacecam(ctx context.Context, pbReq *
 	}, nil
 }
 
+func (h *PhotoGRPCHandler) GetPhotoPrice(ctx context.Context, pbReq *pb.GetPhotoPriceRequest) (
+	*pb.GetPhotoPriceResponse, error) {
+	log.Println("----  GetPhotoPrice Requets via GRPC in photo-svc ------")
+	photo, err := h.photoUseCase.GetPhotoPrice(ctx, pbReq)
+	if err != nil {
+		return &pb.GetPhotoPriceResponse{
+			Status: errorStatus(err),
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.GetPhotoPriceResponse{
+		Status: http.StatusOK,
+		Photo:  converter.PhotoToGrpc(photo),
+	}, nil
+}
+
+func (h *PhotoGRPCHandler) UpdatePhotosOwner(ctx context.Context, pbReq *pb.UpdatePhotosOwnerRequest) (
+	*pb.UpdatePhotosOwnerResponse, error) {
+	log.Println("----  UpdatePhotosOwner Requets via GRPC in photo-svc ------")
+	if err := h.photoUseCase.UpdatePhotosOwner(ctx, pbReq); err != nil {
+		return &pb.UpdatePhotosOwnerResponse{
+			Status: errorStatus(err),
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.UpdatePhotosOwnerResponse{
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (h *PhotoGRPCHandler) ClearPhotosOwner(ctx context.Context, pbReq *pb.ClearPhotosOwnerRequest) (
+	*pb.ClearPhotosOwnerResponse, error) {
+	log.Println("----  ClearPhotosOwner Requets via GRPC in photo-svc ------")
+	if err := h.photoUseCase.ClearPhotosOwner(ctx, pbReq); err != nil {
+		return &pb.ClearPhotosOwnerResponse{
+			Status: errorStatus(err),
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.ClearPhotosOwnerResponse{
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (h *PhotoGRPCHandler) GetCart(ctx context.Context, pbReq *pb.GetCartRequest) (
+	*pb.GetCartResponse, error) {
+	log.Println("----  GetCart Requets via GRPC in photo-svc ------")
+	photos, err := h.userSimilarPhotoUseCase.GetCart(ctx, pbReq.GetUserId())
+	if err != nil {
+		return &pb.GetCartResponse{
+			Status: errorStatus(err),
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.GetCartResponse{
+		Status: http.StatusOK,
+		Photos: converter.PhotosToGrpc(photos),
+	}, nil
+}
+
+func (h *PhotoGRPCHandler) ReservePhotos(ctx context.Context, pbReq *pb.ReservePhotosRequest) (
+	*pb.ReservePhotosResponse, error) {
+	log.Println("----  ReservePhotos Requets via GRPC in photo-svc ------")
+	reservedUntil, err := h.photoUseCase.ReservePhotos(ctx, &model.ReservePhotosRequest{
+		PhotoIds: pbReq.GetIds(),
+		UserId:   pbReq.GetUserId(),
+	})
+	if err != nil {
+		return &pb.ReservePhotosResponse{
+			Status: errorStatus(err),
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.ReservePhotosResponse{
+		Status:        http.StatusOK,
+		ReservedUntil: timestamppb.New(reservedUntil),
+	}, nil
+}
+
+func (h *PhotoGRPCHandler) CancelPhotoReservations(ctx context.Context, pbReq *pb.CancelPhotoReservationsRequest) (
+	*pb.CancelPhotoReservationsResponse, error) {
+	log.Println("----  CancelPhotoReservations Requets via GRPC in photo-svc ------")
+	err := h.photoUseCase.CancelPhotoReservations(ctx, &model.ReservePhotosRequest{
+		PhotoIds: pbReq.GetIds(),
+		UserId:   pbReq.GetUserId(),
+	})
+	if err != nil {
+		return &pb.CancelPhotoReservationsResponse{
+			Status: errorStatus(err),
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.CancelPhotoReservationsResponse{
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (h *PhotoGRPCHandler) GetMatchedPhotos(ctx context.Context, pbReq *pb.GetMatchedPhotosRequest) (
+	*pb.GetMatchedPhotosResponse, error) {
+	log.Println("----  GetMatchedPhotos Requets via GRPC in photo-svc ------")
+	photos, total, err := h.userSimilarPhotoUseCase.GetMatchedPhotos(ctx, converter.GrpcToMatchedPhotosRequest(pbReq))
+	if err != nil {
+		return &pb.GetMatchedPhotosResponse{
+			Status: errorStatus(err),
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.GetMatchedPhotosResponse{
+		Status: http.StatusOK,
+		Photos: converter.MatchedPhotosToGrpc(photos),
+		Total:  total,
+	}, nil
+}
+
+func (h *PhotoGRPCHandler) UpdateUserSimilarity(ctx context.Context, pbReq *pb.UpdateUserSimilarityRequest) (
+	*pb.UpdateUserSimilarityResponse, error) {
+	log.Println("----  UpdateUserSimilarity Requets via GRPC in photo-svc ------")
+	if err := h.userSimilarPhotoUseCase.UpdateUserSimilarity(ctx, converter.GrpcToUpdateUserSimilarityRequest(pbReq)); err != nil {
+		return &pb.UpdateUserSimilarityResponse{
+			Status: errorStatus(err),
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.UpdateUserSimilarityResponse{
+		Status: http.StatusOK,
+	}, nil
+}
+
+// DeletePhoto lets upload-svc take back a photo it registered but could not queue for compression.
+func (h *PhotoGRPCHandler) DeletePhoto(ctx context.Context, pbReq *pb.DeletePhotoRequest) (
+	*pb.DeletePhotoResponse, error) {
+	log.Println("----  DeletePhoto Requets via GRPC in photo-svc ------")
+	err := h.photoUseCase.DeletePhoto(ctx, &model.DeletePhotoRequest{
+		PhotoId:   pbReq.GetId(),
+		CreatorId: pbReq.GetCreatorId(),
+	})
+	if err != nil {
+		return &pb.DeletePhotoResponse{
+			Status: errorStatus(err),
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.DeletePhotoResponse{
+		Status: http.StatusOK,
+	}, nil
+}
+
+// errorStatus keeps the http status of usecase errors so callers can tell
+// a missing or conflicting photo apart from a generic failure.
+func errorStatus(err error) int64 {
+	if fiberErr, ok := err.(*fiber.Error); ok {
+		return int64(fiberErr.Code)
+	}
+	return http.StatusBadRequest
+}
+
 func (h *PhotoGRPCHandler) UpdatePhotographerPhoto(ctx context.Context,
 	pbReq *pb.UpdatePhotographerPhotoRequest) (
 	*pb.UpdatePhotographerPhotoResponse, error) {
@@ -0,0 +1,72 @@
+package http
+
+import (
+	"be-yourmoments/photo-svc/internal/delivery/http/middleware"
+	"be-yourmoments/photo-svc/internal/model"
+	"be-yourmoments/photo-svc/internal/model/converter"
+	"be-yourmoments/photo-svc/internal/usecase"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type CartController interface {
+	AddToCart(ctx *fiber.Ctx) error
+	RemoveFromCart(ctx *fiber.Ctx) error
+	GetCart(ctx *fiber.Ctx) error
+	Route(app *fiber.App)
+}
+
+type cartController struct {
+	userSimilarUsecase usecase.UserSimilarUsecase
+	authMiddleware     fiber.Handler
+}
+
+func NewCartController(userSimilarUsecase usecase.UserSimilarUsecase, authMiddleware fiber.Handler) CartController {
+	return &cartController{
+		userSimilarUsecase: userSimilarUsecase,
+		authMiddleware:     authMiddleware,
+	}
+}
+
+func (c *cartController) AddToCart(ctx *fiber.Ctx) error {
+	request := &model.CartRequest{
+		UserId:  middleware.GetUser(ctx).UserId,
+		PhotoId: ctx.Params("photoId"),
+	}
+
+	if err := c.userSimilarUsecase.AddToCart(ctx.UserContext(), request); err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"success": true,
+	})
+}
+
+func (c *cartController) RemoveFromCart(ctx *fiber.Ctx) error {
+	request := &model.CartRequest{
+		UserId:  middleware.GetUser(ctx).UserId,
+		PhotoId: ctx.Params("photoId"),
+	}
+
+	if err := c.userSimilarUsecase.RemoveFromCart(ctx.UserContext(), request); err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"success": true,
+	})
+}
+
+func (c *cartController) GetCart(ctx *fiber.Ctx) error {
+	photos, err := c.userSimilarUsecase.GetCart(ctx.UserContext(), middleware.GetUser(ctx).UserId)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"data":    converter.PhotosToCartResponse(photos),
+	})
+}
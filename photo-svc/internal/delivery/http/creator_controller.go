@@ -0,0 +1,52 @@
+package http
+
+import (
+	"be-yourmoments/photo-svc/internal/delivery/http/middleware"
+	"be-yourmoments/photo-svc/internal/enum"
+	"be-yourmoments/photo-svc/internal/model"
+	"be-yourmoments/photo-svc/internal/model/converter"
+	"be-yourmoments/photo-svc/internal/usecase"
+	"net/http"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type CreatorController interface {
+	GetCreatorPhotos(ctx *fiber.Ctx) error
+	Route(app *fiber.App)
+}
+
+type creatorController struct {
+	photoUsecase   usecase.PhotoUsecase
+	authMiddleware fiber.Handler
+}
+
+func NewCreatorController(photoUsecase usecase.PhotoUsecase, authMiddleware fiber.Handler) CreatorController {
+	return &creatorController{
+		photoUsecase:   photoUsecase,
+		authMiddleware: authMiddleware,
+	}
+}
+
+// GetCreatorPhotos is the photographer dashboard, it can be narrowed down with event_id and status.
+func (c *creatorController) GetCreatorPhotos(ctx *fiber.Ctx) error {
+	request := &model.CreatorPhotosRequest{
+		CreatorId: middleware.GetUser(ctx).UserId,
+		EventId:   ctx.Query("event_id"),
+		Status:    enum.PhotoStatus(strings.ToUpper(ctx.Query("status"))),
+		Page:      ctx.QueryInt("page", 1),
+		Size:      ctx.QueryInt("size", 20),
+	}
+
+	photos, summary, err := c.photoUsecase.GetCreatorPhotos(ctx.UserContext(), request)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"success":    true,
+		"data":       converter.CreatorPhotosToDashboard(photos, summary),
+		"pagination": model.NewPageMetadata(request.Page, request.Size, summary.TotalPhotos),
+	})
+}
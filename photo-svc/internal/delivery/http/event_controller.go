@@ -0,0 +1,130 @@
+package http
+
+import (
+	"be-yourmoments/photo-svc/internal/delivery/http/middleware"
+	"be-yourmoments/photo-svc/internal/model"
+	"be-yourmoments/photo-svc/internal/model/converter"
+	"be-yourmoments/photo-svc/internal/usecase"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type EventController interface {
+	CreateEvent(ctx *fiber.Ctx) error
+	UpdateEvent(ctx *fiber.Ctx) error
+	GetEvent(ctx *fiber.Ctx) error
+	GetEvents(ctx *fiber.Ctx) error
+	GetEventPhotos(ctx *fiber.Ctx) error
+	Route(app *fiber.App)
+}
+
+type eventController struct {
+	eventUsecase   usecase.EventUsecase
+	authMiddleware fiber.Handler
+}
+
+func NewEventController(eventUsecase usecase.EventUsecase, authMiddleware fiber.Handler) EventController {
+	return &eventController{
+		eventUsecase:   eventUsecase,
+		authMiddleware: authMiddleware,
+	}
+}
+
+func (c *eventController) CreateEvent(ctx *fiber.Ctx) error {
+	request := new(model.EventRequest)
+	if err := ctx.BodyParser(request); err != nil {
+		return fiber.NewError(http.StatusBadRequest, err.Error())
+	}
+	request.CreatorId = middleware.GetUser(ctx).UserId
+
+	event, err := c.eventUsecase.CreateEvent(ctx.UserContext(), request)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"data":    converter.EventToResponse(event),
+	})
+}
+
+func (c *eventController) UpdateEvent(ctx *fiber.Ctx) error {
+	request := new(model.EventRequest)
+	if err := ctx.BodyParser(request); err != nil {
+		return fiber.NewError(http.StatusBadRequest, err.Error())
+	}
+	request.Id = ctx.Params("eventId")
+	request.CreatorId = middleware.GetUser(ctx).UserId
+
+	event, err := c.eventUsecase.UpdateEvent(ctx.UserContext(), request)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"data":    converter.EventToResponse(event),
+	})
+}
+
+func (c *eventController) GetEvent(ctx *fiber.Ctx) error {
+	event, err := c.eventUsecase.GetEvent(ctx.UserContext(), ctx.Params("eventId"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"data":    converter.EventToResponse(event),
+	})
+}
+
+// GetEvents searches events by name or location with q. from and to are YYYY-MM-DD event dates,
+// both inclusive.
+func (c *eventController) GetEvents(ctx *fiber.Ctx) error {
+	request := &model.EventsRequest{
+		Query:     ctx.Query("q"),
+		CreatorId: ctx.Query("creator_id"),
+		Page:      ctx.QueryInt("page", 1),
+		Size:      ctx.QueryInt("size", 20),
+	}
+
+	var err error
+	if request.From, err = parseDateQuery(ctx, "from"); err != nil {
+		return err
+	}
+	if request.To, err = parseDateQuery(ctx, "to"); err != nil {
+		return err
+	}
+
+	events, total, err := c.eventUsecase.GetEvents(ctx.UserContext(), request)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"success":    true,
+		"data":       converter.EventsToResponse(events),
+		"pagination": model.NewPageMetadata(request.Page, request.Size, total),
+	})
+}
+
+func (c *eventController) GetEventPhotos(ctx *fiber.Ctx) error {
+	request := &model.EventPhotosRequest{
+		EventId: ctx.Params("eventId"),
+		Page:    ctx.QueryInt("page", 1),
+		Size:    ctx.QueryInt("size", 20),
+	}
+
+	photos, total, err := c.eventUsecase.GetEventPhotos(ctx.UserContext(), request)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"success":    true,
+		"data":       converter.EventPhotosToResponse(photos),
+		"pagination": model.NewPageMetadata(request.Page, request.Size, total),
+	})
+}
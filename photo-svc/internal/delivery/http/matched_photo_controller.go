@@ -0,0 +1,146 @@
+package http
+
+import (
+	"be-yourmoments/photo-svc/internal/delivery/http/middleware"
+	"be-yourmoments/photo-svc/internal/enum"
+	"be-yourmoments/photo-svc/internal/model"
+	"be-yourmoments/photo-svc/internal/model/converter"
+	"be-yourmoments/photo-svc/internal/usecase"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type MatchedPhotoController interface {
+	GetMatchedPhotos(ctx *fiber.Ctx) error
+	AddToWishlist(ctx *fiber.Ctx) error
+	RemoveFromWishlist(ctx *fiber.Ctx) error
+	AddToFavorite(ctx *fiber.Ctx) error
+	RemoveFromFavorite(ctx *fiber.Ctx) error
+	RequestResend(ctx *fiber.Ctx) error
+	CancelResend(ctx *fiber.Ctx) error
+	Route(app *fiber.App)
+}
+
+type matchedPhotoController struct {
+	userSimilarUsecase usecase.UserSimilarUsecase
+	authMiddleware     fiber.Handler
+}
+
+func NewMatchedPhotoController(userSimilarUsecase usecase.UserSimilarUsecase, authMiddleware fiber.Handler) MatchedPhotoController {
+	return &matchedPhotoController{
+		userSimilarUsecase: userSimilarUsecase,
+		authMiddleware:     authMiddleware,
+	}
+}
+
+// GetMatchedPhotos lists the user's matched photos. from and to are YYYY-MM-DD dates on the day the
+// photo was taken, both inclusive.
+func (c *matchedPhotoController) GetMatchedPhotos(ctx *fiber.Ctx) error {
+	request := &model.MatchedPhotosRequest{
+		UserId:     middleware.GetUser(ctx).UserId,
+		Similarity: enum.SimilarityLevelEnum(ctx.Query("similarity")),
+		Page:       ctx.QueryInt("page", 1),
+		Size:       ctx.QueryInt("size", 20),
+	}
+
+	var err error
+	if request.From, err = parseDateQuery(ctx, "from"); err != nil {
+		return err
+	}
+	if request.To, err = parseDateQuery(ctx, "to"); err != nil {
+		return err
+	}
+	if request.To != nil {
+		to := request.To.AddDate(0, 0, 1)
+		request.To = &to
+	}
+	if request.IsFavorite, err = parseBoolQuery(ctx, "is_favorite"); err != nil {
+		return err
+	}
+	if request.IsWishlist, err = parseBoolQuery(ctx, "is_wishlist"); err != nil {
+		return err
+	}
+
+	photos, total, err := c.userSimilarUsecase.GetMatchedPhotos(ctx.UserContext(), request)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"success":    true,
+		"data":       converter.MatchedPhotosToResponse(photos),
+		"pagination": model.NewPageMetadata(request.Page, request.Size, total),
+	})
+}
+
+func (c *matchedPhotoController) AddToWishlist(ctx *fiber.Ctx) error {
+	return c.updateFlag(ctx, enum.UserSimilarFlagWishlist, true)
+}
+
+func (c *matchedPhotoController) RemoveFromWishlist(ctx *fiber.Ctx) error {
+	return c.updateFlag(ctx, enum.UserSimilarFlagWishlist, false)
+}
+
+func (c *matchedPhotoController) AddToFavorite(ctx *fiber.Ctx) error {
+	return c.updateFlag(ctx, enum.UserSimilarFlagFavorite, true)
+}
+
+func (c *matchedPhotoController) RemoveFromFavorite(ctx *fiber.Ctx) error {
+	return c.updateFlag(ctx, enum.UserSimilarFlagFavorite, false)
+}
+
+func (c *matchedPhotoController) RequestResend(ctx *fiber.Ctx) error {
+	return c.updateFlag(ctx, enum.UserSimilarFlagResend, true)
+}
+
+func (c *matchedPhotoController) CancelResend(ctx *fiber.Ctx) error {
+	return c.updateFlag(ctx, enum.UserSimilarFlagResend, false)
+}
+
+func (c *matchedPhotoController) updateFlag(ctx *fiber.Ctx, flag enum.UserSimilarFlagEnum, value bool) error {
+	request := &model.UserSimilarFlagRequest{
+		UserId:  middleware.GetUser(ctx).UserId,
+		PhotoId: ctx.Params("photoId"),
+		Flag:    flag,
+		Value:   value,
+	}
+
+	if err := c.userSimilarUsecase.UpdateFlag(ctx.UserContext(), request); err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"success": true,
+	})
+}
+
+func parseDateQuery(ctx *fiber.Ctx, key string) (*time.Time, error) {
+	value := ctx.Query(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	date, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, key+" must be a YYYY-MM-DD date")
+	}
+
+	return &date, nil
+}
+
+func parseBoolQuery(ctx *fiber.Ctx, key string) (*bool, error) {
+	value := ctx.Query(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, key+" must be true or false")
+	}
+
+	return &parsed, nil
+}
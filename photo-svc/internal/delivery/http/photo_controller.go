@@ -1,6 +1,9 @@
 package http
 
 import (
+	"be-yourmoments/photo-svc/internal/delivery/http/middleware"
+	"be-yourmoments/photo-svc/internal/model"
+	"be-yourmoments/photo-svc/internal/model/converter"
 	"be-yourmoments/photo-svc/internal/usecase"
 	"net/http"
 
@@ -9,16 +12,23 @@ import (
 
 type PhotoController interface {
 	UploadPhoto(ctx *fiber.Ctx) error
+	GetPhoto(ctx *fiber.Ctx) error
+	DeletePhoto(ctx *fiber.Ctx) error
+	AdminDeletePhoto(ctx *fiber.Ctx) error
 	Route(app *fiber.App)
 }
 
 type photoController struct {
-	photoUsecase usecase.PhotoUsecase
+	photoUsecase    usecase.PhotoUsecase
+	authMiddleware  fiber.Handler
+	adminMiddleware fiber.Handler
 }
 
-func NewPhotoController(photoUsecase usecase.PhotoUsecase) PhotoController {
+func NewPhotoController(photoUsecase usecase.PhotoUsecase, authMiddleware fiber.Handler, adminMiddleware fiber.Handler) PhotoController {
 	return &photoController{
-		photoUsecase: photoUsecase,
+		photoUsecase:    photoUsecase,
+		authMiddleware:  authMiddleware,
+		adminMiddleware: adminMiddleware,
 	}
 }
 
@@ -38,3 +48,51 @@ func (c *photoController) UploadPhoto(ctx *fiber.Ctx) error {
 	})
 
 }
+
+// GetPhoto returns the photo with freshly signed urls of the renditions the caller may download.
+func (c *photoController) GetPhoto(ctx *fiber.Ctx) error {
+	request := &model.GetPhotoRequest{
+		PhotoId: ctx.Params("photoId"),
+		UserId:  middleware.GetUser(ctx).UserId,
+	}
+
+	photo, err := c.photoUsecase.GetPhoto(ctx.UserContext(), request)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"data":    converter.PhotoViewToResponse(photo),
+	})
+}
+
+// DeletePhoto lets the photographer unpublish one of their photos.
+func (c *photoController) DeletePhoto(ctx *fiber.Ctx) error {
+	request := &model.DeletePhotoRequest{
+		PhotoId:   ctx.Params("photoId"),
+		CreatorId: middleware.GetUser(ctx).UserId,
+	}
+
+	if err := c.photoUsecase.DeletePhoto(ctx.UserContext(), request); err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"success": true,
+	})
+}
+
+func (c *photoController) AdminDeletePhoto(ctx *fiber.Ctx) error {
+	request := &model.DeletePhotoRequest{
+		PhotoId: ctx.Params("photoId"),
+	}
+
+	if err := c.photoUsecase.DeletePhoto(ctx.UserContext(), request); err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"success": true,
+	})
+}
@@ -0,0 +1,63 @@
+package http
+
+import (
+	"be-yourmoments/photo-svc/internal/delivery/http/middleware"
+	"be-yourmoments/photo-svc/internal/model"
+	"be-yourmoments/photo-svc/internal/usecase"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ReservationController interface {
+	ReservePhoto(ctx *fiber.Ctx) error
+	CancelReservation(ctx *fiber.Ctx) error
+	Route(app *fiber.App)
+}
+
+type reservationController struct {
+	photoUsecase   usecase.PhotoUsecase
+	authMiddleware fiber.Handler
+}
+
+func NewReservationController(photoUsecase usecase.PhotoUsecase, authMiddleware fiber.Handler) ReservationController {
+	return &reservationController{
+		photoUsecase:   photoUsecase,
+		authMiddleware: authMiddleware,
+	}
+}
+
+func (c *reservationController) ReservePhoto(ctx *fiber.Ctx) error {
+	request := &model.ReservePhotosRequest{
+		PhotoIds: []string{ctx.Params("photoId")},
+		UserId:   middleware.GetUser(ctx).UserId,
+	}
+
+	reservedUntil, err := c.photoUsecase.ReservePhotos(ctx.UserContext(), request)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"data": &model.ReservationResponse{
+			PhotoId:       request.PhotoIds[0],
+			ReservedUntil: reservedUntil,
+		},
+	})
+}
+
+func (c *reservationController) CancelReservation(ctx *fiber.Ctx) error {
+	request := &model.ReservePhotosRequest{
+		PhotoIds: []string{ctx.Params("photoId")},
+		UserId:   middleware.GetUser(ctx).UserId,
+	}
+
+	if err := c.photoUsecase.CancelPhotoReservations(ctx.UserContext(), request); err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"success": true,
+	})
+}
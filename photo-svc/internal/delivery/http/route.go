@@ -2,11 +2,56 @@ package http
 
 import (
 	"be-yourmoments/photo-svc/internal/config"
+	"be-yourmoments/photo-svc/internal/delivery/http/middleware"
 
 	"github.com/gofiber/fiber/v2"
 )
 
 func (c *photoController) Route(app *fiber.App) {
 	api := app.Group(config.EndpointPrefix)
-	api.Post("/upload", c.UploadPhoto)
+	api.Post("/upload", c.authMiddleware, c.UploadPhoto)
+	api.Get("/photos/:photoId", c.authMiddleware, c.GetPhoto)
+	api.Delete("/photos/:photoId", c.authMiddleware, c.DeletePhoto)
+	api.Delete("/admin/photos/:photoId", c.authMiddleware, c.adminMiddleware, c.AdminDeletePhoto)
+}
+
+func (c *cartController) Route(app *fiber.App) {
+	api := app.Group(config.EndpointPrefix)
+	self := middleware.RequireSelf("userId")
+	api.Get("/users/:userId/cart", c.authMiddleware, self, c.GetCart)
+	api.Post("/users/:userId/cart/:photoId", c.authMiddleware, self, c.AddToCart)
+	api.Delete("/users/:userId/cart/:photoId", c.authMiddleware, self, c.RemoveFromCart)
+}
+
+func (c *reservationController) Route(app *fiber.App) {
+	api := app.Group(config.EndpointPrefix)
+	self := middleware.RequireSelf("userId")
+	api.Post("/users/:userId/photos/:photoId/reservation", c.authMiddleware, self, c.ReservePhoto)
+	api.Delete("/users/:userId/photos/:photoId/reservation", c.authMiddleware, self, c.CancelReservation)
+}
+
+func (c *matchedPhotoController) Route(app *fiber.App) {
+	api := app.Group(config.EndpointPrefix)
+	self := middleware.RequireSelf("userId")
+	api.Get("/users/:userId/photos", c.authMiddleware, self, c.GetMatchedPhotos)
+	api.Post("/users/:userId/photos/:photoId/wishlist", c.authMiddleware, self, c.AddToWishlist)
+	api.Delete("/users/:userId/photos/:photoId/wishlist", c.authMiddleware, self, c.RemoveFromWishlist)
+	api.Post("/users/:userId/photos/:photoId/favorite", c.authMiddleware, self, c.AddToFavorite)
+	api.Delete("/users/:userId/photos/:photoId/favorite", c.authMiddleware, self, c.RemoveFromFavorite)
+	api.Post("/users/:userId/photos/:photoId/resend", c.authMiddleware, self, c.RequestResend)
+	api.Delete("/users/:userId/photos/:photoId/resend", c.authMiddleware, self, c.CancelResend)
+}
+
+func (c *eventController) Route(app *fiber.App) {
+	api := app.Group(config.EndpointPrefix)
+	api.Get("/events", c.GetEvents)
+	api.Post("/events", c.authMiddleware, c.CreateEvent)
+	api.Get("/events/:eventId", c.GetEvent)
+	api.Put("/events/:eventId", c.authMiddleware, c.UpdateEvent)
+	api.Get("/events/:eventId/photos", c.GetEventPhotos)
+}
+
+func (c *creatorController) Route(app *fiber.App) {
+	api := app.Group(config.EndpointPrefix)
+	api.Get("/creators/:creatorId/photos", c.authMiddleware, middleware.RequireSelf("creatorId"), c.GetCreatorPhotos)
 }
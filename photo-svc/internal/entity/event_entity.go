@@ -0,0 +1,32 @@
+package entity
+
+import "time"
+
+// Event groups the photos a photographer took at one occasion, such as a marathon or a wedding.
+type Event struct {
+	Id              string    `db:"id"`
+	CreatorId       string    `db:"creator_id"`
+	Name            string    `db:"name"`
+	Description     string    `db:"description"`
+	Location        string    `db:"location"`
+	Date            time.Time `db:"event_date"`
+	CoverUrl        string    `db:"cover_url"`
+	DefaultPrice    int32     `db:"default_price"`
+	DefaultPriceStr string    `db:"default_price_str"`
+	PhotoCount      int64     `db:"photo_count"`
+	CreatedAt       time.Time `db:"created_at"`
+	UpdatedAt       time.Time `db:"updated_at"`
+}
+
+// EventPhoto is a photo as anyone browsing an event sees it, PreviewUrl is empty once it is sold.
+type EventPhoto struct {
+	PhotoId        string    `db:"photo_id"`
+	CreatorId      string    `db:"creator_id"`
+	Title          string    `db:"title"`
+	PreviewFileKey string    `db:"preview_file_key"`
+	PreviewUrl     string    `db:"-"`
+	Price          int32     `db:"price"`
+	PriceStr       string    `db:"price_str"`
+	IsSold         bool      `db:"is_sold"`
+	OriginalAt     time.Time `db:"original_at"`
+}
@@ -1,6 +1,9 @@
 package entity
 
-import "time"
+import (
+	"be-yourmoments/photo-svc/internal/enum"
+	"time"
+)
 
 type Photo struct {
 	Id             string `db:"id"`
@@ -11,10 +14,64 @@ type Photo struct {
 	IsThisYouURL   string `db:"is_this_you_url"`
 	YourMomentsUrl string `db:"your_moments_url"`
 	CollectionUrl  string `db:"collection_url"`
+	EventId        string `db:"event_id"`
+
+	Status           enum.PhotoStatus `db:"status"`
+	ReservedByUserId string           `db:"reserved_by_user_id"`
+	ReservedUntil    *time.Time       `db:"reserved_until"`
+	// DeletedAt is set once the photo is unpublished, a sold photo stays readable by its owner.
+	DeletedAt *time.Time `db:"deleted_at"`
 
 	Price      int32     `db:"price"`
 	PriceStr   string    `db:"price_str"`
 	OriginalAt time.Time `db:"original_at"`
 	CreatedAt  time.Time `db:"created_at"`
 	UpdatedAt  time.Time `db:"updated_at"`
+
+	// PreviewFileKey is only selected for the cart, PreviewUrl is signed from it on every read.
+	PreviewFileKey string `db:"preview_file_key"`
+	PreviewUrl     string `db:"-"`
+}
+
+// CreatorPhoto is a photo as its photographer sees it on the dashboard, with how far it got
+// through the upload pipeline and how many users it was matched with.
+type CreatorPhoto struct {
+	Id                string           `db:"id"`
+	Title             string           `db:"title"`
+	EventId           string           `db:"event_id"`
+	CollectionFileKey string           `db:"collection_file_key"`
+	CompressedFileKey string           `db:"compressed_file_key"`
+	CollectionUrl     string           `db:"-"`
+	CompressedUrl     string           `db:"-"`
+	Price             int32            `db:"price"`
+	PriceStr          string           `db:"price_str"`
+	Status            enum.PhotoStatus `db:"status"`
+	HasCollection     bool             `db:"has_collection"`
+	HasCompressed     bool             `db:"has_compressed"`
+	HasAiResult       bool             `db:"has_ai_result"`
+	MatchCount        int64            `db:"match_count"`
+	OriginalAt        time.Time        `db:"original_at"`
+	CreatedAt         time.Time        `db:"created_at"`
+}
+
+// CreatorPhotoSummary totals the photos of a photographer. SoldAmount is the listed price of
+// the sold photos, before promo discounts and commissions.
+type CreatorPhotoSummary struct {
+	TotalPhotos      int64 `db:"total_photos"`
+	CompressedPhotos int64 `db:"compressed_photos"`
+	ProcessedPhotos  int64 `db:"processed_photos"`
+	MatchedPhotos    int64 `db:"matched_photos"`
+	TotalMatches     int64 `db:"total_matches"`
+	ReservedPhotos   int64 `db:"reserved_photos"`
+	SoldPhotos       int64 `db:"sold_photos"`
+	SoldAmount       int64 `db:"sold_amount"`
+}
+
+// PhotoView is a photo as one caller sees it. Renditions only holds the renditions their access
+// level may download, with Url signed for them until ExpiresAt.
+type PhotoView struct {
+	Photo      *Photo
+	Access     enum.PhotoAccessLevel
+	Renditions []*PhotoDetail
+	ExpiresAt  time.Time
 }
@@ -0,0 +1,14 @@
+package entity
+
+import (
+	"be-yourmoments/photo-svc/internal/enum"
+	"time"
+)
+
+// UserSetting is the similarity threshold a user chose in user-svc. Users without one
+// see matches from enum.DefaultSimilarityThreshold up.
+type UserSetting struct {
+	UserId     string                   `db:"user_id"`
+	Similarity enum.SimilarityLevelEnum `db:"similarity"`
+	UpdatedAt  time.Time                `db:"updated_at"`
+}
@@ -17,3 +17,25 @@ type UserSimilarPhoto struct {
 	CreatedAt  time.Time                `db:"created_at"`
 	UpdatedAt  time.Time                `db:"updated_at"`
 }
+
+// MatchedPhoto is a photo as one of its matched users sees it. The preview is the watermarked one
+// while the photo is unsold, the full photo once the user owns it and empty when someone else bought it.
+// PreviewUrl is signed from PreviewFileKey on every read.
+type MatchedPhoto struct {
+	PhotoId        string                   `db:"photo_id"`
+	CreatorId      string                   `db:"creator_id"`
+	Title          string                   `db:"title"`
+	PreviewFileKey string                   `db:"preview_file_key"`
+	PreviewUrl     string                   `db:"-"`
+	Price          int32                    `db:"price"`
+	PriceStr       string                   `db:"price_str"`
+	IsOwned        bool                     `db:"is_owned"`
+	IsSold         bool                     `db:"is_sold"`
+	Similarity     enum.SimilarityLevelEnum `db:"similarity"`
+	IsWishlist     bool                     `db:"is_wishlist"`
+	IsResend       bool                     `db:"is_resend"`
+	IsCart         bool                     `db:"is_cart"`
+	IsFavorite     bool                     `db:"is_favorite"`
+	OriginalAt     time.Time                `db:"original_at"`
+	MatchedAt      time.Time                `db:"matched_at"`
+}
@@ -7,4 +7,17 @@ const (
 	YourMomentTypeIsYou      YourMomentsType = "ISYOU"
 	YourMomentTypeYou        YourMomentsType = "YOU"
 	YourMomentTypeCollection YourMomentsType = "COLLECTION"
+	YourMomentTypeThumbnail  YourMomentsType = "THUMBNAIL"
+	YourMomentTypePreview    YourMomentsType = "PREVIEW"
+)
+
+// PhotoAccessLevel is how a caller is related to a photo. It decides which renditions they may
+// download and how long their signed urls stay valid.
+type PhotoAccessLevel string
+
+const (
+	PhotoAccessPublic  PhotoAccessLevel = "PUBLIC"
+	PhotoAccessMatched PhotoAccessLevel = "MATCHED"
+	PhotoAccessOwner   PhotoAccessLevel = "OWNER"
+	PhotoAccessCreator PhotoAccessLevel = "CREATOR"
 )
@@ -0,0 +1,11 @@
+package enum
+
+// PhotoStatus is where a photo is in its sale: AVAILABLE -> RESERVED -> OWNED. A reservation that
+// runs out without a purchase counts as AVAILABLE again, a refund makes an OWNED photo AVAILABLE.
+type PhotoStatus string
+
+const (
+	PhotoStatusAvailable PhotoStatus = "AVAILABLE"
+	PhotoStatusReserved  PhotoStatus = "RESERVED"
+	PhotoStatusOwned     PhotoStatus = "OWNED"
+)
@@ -12,3 +12,16 @@ const (
 	SimilarityLevelSeven SimilarityLevelEnum = "7"
 	SimilarityLevelEight SimilarityLevelEnum = "8"
 )
+
+// UserSimilarFlagEnum names the flags a user can toggle on a photo matched to them.
+type UserSimilarFlagEnum string
+
+const (
+	UserSimilarFlagWishlist UserSimilarFlagEnum = "wishlist"
+	UserSimilarFlagFavorite UserSimilarFlagEnum = "favorite"
+	UserSimilarFlagResend   UserSimilarFlagEnum = "resend"
+)
+
+// DefaultSimilarityThreshold matches the default of user_profiles.similarity in user-svc, which
+// accepts the same levels one to eight as a threshold.
+const DefaultSimilarityThreshold = SimilarityLevelThree
@@ -0,0 +1,49 @@
+package converter
+
+import (
+	"be-yourmoments/photo-svc/internal/entity"
+	"be-yourmoments/photo-svc/internal/model"
+	"time"
+)
+
+func EventToResponse(event *entity.Event) *model.EventResponse {
+	return &model.EventResponse{
+		Id:              event.Id,
+		CreatorId:       event.CreatorId,
+		Name:            event.Name,
+		Description:     event.Description,
+		Location:        event.Location,
+		Date:            event.Date.Format(time.DateOnly),
+		CoverUrl:        event.CoverUrl,
+		DefaultPrice:    event.DefaultPrice,
+		DefaultPriceStr: event.DefaultPriceStr,
+		PhotoCount:      event.PhotoCount,
+		CreatedAt:       event.CreatedAt,
+		UpdatedAt:       event.UpdatedAt,
+	}
+}
+
+func EventsToResponse(events []*entity.Event) []*model.EventResponse {
+	responses := make([]*model.EventResponse, 0, len(events))
+	for _, event := range events {
+		responses = append(responses, EventToResponse(event))
+	}
+	return responses
+}
+
+func EventPhotosToResponse(photos []*entity.EventPhoto) []*model.EventPhotoResponse {
+	responses := make([]*model.EventPhotoResponse, 0, len(photos))
+	for _, photo := range photos {
+		responses = append(responses, &model.EventPhotoResponse{
+			PhotoId:    photo.PhotoId,
+			CreatorId:  photo.CreatorId,
+			Title:      photo.Title,
+			PreviewUrl: photo.PreviewUrl,
+			Price:      photo.Price,
+			PriceStr:   photo.PriceStr,
+			IsSold:     photo.IsSold,
+			OriginalAt: photo.OriginalAt,
+		})
+	}
+	return responses
+}
@@ -1,8 +1,13 @@
 package converter
 
 import (
+	"be-yourmoments/photo-svc/internal/entity"
+	"be-yourmoments/photo-svc/internal/enum"
 	"be-yourmoments/photo-svc/internal/model"
 	"be-yourmoments/photo-svc/internal/pb"
+	"strconv"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func GrpcToCreateRequest(req *pb.UpdatePhotographerPhotoRequest) *model.RequestUpdateProcessedPhoto {
@@ -18,3 +23,188 @@ func GrpcToCreateRequest(req *pb.UpdatePhotographerPhotoRequest) *model.RequestU
 	}
 
 }
+
+func PhotoToGrpc(photo *entity.Photo) *pb.Photo {
+	return &pb.Photo{
+		Id:             photo.Id,
+		CreatorId:      photo.CreatorId,
+		Title:          photo.Title,
+		OwnedByUserId:  photo.OwnedByUserId,
+		CompressedUrl:  photo.CompressedUrl,
+		IsThisYouUrl:   photo.IsThisYouURL,
+		YourMomentsUrl: photo.YourMomentsUrl,
+		CollectionUrl:  photo.CollectionUrl,
+		Price:          photo.Price,
+		PriceStr:       photo.PriceStr,
+		OriginalAt:     timestamppb.New(photo.OriginalAt),
+		CreatedAt:      timestamppb.New(photo.CreatedAt),
+		UpdatedAt:      timestamppb.New(photo.UpdatedAt),
+	}
+}
+
+func PhotosToCartResponse(photos []*entity.Photo) []*model.CartItemResponse {
+	responses := make([]*model.CartItemResponse, 0, len(photos))
+	for _, photo := range photos {
+		responses = append(responses, &model.CartItemResponse{
+			PhotoId:    photo.Id,
+			CreatorId:  photo.CreatorId,
+			Title:      photo.Title,
+			Price:      photo.Price,
+			PriceStr:   photo.PriceStr,
+			PreviewUrl: photo.PreviewUrl,
+		})
+	}
+	return responses
+}
+
+func PhotosToGrpc(photos []*entity.Photo) []*pb.Photo {
+	pbPhotos := make([]*pb.Photo, 0, len(photos))
+	for _, photo := range photos {
+		pbPhotos = append(pbPhotos, PhotoToGrpc(photo))
+	}
+	return pbPhotos
+}
+
+func MatchedPhotosToResponse(photos []*entity.MatchedPhoto) []*model.MatchedPhotoResponse {
+	responses := make([]*model.MatchedPhotoResponse, 0, len(photos))
+	for _, photo := range photos {
+		responses = append(responses, &model.MatchedPhotoResponse{
+			PhotoId:    photo.PhotoId,
+			CreatorId:  photo.CreatorId,
+			Title:      photo.Title,
+			PreviewUrl: photo.PreviewUrl,
+			Price:      photo.Price,
+			PriceStr:   photo.PriceStr,
+			IsOwned:    photo.IsOwned,
+			IsSold:     photo.IsSold,
+			Similarity: photo.Similarity,
+			IsWishlist: photo.IsWishlist,
+			IsResend:   photo.IsResend,
+			IsCart:     photo.IsCart,
+			IsFavorite: photo.IsFavorite,
+			OriginalAt: photo.OriginalAt,
+			MatchedAt:  photo.MatchedAt,
+		})
+	}
+	return responses
+}
+
+func GrpcToMatchedPhotosRequest(req *pb.GetMatchedPhotosRequest) *model.MatchedPhotosRequest {
+	request := &model.MatchedPhotosRequest{
+		UserId:     req.GetUserId(),
+		Similarity: enum.SimilarityLevelEnum(req.GetSimilarity()),
+		IsFavorite: req.IsFavorite,
+		IsWishlist: req.IsWishlist,
+		Page:       int(req.GetPage()),
+		Size:       int(req.GetSize()),
+	}
+	if req.GetFrom() != nil {
+		from := req.GetFrom().AsTime()
+		request.From = &from
+	}
+	if req.GetTo() != nil {
+		to := req.GetTo().AsTime()
+		request.To = &to
+	}
+	return request
+}
+
+func MatchedPhotosToGrpc(photos []*entity.MatchedPhoto) []*pb.MatchedPhoto {
+	pbPhotos := make([]*pb.MatchedPhoto, 0, len(photos))
+	for _, photo := range photos {
+		pbPhotos = append(pbPhotos, &pb.MatchedPhoto{
+			PhotoId:    photo.PhotoId,
+			CreatorId:  photo.CreatorId,
+			Title:      photo.Title,
+			PreviewUrl: photo.PreviewUrl,
+			Price:      photo.Price,
+			PriceStr:   photo.PriceStr,
+			IsOwned:    photo.IsOwned,
+			IsSold:     photo.IsSold,
+			Similarity: string(photo.Similarity),
+			IsWishlist: photo.IsWishlist,
+			IsResend:   photo.IsResend,
+			IsCart:     photo.IsCart,
+			IsFavorite: photo.IsFavorite,
+			OriginalAt: timestamppb.New(photo.OriginalAt),
+			MatchedAt:  timestamppb.New(photo.MatchedAt),
+		})
+	}
+	return pbPhotos
+}
+
+func GrpcToUpdateUserSimilarityRequest(req *pb.UpdateUserSimilarityRequest) *model.UpdateUserSimilarityRequest {
+	return &model.UpdateUserSimilarityRequest{
+		UserId:     req.GetUserId(),
+		Similarity: enum.SimilarityLevelEnum(strconv.Itoa(int(req.GetSimilarity()))),
+		UpdatedAt:  req.GetUpdatedAt().AsTime(),
+	}
+}
+
+func CreatorPhotosToDashboard(photos []*entity.CreatorPhoto, summary *entity.CreatorPhotoSummary) *model.CreatorDashboardResponse {
+	responses := make([]*model.CreatorPhotoResponse, 0, len(photos))
+	for _, photo := range photos {
+		responses = append(responses, &model.CreatorPhotoResponse{
+			Id:            photo.Id,
+			Title:         photo.Title,
+			EventId:       photo.EventId,
+			CollectionUrl: photo.CollectionUrl,
+			CompressedUrl: photo.CompressedUrl,
+			Price:         photo.Price,
+			PriceStr:      photo.PriceStr,
+			Status:        photo.Status,
+			IsSold:        photo.Status == enum.PhotoStatusOwned,
+			IsUploaded:    photo.HasCollection,
+			IsCompressed:  photo.HasCompressed,
+			IsProcessed:   photo.HasAiResult,
+			MatchCount:    photo.MatchCount,
+			OriginalAt:    photo.OriginalAt,
+			CreatedAt:     photo.CreatedAt,
+		})
+	}
+
+	return &model.CreatorDashboardResponse{
+		Summary: &model.CreatorPhotoSummaryResponse{
+			TotalPhotos:      summary.TotalPhotos,
+			CompressedPhotos: summary.CompressedPhotos,
+			ProcessedPhotos:  summary.ProcessedPhotos,
+			MatchedPhotos:    summary.MatchedPhotos,
+			TotalMatches:     summary.TotalMatches,
+			ReservedPhotos:   summary.ReservedPhotos,
+			SoldPhotos:       summary.SoldPhotos,
+			UnsoldPhotos:     summary.TotalPhotos - summary.SoldPhotos,
+			SoldAmount:       summary.SoldAmount,
+		},
+		Photos: responses,
+	}
+}
+
+func PhotoViewToResponse(view *entity.PhotoView) *model.PhotoResponse {
+	renditions := make([]*model.PhotoRenditionResponse, 0, len(view.Renditions))
+	for _, rendition := range view.Renditions {
+		renditions = append(renditions, &model.PhotoRenditionResponse{
+			Type:     rendition.YourMomentsType,
+			FileName: rendition.FileName,
+			Format:   rendition.Type,
+			Size:     rendition.Size,
+			Width:    rendition.Width,
+			Height:   rendition.Height,
+			Url:      rendition.Url,
+		})
+	}
+
+	return &model.PhotoResponse{
+		Id:         view.Photo.Id,
+		CreatorId:  view.Photo.CreatorId,
+		Title:      view.Photo.Title,
+		EventId:    view.Photo.EventId,
+		Price:      view.Photo.Price,
+		PriceStr:   view.Photo.PriceStr,
+		IsSold:     view.Photo.OwnedByUserId != "",
+		Access:     view.Access,
+		Renditions: renditions,
+		ExpiresAt:  view.ExpiresAt,
+		OriginalAt: view.Photo.OriginalAt,
+		CreatedAt:  view.Photo.CreatedAt,
+	}
+}
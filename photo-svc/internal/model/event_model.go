@@ -0,0 +1,59 @@
+package model
+
+import "time"
+
+// EventRequest creates or replaces an event of the authenticated photographer. Date is a
+// YYYY-MM-DD date.
+type EventRequest struct {
+	Id              string `json:"-"`
+	CreatorId       string `json:"-"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	Location        string `json:"location"`
+	Date            string `json:"date"`
+	CoverUrl        string `json:"cover_url"`
+	DefaultPrice    int32  `json:"default_price"`
+	DefaultPriceStr string `json:"default_price_str"`
+}
+
+// EventsRequest pages through events, From and To are inclusive event dates.
+type EventsRequest struct {
+	Query     string
+	CreatorId string
+	From      *time.Time
+	To        *time.Time
+	Page      int
+	Size      int
+}
+
+type EventPhotosRequest struct {
+	EventId string
+	Page    int
+	Size    int
+}
+
+type EventResponse struct {
+	Id              string    `json:"id"`
+	CreatorId       string    `json:"creator_id"`
+	Name            string    `json:"name"`
+	Description     string    `json:"description"`
+	Location        string    `json:"location"`
+	Date            string    `json:"date"`
+	CoverUrl        string    `json:"cover_url"`
+	DefaultPrice    int32     `json:"default_price"`
+	DefaultPriceStr string    `json:"default_price_str"`
+	PhotoCount      int64     `json:"photo_count"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+type EventPhotoResponse struct {
+	PhotoId    string    `json:"photo_id"`
+	CreatorId  string    `json:"creator_id"`
+	Title      string    `json:"title"`
+	PreviewUrl string    `json:"preview_url"`
+	Price      int32     `json:"price"`
+	PriceStr   string    `json:"price_str"`
+	IsSold     bool      `json:"is_sold"`
+	OriginalAt time.Time `json:"original_at"`
+}
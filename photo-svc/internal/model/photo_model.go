@@ -1,5 +1,10 @@
 package model
 
+import (
+	"be-yourmoments/photo-svc/internal/enum"
+	"time"
+)
+
 // TODO add similarity
 type RequestUpdateProcessedPhoto struct {
 	Id                     string
@@ -8,7 +13,172 @@ type RequestUpdateProcessedPhoto struct {
 	UserId                 []string
 }
 
-type RequestClaimPhoto struct {
-	Id     string
-	UserId string
+// GetPhotoRequest loads a photo for UserId, who may be neither its photographer nor matched to it.
+type GetPhotoRequest struct {
+	PhotoId string
+	UserId  string
+}
+
+// DeletePhotoRequest unpublishes a photo. An empty CreatorId is an admin removing any photo.
+type DeletePhotoRequest struct {
+	PhotoId   string
+	CreatorId string
+}
+
+// ReservePhotosRequest reserves or releases every photo for the same user.
+type ReservePhotosRequest struct {
+	PhotoIds []string
+	UserId   string
+}
+
+type ReservationResponse struct {
+	PhotoId       string    `json:"photo_id"`
+	ReservedUntil time.Time `json:"reserved_until"`
+}
+
+type CartRequest struct {
+	UserId  string
+	PhotoId string
+}
+
+// UserSimilarFlagRequest sets or clears one flag on a photo matched to the user.
+type UserSimilarFlagRequest struct {
+	UserId  string
+	PhotoId string
+	Flag    enum.UserSimilarFlagEnum
+	Value   bool
+}
+
+type UpdateUserSimilarityRequest struct {
+	UserId     string
+	Similarity enum.SimilarityLevelEnum
+	UpdatedAt  time.Time
+}
+
+type CartItemResponse struct {
+	PhotoId    string `json:"photo_id"`
+	CreatorId  string `json:"creator_id"`
+	Title      string `json:"title"`
+	Price      int32  `json:"price"`
+	PriceStr   string `json:"price_str"`
+	PreviewUrl string `json:"preview_url"`
+}
+
+// MatchedPhotosRequest pages through a user's matched photos. To is exclusive and a nil flag
+// does not filter on it.
+type MatchedPhotosRequest struct {
+	UserId     string
+	Similarity enum.SimilarityLevelEnum
+	From       *time.Time
+	To         *time.Time
+	IsFavorite *bool
+	IsWishlist *bool
+	Page       int
+	Size       int
+}
+
+type MatchedPhotoResponse struct {
+	PhotoId    string                   `json:"photo_id"`
+	CreatorId  string                   `json:"creator_id"`
+	Title      string                   `json:"title"`
+	PreviewUrl string                   `json:"preview_url"`
+	Price      int32                    `json:"price"`
+	PriceStr   string                   `json:"price_str"`
+	IsOwned    bool                     `json:"is_owned"`
+	IsSold     bool                     `json:"is_sold"`
+	Similarity enum.SimilarityLevelEnum `json:"similarity"`
+	IsWishlist bool                     `json:"is_wishlist"`
+	IsResend   bool                     `json:"is_resend"`
+	IsCart     bool                     `json:"is_cart"`
+	IsFavorite bool                     `json:"is_favorite"`
+	OriginalAt time.Time                `json:"original_at"`
+	MatchedAt  time.Time                `json:"matched_at"`
+}
+
+type PageMetadata struct {
+	Page      int   `json:"page"`
+	Size      int   `json:"size"`
+	TotalItem int64 `json:"total_item"`
+	TotalPage int64 `json:"total_page"`
+}
+
+func NewPageMetadata(page, size int, totalItem int64) *PageMetadata {
+	return &PageMetadata{
+		Page:      page,
+		Size:      size,
+		TotalItem: totalItem,
+		TotalPage: (totalItem + int64(size) - 1) / int64(size),
+	}
+}
+
+type CreatorPhotosRequest struct {
+	CreatorId string
+	EventId   string
+	Status    enum.PhotoStatus
+	Page      int
+	Size      int
+}
+
+// CreatorPhotoResponse shows the photographer which steps of the upload pipeline finished:
+// the original is stored, the compressed copy exists and the AI made its variant.
+type CreatorPhotoResponse struct {
+	Id            string           `json:"id"`
+	Title         string           `json:"title"`
+	EventId       string           `json:"event_id,omitempty"`
+	CollectionUrl string           `json:"collection_url"`
+	CompressedUrl string           `json:"compressed_url"`
+	Price         int32            `json:"price"`
+	PriceStr      string           `json:"price_str"`
+	Status        enum.PhotoStatus `json:"status"`
+	IsSold        bool             `json:"is_sold"`
+	IsUploaded    bool             `json:"is_uploaded"`
+	IsCompressed  bool             `json:"is_compressed"`
+	IsProcessed   bool             `json:"is_processed"`
+	MatchCount    int64            `json:"match_count"`
+	OriginalAt    time.Time        `json:"original_at"`
+	CreatedAt     time.Time        `json:"created_at"`
+}
+
+type CreatorPhotoSummaryResponse struct {
+	TotalPhotos      int64 `json:"total_photos"`
+	CompressedPhotos int64 `json:"compressed_photos"`
+	ProcessedPhotos  int64 `json:"processed_photos"`
+	MatchedPhotos    int64 `json:"matched_photos"`
+	TotalMatches     int64 `json:"total_matches"`
+	ReservedPhotos   int64 `json:"reserved_photos"`
+	SoldPhotos       int64 `json:"sold_photos"`
+	UnsoldPhotos     int64 `json:"unsold_photos"`
+	SoldAmount       int64 `json:"sold_amount"`
+}
+
+type CreatorDashboardResponse struct {
+	Summary *CreatorPhotoSummaryResponse `json:"summary"`
+	Photos  []*CreatorPhotoResponse      `json:"photos"`
+}
+
+// PhotoResponse is a photo as the caller sees it. The rendition urls are signed for this response
+// only and stop working at expires_at.
+type PhotoResponse struct {
+	Id         string                    `json:"id"`
+	CreatorId  string                    `json:"creator_id"`
+	Title      string                    `json:"title"`
+	EventId    string                    `json:"event_id"`
+	Price      int32                     `json:"price"`
+	PriceStr   string                    `json:"price_str"`
+	IsSold     bool                      `json:"is_sold"`
+	Access     enum.PhotoAccessLevel     `json:"access"`
+	Renditions []*PhotoRenditionResponse `json:"renditions"`
+	ExpiresAt  time.Time                 `json:"expires_at"`
+	OriginalAt time.Time                 `json:"original_at"`
+	CreatedAt  time.Time                 `json:"created_at"`
+}
+
+type PhotoRenditionResponse struct {
+	Type     enum.YourMomentsType `json:"type"`
+	FileName string               `json:"file_name"`
+	Format   string               `json:"format"`
+	Size     int64                `json:"size"`
+	Width    int32                `json:"width"`
+	Height   int32                `json:"height"`
+	Url      string               `json:"url"`
 }
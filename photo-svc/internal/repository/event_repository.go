@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"be-yourmoments/photo-svc/internal/entity"
+	"fmt"
+	"time"
+)
+
+type EventRepository interface {
+	Create(tx Querier, event *entity.Event) error
+	Update(tx Querier, event *entity.Event) error
+	FindById(tx Querier, id string) (*entity.Event, error)
+	FindByFilter(tx Querier, filter *EventFilter, limit, offset int) ([]*entity.Event, error)
+	CountByFilter(tx Querier, filter *EventFilter) (int64, error)
+	FindPhotos(tx Querier, eventId string, limit, offset int) ([]*entity.EventPhoto, error)
+	CountPhotos(tx Querier, eventId string) (int64, error)
+}
+
+// EventFilter selects events, empty and nil fields match everything. Query matches the name
+// or location, From and To bound the event date and are both inclusive.
+type EventFilter struct {
+	Query     string
+	CreatorId string
+	From      *time.Time
+	To        *time.Time
+}
+
+type eventRepository struct {
+}
+
+func NewEventRepository() EventRepository {
+	return &eventRepository{}
+}
+
+const eventColumns = `e.id, e.creator_id, e.name, COALESCE(e.description, '') AS description,
+	COALESCE(e.location, '') AS location, e.event_date, COALESCE(e.cover_url, '') AS cover_url,
+	e.default_price, e.default_price_str,
+	(SELECT COUNT(*) FROM photos p WHERE p.event_id = e.id AND p.deleted_at IS NULL) AS photo_count,
+	e.created_at, e.updated_at`
+
+// eventFilterCondition takes the EventFilter fields as $1 to $4.
+const eventFilterCondition = `
+	WHERE ($1 = '' OR e.name ILIKE '%' || $1 || '%' OR e.location ILIKE '%' || $1 || '%')
+	  AND ($2 = '' OR e.creator_id = $2)
+	  AND ($3::date IS NULL OR e.event_date >= $3)
+	  AND ($4::date IS NULL OR e.event_date <= $4)`
+
+func (r *eventRepository) Create(tx Querier, event *entity.Event) error {
+	query := `INSERT INTO events 
+		(id, creator_id, name, description, location, event_date, cover_url, default_price, default_price_str,
+		created_at, updated_at)
+		VALUES ($1, $2, $3, NULLIF($4, ''), NULLIF($5, ''), $6, NULLIF($7, ''), $8, $9, $10, $11)`
+
+	_, err := tx.Exec(query, event.Id, event.CreatorId, event.Name, event.Description, event.Location, event.Date,
+		event.CoverUrl, event.DefaultPrice, event.DefaultPriceStr, event.CreatedAt, event.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert event: %w", err)
+	}
+
+	return nil
+}
+
+func (r *eventRepository) Update(tx Querier, event *entity.Event) error {
+	query := `UPDATE events 
+		SET name = $1, description = NULLIF($2, ''), location = NULLIF($3, ''), event_date = $4,
+		cover_url = NULLIF($5, ''), default_price = $6, default_price_str = $7, updated_at = $8
+		WHERE id = $9`
+
+	_, err := tx.Exec(query, event.Name, event.Description, event.Location, event.Date, event.CoverUrl,
+		event.DefaultPrice, event.DefaultPriceStr, event.UpdatedAt, event.Id)
+	if err != nil {
+		return fmt.Errorf("failed to update event: %w", err)
+	}
+
+	return nil
+}
+
+func (r *eventRepository) FindById(tx Querier, id string) (*entity.Event, error) {
+	query := `SELECT ` + eventColumns + ` FROM events e WHERE e.id = $1`
+
+	event := new(entity.Event)
+	if err := tx.Get(event, query, id); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// FindByFilter returns the most recent events first.
+func (r *eventRepository) FindByFilter(tx Querier, filter *EventFilter, limit, offset int) ([]*entity.Event, error) {
+	query := `SELECT ` + eventColumns + ` FROM events e` + eventFilterCondition +
+		` ORDER BY e.event_date DESC, e.id LIMIT $5 OFFSET $6`
+
+	rows, err := tx.Queryx(query, filter.Query, filter.CreatorId, filter.From, filter.To, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []*entity.Event
+	for rows.Next() {
+		event := new(entity.Event)
+		if err := rows.StructScan(event); err != nil {
+			return nil, fmt.Errorf("failed to scan event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+func (r *eventRepository) CountByFilter(tx Querier, filter *EventFilter) (int64, error) {
+	query := `SELECT COUNT(*) FROM events e` + eventFilterCondition
+
+	var count int64
+	if err := tx.Get(&count, query, filter.Query, filter.CreatorId, filter.From, filter.To); err != nil {
+		return 0, fmt.Errorf("failed to count events: %w", err)
+	}
+
+	return count, nil
+}
+
+// FindPhotos returns the photos of an event in the order they were taken. Only the watermarked
+// THUMBNAIL of unsold photos is exposed, anyone can browse an event.
+func (r *eventRepository) FindPhotos(tx Querier, eventId string, limit, offset int) ([]*entity.EventPhoto, error) {
+	query := `SELECT p.id AS photo_id, p.creator_id, p.title,
+		CASE WHEN p.owned_by_user_id IS NULL THEN COALESCE((SELECT d.file_key FROM photo_details d
+		  WHERE d.photo_id = p.id AND d.your_moments_type = 'THUMBNAIL' ORDER BY d.created_at DESC LIMIT 1), '')
+		ELSE '' END AS preview_file_key,
+		p.price, p.price_str, p.owned_by_user_id IS NOT NULL AS is_sold, p.original_at
+		FROM photos p WHERE p.event_id = $1 AND p.deleted_at IS NULL
+		ORDER BY p.original_at, p.id LIMIT $2 OFFSET $3`
+
+	rows, err := tx.Queryx(query, eventId, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event photos: %w", err)
+	}
+	defer rows.Close()
+
+	var photos []*entity.EventPhoto
+	for rows.Next() {
+		photo := new(entity.EventPhoto)
+		if err := rows.StructScan(photo); err != nil {
+			return nil, fmt.Errorf("failed to scan event photo: %w", err)
+		}
+		photos = append(photos, photo)
+	}
+
+	return photos, rows.Err()
+}
+
+func (r *eventRepository) CountPhotos(tx Querier, eventId string) (int64, error) {
+	var count int64
+	if err := tx.Get(&count, `SELECT COUNT(*) FROM photos WHERE event_id = $1 AND deleted_at IS NULL`, eventId); err != nil {
+		return 0, fmt.Errorf("failed to count event photos: %w", err)
+	}
+
+	return count, nil
+}
@@ -2,12 +2,17 @@ package repository
 
 import (
 	"be-yourmoments/photo-svc/internal/entity"
+	"be-yourmoments/photo-svc/internal/enum"
 	"fmt"
 	"log"
 )
 
 type PhotoDetailRepository interface {
 	Create(tx Querier, photoDetail *entity.PhotoDetail) (*entity.PhotoDetail, error)
+	// Upsert replaces the rendition of the same type, so registering it again is harmless.
+	Upsert(tx Querier, photoDetail *entity.PhotoDetail) (*entity.PhotoDetail, error)
+	FindByPhotoId(tx Querier, photoId string) ([]*entity.PhotoDetail, error)
+	DeleteByPhotoId(tx Querier, photoId string, keep enum.YourMomentsType) ([]*entity.PhotoDetail, error)
 }
 
 type photoDetailRepository struct {
@@ -34,14 +39,72 @@ func (r *photoDetailRepository) Create(tx Querier, photoDetail *entity.PhotoDeta
 	return photoDetail, nil
 }
 
-// func (r *photoDetailRepository) Update(ctx context.Context, db Querier, req *model.RequestUpdatePhoto) error {
+func (r *photoDetailRepository) Upsert(tx Querier, photoDetail *entity.PhotoDetail) (*entity.PhotoDetail, error) {
+	query := `INSERT INTO photo_details
+			  (id, photo_id, file_name, file_key, size, type, checksum, width, height, url, your_moments_type, created_at, updated_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+			  ON CONFLICT (photo_id, your_moments_type) DO UPDATE
+			  SET file_name = EXCLUDED.file_name, file_key = EXCLUDED.file_key, size = EXCLUDED.size, type = EXCLUDED.type,
+			  checksum = EXCLUDED.checksum, width = EXCLUDED.width, height = EXCLUDED.height, url = EXCLUDED.url,
+			  updated_at = EXCLUDED.updated_at
+			  RETURNING id, created_at`
 
-// 	query := `UPDATE INTO user_simillars (id, user_id, size, url, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6) RETURNING (id)`
+	err := tx.QueryRowx(query, photoDetail.Id, photoDetail.PhotoId, photoDetail.FileName, photoDetail.FileKey, photoDetail.Size,
+		photoDetail.Type, photoDetail.Checksum, photoDetail.Width, photoDetail.Height, photoDetail.Url, photoDetail.YourMomentsType,
+		photoDetail.CreatedAt, photoDetail.UpdatedAt).Scan(&photoDetail.Id, &photoDetail.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert photoDetail: %w", err)
+	}
+
+	return photoDetail, nil
+}
 
-// 	err := db.Exec(ctx, query)
-// 	if err != nil {
-// 		return err
-// 	}
+// FindByPhotoId returns the newest rendition of every type stored for the photo.
+func (r *photoDetailRepository) FindByPhotoId(tx Querier, photoId string) ([]*entity.PhotoDetail, error) {
+	query := `SELECT DISTINCT ON (your_moments_type) id, photo_id, file_name, file_key, size, type,
+			  COALESCE(checksum, '') AS checksum, width, height, COALESCE(url, '') AS url, your_moments_type,
+			  created_at, updated_at
+			  FROM photo_details WHERE photo_id = $1
+			  ORDER BY your_moments_type, created_at DESC`
+
+	rows, err := tx.Queryx(query, photoId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get photo details: %w", err)
+	}
+	defer rows.Close()
+
+	var photoDetails []*entity.PhotoDetail
+	for rows.Next() {
+		photoDetail := new(entity.PhotoDetail)
+		if err := rows.StructScan(photoDetail); err != nil {
+			return nil, fmt.Errorf("failed to scan photo detail: %w", err)
+		}
+		photoDetails = append(photoDetails, photoDetail)
+	}
 
-// 	return nil
-// }
+	return photoDetails, rows.Err()
+}
+
+// DeleteByPhotoId drops every rendition of the photo except those of type keep, an empty keep drops
+// them all. It returns the deleted renditions so their files can be removed from storage.
+func (r *photoDetailRepository) DeleteByPhotoId(tx Querier, photoId string, keep enum.YourMomentsType) ([]*entity.PhotoDetail, error) {
+	query := `DELETE FROM photo_details WHERE photo_id = $1 AND ($2 = '' OR your_moments_type::text <> $2)
+			  RETURNING id, photo_id, file_name, file_key, your_moments_type`
+
+	rows, err := tx.Queryx(query, photoId, keep)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete photo details: %w", err)
+	}
+	defer rows.Close()
+
+	var photoDetails []*entity.PhotoDetail
+	for rows.Next() {
+		photoDetail := new(entity.PhotoDetail)
+		if err := rows.StructScan(photoDetail); err != nil {
+			return nil, fmt.Errorf("failed to scan photo detail: %w", err)
+		}
+		photoDetails = append(photoDetails, photoDetail)
+	}
+
+	return photoDetails, rows.Err()
+}
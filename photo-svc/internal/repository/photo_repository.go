@@ -2,16 +2,37 @@ package repository
 
 import (
 	"be-yourmoments/photo-svc/internal/entity"
+	"be-yourmoments/photo-svc/internal/enum"
+	"errors"
 	"fmt"
 	"log"
 )
 
+var ErrPhotoAlreadyOwned = errors.New("photo is already owned by another user")
+var ErrPhotoUnavailable = errors.New("photo is owned or reserved by another user")
+
 type PhotoRepository interface {
 	Create(tx Querier, photo *entity.Photo) (*entity.Photo, error)
 	UpdateProcessedUrl(tx Querier, photo *entity.Photo) error
 	UpdateCompressedUrl(tx Querier, photo *entity.Photo) error
-	// UpdateClaimedPhoto(ctx context.Context, db Querier, photo *entity.Photo) error
-	// UpdatePhotoStatus(ctx context.Context, db Querier, photo *entity.Photo) error
+	FindById(tx Querier, id string) (*entity.Photo, error)
+	SoftDelete(tx Querier, photo *entity.Photo) error
+	UpdateOwner(tx Querier, photo *entity.Photo) error
+	ClearOwner(tx Querier, photo *entity.Photo) (bool, error)
+	FindCartByUserId(tx Querier, userId string) ([]*entity.Photo, error)
+	Reserve(tx Querier, photo *entity.Photo) error
+	CancelReservation(tx Querier, photo *entity.Photo) (bool, error)
+	ReleaseExpiredReservations(tx Querier) (int64, error)
+	FindByCreator(tx Querier, filter *CreatorPhotoFilter, limit, offset int) ([]*entity.CreatorPhoto, error)
+	SummarizeByCreator(tx Querier, filter *CreatorPhotoFilter) (*entity.CreatorPhotoSummary, error)
+}
+
+// CreatorPhotoFilter selects the photos of one photographer, empty fields match everything.
+// A reservation that ran out counts as AVAILABLE.
+type CreatorPhotoFilter struct {
+	CreatorId string
+	EventId   string
+	Status    enum.PhotoStatus
 }
 
 type photoRepository struct {
@@ -23,11 +44,11 @@ func NewPhotoRepository() PhotoRepository {
 
 func (r *photoRepository) Create(tx Querier, photo *entity.Photo) (*entity.Photo, error) {
 	query := `INSERT INTO photos 
-			  (id, creator_id, title, collection_url, price, price_str, original_at, created_at, updated_at) 
-			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+			  (id, creator_id, title, collection_url, event_id, price, price_str, original_at, created_at, updated_at) 
+			  VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, $7, $8, $9, $10)`
 
-	_, err := tx.Exec(query, photo.Id, photo.CreatorId, photo.Title, photo.CollectionUrl, photo.Price, photo.PriceStr,
-		photo.OriginalAt, photo.CreatedAt, photo.UpdatedAt)
+	_, err := tx.Exec(query, photo.Id, photo.CreatorId, photo.Title, photo.CollectionUrl, photo.EventId, photo.Price,
+		photo.PriceStr, photo.OriginalAt, photo.CreatedAt, photo.UpdatedAt)
 
 	if err != nil {
 		log.Println(err)
@@ -63,30 +84,248 @@ func (r *photoRepository) UpdateProcessedUrl(tx Querier, photo *entity.Photo) er
 	return nil
 }
 
-// func (r *photoRepository) UpdateClaimedPhoto(ctx context.Context, db Querier, photo *entity.Photo) error {
-// 	query := `UPDATE photos
-// 	          SET owned_by_user_id = :owned_by_user_id, updated_at = $3
-// 	          WHERE id = $4`
+func (r *photoRepository) FindById(tx Querier, id string) (*entity.Photo, error) {
+	query := `SELECT id, creator_id, title, COALESCE(owned_by_user_id, '') AS owned_by_user_id,
+			  COALESCE(compressed_url, '') AS compressed_url, COALESCE(is_this_you_url, '') AS is_this_you_url,
+			  COALESCE(your_moments_url, '') AS your_moments_url, COALESCE(collection_url, '') AS collection_url,
+			  COALESCE(event_id, '') AS event_id,
+			  status, COALESCE(reserved_by_user_id, '') AS reserved_by_user_id, reserved_until,
+			  price, price_str, original_at, created_at, updated_at, deleted_at
+			  FROM photos WHERE id = $1`
+
+	photo := new(entity.Photo)
+	if err := tx.Get(photo, query, id); err != nil {
+		return nil, err
+	}
+
+	return photo, nil
+}
+
+// SoftDelete unpublishes the photo and drops its reservation. It refuses a photo someone holds an
+// active reservation for, as they may be paying for it right now.
+func (r *photoRepository) SoftDelete(tx Querier, photo *entity.Photo) error {
+	query := `UPDATE photos
+			  SET deleted_at = $1, updated_at = $1,
+			  status = CASE WHEN owned_by_user_id IS NULL THEN 'AVAILABLE'::photo_status ELSE status END,
+			  reserved_by_user_id = NULL, reserved_until = NULL
+			  WHERE id = $2 AND deleted_at IS NULL
+			  AND NOT (status = 'RESERVED' AND reserved_until > now())`
+
+	result, err := tx.Exec(query, photo.DeletedAt, photo.Id)
+	if err != nil {
+		return fmt.Errorf("failed to delete photo: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete photo: %w", err)
+	}
+
+	if affected == 0 {
+		return ErrPhotoUnavailable
+	}
+
+	return nil
+}
+
+// UpdateOwner only claims photos that are not owned yet (or already owned by the same user) and not
+// reserved by someone else, so a concurrent purchase by another user can never overwrite the current owner.
+func (r *photoRepository) UpdateOwner(tx Querier, photo *entity.Photo) error {
+	query := `UPDATE photos 
+			  SET owned_by_user_id = $1, status = 'OWNED', reserved_by_user_id = NULL, reserved_until = NULL,
+			  updated_at = $2
+			  WHERE id = $3 AND deleted_at IS NULL AND (owned_by_user_id IS NULL OR owned_by_user_id = $1)
+			  AND NOT (status = 'RESERVED' AND reserved_by_user_id <> $1 AND reserved_until > now())`
+
+	result, err := tx.Exec(query, photo.OwnedByUserId, photo.UpdatedAt, photo.Id)
+	if err != nil {
+		return fmt.Errorf("failed to update photo owner: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to update photo owner: %w", err)
+	}
+
+	if affected == 0 {
+		return ErrPhotoAlreadyOwned
+	}
+
+	return nil
+}
+
+// ClearOwner removes the owner only if it is still photo.OwnedByUserId and reports whether it did.
+// Clearing a photo that is not owned by that user is a no-op, so a retried refund does not fail.
+func (r *photoRepository) ClearOwner(tx Querier, photo *entity.Photo) (bool, error) {
+	query := `UPDATE photos 
+			  SET owned_by_user_id = NULL, status = 'AVAILABLE', updated_at = $1
+			  WHERE id = $2 AND owned_by_user_id = $3`
+
+	result, err := tx.Exec(query, photo.UpdatedAt, photo.Id, photo.OwnedByUserId)
+	if err != nil {
+		return false, fmt.Errorf("failed to clear photo owner: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to clear photo owner: %w", err)
+	}
+
+	return affected > 0, nil
+}
+
+func (r *photoRepository) FindCartByUserId(tx Querier, userId string) ([]*entity.Photo, error) {
+	query := `SELECT p.id, p.creator_id, p.title, COALESCE(p.owned_by_user_id, '') AS owned_by_user_id,
+			  COALESCE(p.compressed_url, '') AS compressed_url, COALESCE(p.is_this_you_url, '') AS is_this_you_url,
+			  COALESCE(p.your_moments_url, '') AS your_moments_url, COALESCE(p.collection_url, '') AS collection_url,
+			  COALESCE(p.event_id, '') AS event_id,
+			  p.status, COALESCE(p.reserved_by_user_id, '') AS reserved_by_user_id, p.reserved_until,
+			  p.price, p.price_str, p.original_at, p.created_at, p.updated_at,
+			  COALESCE((SELECT d.file_key FROM photo_details d WHERE d.photo_id = p.id AND d.your_moments_type = 'ISYOU'
+			    ORDER BY d.created_at DESC LIMIT 1), '') AS preview_file_key
+			  FROM photos p
+			  INNER JOIN user_similar_photos usp ON p.id = usp.photo_id
+			  WHERE usp.user_id = $1 AND usp.is_cart = TRUE
+			  ORDER BY usp.updated_at`
+
+	rows, err := tx.Queryx(query, userId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cart: %w", err)
+	}
+	defer rows.Close()
+
+	var photos []*entity.Photo
+	for rows.Next() {
+		photo := new(entity.Photo)
+		if err := rows.StructScan(photo); err != nil {
+			return nil, fmt.Errorf("failed to scan cart photo: %w", err)
+		}
+		photos = append(photos, photo)
+	}
+
+	return photos, rows.Err()
+}
+
+// Reserve holds an unowned photo for photo.ReservedByUserId until photo.ReservedUntil. A user may
+// extend their own reservation and take over one that already ran out.
+func (r *photoRepository) Reserve(tx Querier, photo *entity.Photo) error {
+	query := `UPDATE photos
+			  SET status = 'RESERVED', reserved_by_user_id = $1, reserved_until = $2, updated_at = $3
+			  WHERE id = $4 AND owned_by_user_id IS NULL AND deleted_at IS NULL
+			  AND (status <> 'RESERVED' OR reserved_by_user_id = $1 OR reserved_until <= now())`
+
+	result, err := tx.Exec(query, photo.ReservedByUserId, photo.ReservedUntil, photo.UpdatedAt, photo.Id)
+	if err != nil {
+		return fmt.Errorf("failed to reserve photo: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to reserve photo: %w", err)
+	}
+
+	if affected == 0 {
+		return ErrPhotoUnavailable
+	}
+
+	return nil
+}
+
+// CancelReservation only releases a reservation held by photo.ReservedByUserId and reports whether it did.
+func (r *photoRepository) CancelReservation(tx Querier, photo *entity.Photo) (bool, error) {
+	query := `UPDATE photos
+			  SET status = 'AVAILABLE', reserved_by_user_id = NULL, reserved_until = NULL, updated_at = $1
+			  WHERE id = $2 AND status = 'RESERVED' AND reserved_by_user_id = $3`
 
-// 	_, err := db.NamedExecContext(ctx, query, photo)
+	result, err := tx.Exec(query, photo.UpdatedAt, photo.Id, photo.ReservedByUserId)
+	if err != nil {
+		return false, fmt.Errorf("failed to cancel photo reservation: %w", err)
+	}
 
-// 	if err != nil {
-// 		return err
-// 	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to cancel photo reservation: %w", err)
+	}
 
-// 	return nil
-// }
+	return affected > 0, nil
+}
 
-// func (r *photoRepository) UpdatePhotoStatus(ctx context.Context, db Querier, photo *entity.Photo) error {
-// 	query := `UPDATE photos
-// 	          SET status = $1, updated_at = $3
-// 	          WHERE id = $4`
+// ReleaseExpiredReservations makes photos whose reservation ran out AVAILABLE again. Expired
+// reservations already stop blocking other users before this runs, it only keeps status accurate.
+func (r *photoRepository) ReleaseExpiredReservations(tx Querier) (int64, error) {
+	query := `UPDATE photos
+			  SET status = 'AVAILABLE', reserved_by_user_id = NULL, reserved_until = NULL, updated_at = now()
+			  WHERE status = 'RESERVED' AND reserved_until <= now()`
 
-// 	_, err := db.Exec(ctx, query, photo.Status, photo.UpdatedAt, photo.Id)
+	result, err := tx.Exec(query)
+	if err != nil {
+		return 0, fmt.Errorf("failed to release expired photo reservations: %w", err)
+	}
 
-// 	if err != nil {
-// 		return err
-// 	}
+	return result.RowsAffected()
+}
+
+// creatorPhotosQuery lists the photos matching a CreatorPhotoFilter given as $1 to $3 with their
+// pipeline state and match count. Matches moved to sold_user_similar_photos still count, and sold
+// photos stay listed after they were unpublished so the sales totals do not shrink.
+const creatorPhotosQuery = `
+	SELECT p.id, p.title, COALESCE(p.event_id, '') AS event_id,
+		   COALESCE((SELECT d.file_key FROM photo_details d WHERE d.photo_id = p.id AND d.your_moments_type = 'COLLECTION'
+			 ORDER BY d.created_at DESC LIMIT 1), '') AS collection_file_key,
+		   COALESCE((SELECT d.file_key FROM photo_details d WHERE d.photo_id = p.id AND d.your_moments_type = 'COMPRESSED'
+			 ORDER BY d.created_at DESC LIMIT 1), '') AS compressed_file_key,
+		   p.price, p.price_str,
+		   CASE WHEN p.status = 'RESERVED' AND p.reserved_until <= now() THEN 'AVAILABLE' ELSE p.status::text END AS status,
+		   EXISTS (SELECT 1 FROM photo_details d WHERE d.photo_id = p.id AND d.your_moments_type = 'COLLECTION') AS has_collection,
+		   EXISTS (SELECT 1 FROM photo_details d WHERE d.photo_id = p.id AND d.your_moments_type = 'COMPRESSED') AS has_compressed,
+		   EXISTS (SELECT 1 FROM photo_details d WHERE d.photo_id = p.id AND d.your_moments_type = 'YOU') AS has_ai_result,
+		   (SELECT COUNT(*) FROM user_similar_photos usp WHERE usp.photo_id = p.id) +
+		   (SELECT COUNT(*) FROM sold_user_similar_photos susp WHERE susp.photo_id = p.id) AS match_count,
+		   p.original_at, p.created_at
+	FROM photos p
+	WHERE p.creator_id = $1 AND (p.deleted_at IS NULL OR p.owned_by_user_id IS NOT NULL)
+	  AND ($2 = '' OR p.event_id = $2)`
+
+const creatorPhotosStatusCondition = ` WHERE ($3 = '' OR status = $3)`
 
-// 	return nil
-// }
+// FindByCreator returns the most recently uploaded photos first.
+func (r *photoRepository) FindByCreator(tx Querier, filter *CreatorPhotoFilter, limit, offset int) ([]*entity.CreatorPhoto, error) {
+	query := `SELECT * FROM (` + creatorPhotosQuery + `) creator_photos` + creatorPhotosStatusCondition +
+		` ORDER BY created_at DESC, id LIMIT $4 OFFSET $5`
+
+	rows, err := tx.Queryx(query, filter.CreatorId, filter.EventId, filter.Status, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get creator photos: %w", err)
+	}
+	defer rows.Close()
+
+	var photos []*entity.CreatorPhoto
+	for rows.Next() {
+		photo := new(entity.CreatorPhoto)
+		if err := rows.StructScan(photo); err != nil {
+			return nil, fmt.Errorf("failed to scan creator photo: %w", err)
+		}
+		photos = append(photos, photo)
+	}
+
+	return photos, rows.Err()
+}
+
+func (r *photoRepository) SummarizeByCreator(tx Querier, filter *CreatorPhotoFilter) (*entity.CreatorPhotoSummary, error) {
+	query := `SELECT COUNT(*) AS total_photos,
+		COUNT(*) FILTER (WHERE has_compressed) AS compressed_photos,
+		COUNT(*) FILTER (WHERE has_ai_result) AS processed_photos,
+		COUNT(*) FILTER (WHERE match_count > 0) AS matched_photos,
+		COALESCE(SUM(match_count), 0) AS total_matches,
+		COUNT(*) FILTER (WHERE status = 'RESERVED') AS reserved_photos,
+		COUNT(*) FILTER (WHERE status = 'OWNED') AS sold_photos,
+		COALESCE(SUM(price) FILTER (WHERE status = 'OWNED'), 0) AS sold_amount
+		FROM (` + creatorPhotosQuery + `) creator_photos` + creatorPhotosStatusCondition
+
+	summary := new(entity.CreatorPhotoSummary)
+	if err := tx.Get(summary, query, filter.CreatorId, filter.EventId, filter.Status); err != nil {
+		return nil, fmt.Errorf("failed to summarize creator photos: %w", err)
+	}
+
+	return summary, nil
+}
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"be-yourmoments/photo-svc/internal/entity"
+	"fmt"
+)
+
+type UserSettingRepository interface {
+	Upsert(tx Querier, userSetting *entity.UserSetting) error
+}
+
+type userSettingRepository struct {
+}
+
+func NewUserSettingRepository() UserSettingRepository {
+	return &userSettingRepository{}
+}
+
+// Upsert ignores settings older than the stored one, so a late retry can not undo a newer change.
+func (r *userSettingRepository) Upsert(tx Querier, userSetting *entity.UserSetting) error {
+	query := `INSERT INTO user_settings (user_id, similarity, updated_at) VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE SET similarity = EXCLUDED.similarity, updated_at = EXCLUDED.updated_at
+		WHERE user_settings.updated_at <= EXCLUDED.updated_at`
+
+	if _, err := tx.Exec(query, userSetting.UserId, userSetting.Similarity, userSetting.UpdatedAt); err != nil {
+		return fmt.Errorf("failed to upsert user setting: %w", err)
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"be-yourmoments/photo-svc/internal/entity"
+	"be-yourmoments/photo-svc/internal/enum"
 	"fmt"
 	"log"
 	"strings"
@@ -11,9 +12,25 @@ import (
 type UserSimilarRepository interface {
 	InsertOrUpdate(tx Querier, photoId string, userSimilarPhotos *[]*entity.UserSimilarPhoto) error
 	InserOrUpdateByUserId(tx Querier, userId string, userSimilarPhotos *[]*entity.UserSimilarPhoto) error
-	// UpdateUsersForPhoto(ctx context.Context, db Querier, photoId string, userIds []string) error
-	// GetSimilarPhotosByUser(ctx context.Context, db Querier, userId string) (*UserSimilarPhotosResponse, error)
-	// DeleteSimilarUsers(ctx context.Context, db Querier, photoId string) error
+	MoveToSold(tx Querier, photoId string, ownerId string) error
+	RestoreFromSold(tx Querier, photoId string) error
+	DeleteByPhotoId(tx Querier, photoId string) error
+	UpdateCart(tx Querier, photoId string, userId string, isCart bool) (bool, error)
+	UpdateFlag(tx Querier, photoId string, userId string, flag enum.UserSimilarFlagEnum, value bool) (bool, error)
+	IsMatched(tx Querier, photoId string, userId string) (bool, error)
+	FindMatchedPhotos(tx Querier, filter *MatchedPhotoFilter, limit, offset int) ([]*entity.MatchedPhoto, error)
+	CountMatchedPhotos(tx Querier, filter *MatchedPhotoFilter) (int64, error)
+}
+
+// MatchedPhotoFilter selects a user's matched photos, empty and nil fields match everything.
+// From and To bound the time the photo was taken, From inclusive and To exclusive.
+type MatchedPhotoFilter struct {
+	UserId     string
+	Similarity enum.SimilarityLevelEnum
+	From       *time.Time
+	To         *time.Time
+	IsFavorite *bool
+	IsWishlist *bool
 }
 
 type userSimilarRepository struct {
@@ -23,6 +40,13 @@ func NewUserSimilarRepository() UserSimilarRepository {
 	return &userSimilarRepository{}
 }
 
+// skipSoldMatches leaves out users whose match was moved to sold_user_similar_photos, the photo was
+// sold to someone else and matching it again must not give it back to them.
+const skipSoldMatches = ` WHERE NOT EXISTS (SELECT 1 FROM sold_user_similar_photos s
+	WHERE s.photo_id = v.photo_id AND s.user_id = v.user_id)`
+
+// InsertOrUpdate replaces the users matched with a photo. Users that are still matched keep their
+// wishlist, resend, cart and favorite flags, only their similarity is refreshed.
 func (r *userSimilarRepository) InsertOrUpdate(tx Querier, photoId string, userSimilarPhotos *[]*entity.UserSimilarPhoto) error {
 	now := time.Now()
 	if len(*userSimilarPhotos) == 0 {
@@ -52,14 +76,15 @@ func (r *userSimilarRepository) InsertOrUpdate(tx Querier, photoId string, userS
 	placeholderCounter := 1
 	for _, userSimilarPhoto := range *userSimilarPhotos {
 		// Misalnya, baris pertama: ($1, $2, $3, $4), baris kedua: ($5, $6, $7, $8), dst.
-		insertValues = append(insertValues, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d)", placeholderCounter, placeholderCounter+1, placeholderCounter+2, placeholderCounter+3, placeholderCounter+4))
+		insertValues = append(insertValues, fmt.Sprintf("($%d, $%d, $%d::similarity_level, $%d::timestamptz, $%d::timestamptz)", placeholderCounter, placeholderCounter+1, placeholderCounter+2, placeholderCounter+3, placeholderCounter+4))
 		insertArgs = append(insertArgs, photoId, userSimilarPhoto.UserId, userSimilarPhoto.Similarity, now, now)
 		placeholderCounter += 5
 	}
 
-	insertQuery := "INSERT INTO user_similar_photos (photo_id, user_id, similarity, created_at, updated_at) VALUES " +
-		strings.Join(insertValues, ", ") +
-		" ON CONFLICT (photo_id, user_id) DO UPDATE SET updated_at = EXCLUDED.updated_at"
+	insertQuery := "INSERT INTO user_similar_photos (photo_id, user_id, similarity, created_at, updated_at) " +
+		"SELECT v.photo_id, v.user_id, v.similarity, v.created_at, v.updated_at FROM (VALUES " + strings.Join(insertValues, ", ") +
+		") AS v(photo_id, user_id, similarity, created_at, updated_at)" + skipSoldMatches +
+		" ON CONFLICT (photo_id, user_id) DO UPDATE SET similarity = EXCLUDED.similarity, updated_at = EXCLUDED.updated_at"
 
 	if _, err := tx.Exec(insertQuery, insertArgs...); err != nil {
 		log.Println("Error at insert query:", err)
@@ -69,6 +94,8 @@ func (r *userSimilarRepository) InsertOrUpdate(tx Querier, photoId string, userS
 	return nil
 }
 
+// InserOrUpdateByUserId replaces the photos matched with a user, keeping the flags of photos that
+// are still matched like InsertOrUpdate.
 func (r *userSimilarRepository) InserOrUpdateByUserId(tx Querier, userId string, userSimilarPhotos *[]*entity.UserSimilarPhoto) error {
 	now := time.Now()
 	if len(*userSimilarPhotos) == 0 {
@@ -83,7 +110,7 @@ func (r *userSimilarRepository) InserOrUpdateByUserId(tx Querier, userId string,
 	deleteArgs = append(deleteArgs, userId)
 	for i, userSimilarPhoto := range *userSimilarPhotos {
 		placeholders[i] = fmt.Sprintf("$%d", i+2)
-		deleteArgs = append(deleteArgs, userSimilarPhoto.UserId)
+		deleteArgs = append(deleteArgs, userSimilarPhoto.PhotoId)
 	}
 
 	deleteQuery := "DELETE FROM user_similar_photos WHERE user_id = $1 AND photo_id NOT IN (" + strings.Join(placeholders, ", ") + ")"
@@ -97,14 +124,15 @@ func (r *userSimilarRepository) InserOrUpdateByUserId(tx Querier, userId string,
 	placeholderCounter := 1
 	for _, userSimilarPhoto := range *userSimilarPhotos {
 		// Misalnya, baris pertama: ($1, $2, $3, $4), baris kedua: ($5, $6, $7, $8), dst.
-		insertValues = append(insertValues, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d)", placeholderCounter, placeholderCounter+1, placeholderCounter+2, placeholderCounter+3, placeholderCounter+4))
+		insertValues = append(insertValues, fmt.Sprintf("($%d, $%d, $%d::similarity_level, $%d::timestamptz, $%d::timestamptz)", placeholderCounter, placeholderCounter+1, placeholderCounter+2, placeholderCounter+3, placeholderCounter+4))
 		insertArgs = append(insertArgs, userId, userSimilarPhoto.PhotoId, userSimilarPhoto.Similarity, now, now)
 		placeholderCounter += 5
 	}
 
-	insertQuery := "INSERT INTO user_similar_photos (user_id, photo_id, similarity, created_at, updated_at) VALUES " +
-		strings.Join(insertValues, ", ") +
-		" ON CONFLICT (photo_id, user_id) DO UPDATE SET updated_at = EXCLUDED.updated_at"
+	insertQuery := "INSERT INTO user_similar_photos (photo_id, user_id, similarity, created_at, updated_at) " +
+		"SELECT v.photo_id, v.user_id, v.similarity, v.created_at, v.updated_at FROM (VALUES " + strings.Join(insertValues, ", ") +
+		") AS v(user_id, photo_id, similarity, created_at, updated_at)" + skipSoldMatches +
+		" ON CONFLICT (photo_id, user_id) DO UPDATE SET similarity = EXCLUDED.similarity, updated_at = EXCLUDED.updated_at"
 
 	if _, err := tx.Exec(insertQuery, insertArgs...); err != nil {
 		log.Println("Error at insert query:", err)
@@ -114,51 +142,188 @@ func (r *userSimilarRepository) InserOrUpdateByUserId(tx Querier, userId string,
 	return nil
 }
 
-// type UserSimilarPhotosResponse struct {
-// 	UserID string         `json:"user_id"`
-// 	Photos []PhotoPreview `json:"photos"`
-// }
-
-// type PhotoPreview struct {
-// 	ID         string `json:"id"`
-// 	PreviewUrl string `json:"preview_url"`
-// }
-
-// func (r *userSimilarRepository) GetSimilarPhotosByUser(ctx context.Context, db Querier, userId string) (*UserSimilarPhotosResponse, error) {
-// 	/*  !!! TODO !!!
-// 	Query salah, pastikan logic wishlish dihandle
-
-// 	*/
-
-// 	query := `
-// 		SELECT p.id,
-// 		       CASE
-// 		         WHEN p.owned_by_user_id IS NULL THEN p.preview_with_bounding_url
-// 		         WHEN p.owned_by_user_id = $1 THEN p.preview_url
-// 		         ELSE NULL
-// 		       END AS preview_url
-// 		FROM photos p
-// 		INNER JOIN user_similar_photos usp ON p.id = usp.photo_id
-// 		WHERE usp.user_id = $1
-// 	`
-
-// 	rows, err := db.Query(ctx, query, userId)
-// 	if err != nil {
-// 		return nil, fmt.Errorf("failed to get similar photos: %w", err)
-// 	}
-// 	defer rows.Close()
-
-// 	var photos []PhotoPreview
-// 	for rows.Next() {
-// 		var photo PhotoPreview
-// 		if err := rows.Scan(&photo.ID, &photo.PreviewUrl); err != nil {
-// 			return nil, fmt.Errorf("failed to scan photo: %w", err)
-// 		}
-// 		photos = append(photos, photo)
-// 	}
-
-// 	return &UserSimilarPhotosResponse{
-// 		UserID: userId,
-// 		Photos: photos,
-// 	}, nil
-// }
+// MoveToSold takes a sold photo away from every matched user except the owner.
+// The rows are kept in sold_user_similar_photos so a refund can bring them back.
+func (r *userSimilarRepository) MoveToSold(tx Querier, photoId string, ownerId string) error {
+	insertQuery := `INSERT INTO sold_user_similar_photos 
+		(photo_id, user_id, similarity, is_wishlist, is_resend, is_cart, is_favorite, created_at, updated_at, sold_at)
+		SELECT photo_id, user_id, similarity, is_wishlist, is_resend, is_cart, is_favorite, created_at, updated_at, $3
+		FROM user_similar_photos WHERE photo_id = $1 AND user_id <> $2
+		ON CONFLICT (photo_id, user_id) DO NOTHING`
+
+	if _, err := tx.Exec(insertQuery, photoId, ownerId, time.Now()); err != nil {
+		log.Println("Error at insert query:", err)
+		return err
+	}
+
+	if _, err := tx.Exec("DELETE FROM user_similar_photos WHERE photo_id = $1 AND user_id <> $2", photoId, ownerId); err != nil {
+		log.Println("Error at delete query:", err)
+		return err
+	}
+
+	if _, err := tx.Exec("UPDATE user_similar_photos SET is_cart = FALSE WHERE photo_id = $1 AND user_id = $2", photoId, ownerId); err != nil {
+		log.Println("Error at update query:", err)
+		return err
+	}
+
+	return nil
+}
+
+// RestoreFromSold gives a refunded photo back to the users it was matched with before the sale.
+func (r *userSimilarRepository) RestoreFromSold(tx Querier, photoId string) error {
+	insertQuery := `INSERT INTO user_similar_photos 
+		(photo_id, user_id, similarity, is_wishlist, is_resend, is_cart, is_favorite, created_at, updated_at)
+		SELECT photo_id, user_id, similarity, is_wishlist, is_resend, is_cart, is_favorite, created_at, $2
+		FROM sold_user_similar_photos WHERE photo_id = $1
+		ON CONFLICT (photo_id, user_id) DO NOTHING`
+
+	if _, err := tx.Exec(insertQuery, photoId, time.Now()); err != nil {
+		log.Println("Error at insert query:", err)
+		return err
+	}
+
+	if _, err := tx.Exec("DELETE FROM sold_user_similar_photos WHERE photo_id = $1", photoId); err != nil {
+		log.Println("Error at delete query:", err)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteByPhotoId drops the current matches of a photo. Matches already moved to
+// sold_user_similar_photos are kept so a refund can still restore them.
+func (r *userSimilarRepository) DeleteByPhotoId(tx Querier, photoId string) error {
+	if _, err := tx.Exec("DELETE FROM user_similar_photos WHERE photo_id = $1", photoId); err != nil {
+		return fmt.Errorf("failed to delete user similar photos: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateCart reports false when the photo was never matched with the user.
+func (r *userSimilarRepository) UpdateCart(tx Querier, photoId string, userId string, isCart bool) (bool, error) {
+	query := `UPDATE user_similar_photos SET is_cart = $1, updated_at = $2 WHERE photo_id = $3 AND user_id = $4`
+
+	result, err := tx.Exec(query, isCart, time.Now(), photoId, userId)
+	if err != nil {
+		return false, fmt.Errorf("failed to update cart: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to update cart: %w", err)
+	}
+
+	return affected > 0, nil
+}
+
+// userSimilarFlagColumns whitelists the columns UpdateFlag may write.
+var userSimilarFlagColumns = map[enum.UserSimilarFlagEnum]string{
+	enum.UserSimilarFlagWishlist: "is_wishlist",
+	enum.UserSimilarFlagFavorite: "is_favorite",
+	enum.UserSimilarFlagResend:   "is_resend",
+}
+
+// UpdateFlag reports false when the photo was never matched with the user.
+func (r *userSimilarRepository) UpdateFlag(tx Querier, photoId string, userId string, flag enum.UserSimilarFlagEnum, value bool) (bool, error) {
+	column, ok := userSimilarFlagColumns[flag]
+	if !ok {
+		return false, fmt.Errorf("unknown user similar flag %q", flag)
+	}
+
+	query := `UPDATE user_similar_photos SET ` + column + ` = $1, updated_at = $2 WHERE photo_id = $3 AND user_id = $4`
+
+	result, err := tx.Exec(query, value, time.Now(), photoId, userId)
+	if err != nil {
+		return false, fmt.Errorf("failed to update %s: %w", column, err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to update %s: %w", column, err)
+	}
+
+	return affected > 0, nil
+}
+
+func (r *userSimilarRepository) IsMatched(tx Querier, photoId string, userId string) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM user_similar_photos WHERE photo_id = $1 AND user_id = $2)`
+
+	var matched bool
+	if err := tx.Get(&matched, query, photoId, userId); err != nil {
+		return false, fmt.Errorf("failed to check user similar photo: %w", err)
+	}
+
+	return matched, nil
+}
+
+// matchedPhotosQuery lists the user's current matches together with the matches of photos someone
+// else bought, which were moved to sold_user_similar_photos. It takes the MatchedPhotoFilter fields
+// as $1 to $6 and never exposes a file of a photo owned by another user. Unpublished photos are
+// only listed for the user who bought them. Matches below the user's
+// similarity threshold are left out here rather than when they are stored, so lowering the
+// threshold brings them back.
+const matchedPhotosQuery = `
+	SELECT p.id AS photo_id, p.creator_id, p.title,
+		   COALESCE((SELECT d.file_key FROM photo_details d
+			 WHERE d.photo_id = p.id AND d.your_moments_type = CASE
+			   WHEN p.owned_by_user_id IS NULL THEN 'ISYOU'::your_moments_type
+			   WHEN p.owned_by_user_id = $1 THEN 'COLLECTION'::your_moments_type
+			 END
+			 ORDER BY d.created_at DESC LIMIT 1), '') AS preview_file_key,
+		   p.price, p.price_str,
+		   COALESCE(p.owned_by_user_id = $1, FALSE) AS is_owned,
+		   COALESCE(p.owned_by_user_id <> $1, FALSE) AS is_sold,
+		   m.similarity, COALESCE(m.is_wishlist, FALSE) AS is_wishlist, COALESCE(m.is_resend, FALSE) AS is_resend,
+		   COALESCE(m.is_cart, FALSE) AS is_cart, COALESCE(m.is_favorite, FALSE) AS is_favorite,
+		   p.original_at, m.created_at AS matched_at
+	FROM (
+		SELECT photo_id, user_id, similarity, is_wishlist, is_resend, is_cart, is_favorite, created_at
+		FROM user_similar_photos WHERE user_id = $1
+		UNION ALL
+		SELECT photo_id, user_id, similarity, is_wishlist, is_resend, is_cart, is_favorite, created_at
+		FROM sold_user_similar_photos WHERE user_id = $1
+	) m
+	INNER JOIN photos p ON p.id = m.photo_id
+	WHERE (p.deleted_at IS NULL OR p.owned_by_user_id = $1)
+	  AND m.similarity >= COALESCE((SELECT similarity FROM user_settings WHERE user_id = $1), '` + string(enum.DefaultSimilarityThreshold) + `')
+	  AND ($2 = '' OR m.similarity::text = $2)
+	  AND ($3::timestamptz IS NULL OR p.original_at >= $3)
+	  AND ($4::timestamptz IS NULL OR p.original_at < $4)
+	  AND ($5::boolean IS NULL OR COALESCE(m.is_favorite, FALSE) = $5)
+	  AND ($6::boolean IS NULL OR COALESCE(m.is_wishlist, FALSE) = $6)`
+
+// FindMatchedPhotos returns the newest photos first.
+func (r *userSimilarRepository) FindMatchedPhotos(tx Querier, filter *MatchedPhotoFilter, limit, offset int) ([]*entity.MatchedPhoto, error) {
+	query := matchedPhotosQuery + ` ORDER BY p.original_at DESC, p.id LIMIT $7 OFFSET $8`
+
+	rows, err := tx.Queryx(query, filter.UserId, filter.Similarity, filter.From, filter.To, filter.IsFavorite,
+		filter.IsWishlist, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get matched photos: %w", err)
+	}
+	defer rows.Close()
+
+	var photos []*entity.MatchedPhoto
+	for rows.Next() {
+		photo := new(entity.MatchedPhoto)
+		if err := rows.StructScan(photo); err != nil {
+			return nil, fmt.Errorf("failed to scan matched photo: %w", err)
+		}
+		photos = append(photos, photo)
+	}
+
+	return photos, rows.Err()
+}
+
+func (r *userSimilarRepository) CountMatchedPhotos(tx Querier, filter *MatchedPhotoFilter) (int64, error) {
+	query := `SELECT COUNT(*) FROM (` + matchedPhotosQuery + `) matched`
+
+	var count int64
+	if err := tx.Get(&count, query, filter.UserId, filter.Similarity, filter.From, filter.To, filter.IsFavorite,
+		filter.IsWishlist); err != nil {
+		return 0, fmt.Errorf("failed to count matched photos: %w", err)
+	}
+
+	return count, nil
+}
@@ -0,0 +1,193 @@
+package usecase
+
+import (
+	"be-yourmoments/photo-svc/internal/adapter"
+	"be-yourmoments/photo-svc/internal/entity"
+	"be-yourmoments/photo-svc/internal/enum"
+	"be-yourmoments/photo-svc/internal/model"
+	"be-yourmoments/photo-svc/internal/repository"
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/jmoiron/sqlx"
+	"github.com/oklog/ulid/v2"
+)
+
+type EventUsecase interface {
+	CreateEvent(ctx context.Context, request *model.EventRequest) (*entity.Event, error)
+	UpdateEvent(ctx context.Context, request *model.EventRequest) (*entity.Event, error)
+	GetEvent(ctx context.Context, id string) (*entity.Event, error)
+	GetEvents(ctx context.Context, request *model.EventsRequest) ([]*entity.Event, int64, error)
+	GetEventPhotos(ctx context.Context, request *model.EventPhotosRequest) ([]*entity.EventPhoto, int64, error)
+}
+
+type eventUsecase struct {
+	db            *sqlx.DB
+	eventRepo     repository.EventRepository
+	uploadAdapter adapter.UploadAdapter
+}
+
+func NewEventUsecase(db *sqlx.DB, eventRepo repository.EventRepository, uploadAdapter adapter.UploadAdapter) EventUsecase {
+	return &eventUsecase{
+		db:            db,
+		eventRepo:     eventRepo,
+		uploadAdapter: uploadAdapter,
+	}
+}
+
+func (u *eventUsecase) CreateEvent(ctx context.Context, request *model.EventRequest) (*entity.Event, error) {
+	if request.CreatorId == "" {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "creator_id is required")
+	}
+
+	now := time.Now()
+	event := &entity.Event{
+		Id:        ulid.Make().String(),
+		CreatorId: request.CreatorId,
+		CreatedAt: now,
+	}
+	if err := setEventFields(event, request); err != nil {
+		return nil, err
+	}
+
+	if err := u.eventRepo.Create(u.db, event); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// UpdateEvent replaces every field but the creator. The default price only applies to photos
+// uploaded afterwards.
+func (u *eventUsecase) UpdateEvent(ctx context.Context, request *model.EventRequest) (*entity.Event, error) {
+	event, err := u.GetEvent(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if event.CreatorId != request.CreatorId {
+		return nil, fiber.NewError(fiber.StatusForbidden, "event belongs to another photographer")
+	}
+
+	if err := setEventFields(event, request); err != nil {
+		return nil, err
+	}
+
+	if err := u.eventRepo.Update(u.db, event); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return event, nil
+}
+
+func (u *eventUsecase) GetEvent(ctx context.Context, id string) (*entity.Event, error) {
+	event, err := u.eventRepo.FindById(u.db, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fiber.NewError(fiber.StatusNotFound, "event not found")
+		}
+		log.Println(err)
+		return nil, err
+	}
+
+	return event, nil
+}
+
+func (u *eventUsecase) GetEvents(ctx context.Context, request *model.EventsRequest) ([]*entity.Event, int64, error) {
+	if err := normalizePage(&request.Page, &request.Size); err != nil {
+		return nil, 0, err
+	}
+	if request.From != nil && request.To != nil && request.To.Before(*request.From) {
+		return nil, 0, fiber.NewError(fiber.StatusBadRequest, "to can not be before from")
+	}
+
+	filter := &repository.EventFilter{
+		Query:     strings.TrimSpace(request.Query),
+		CreatorId: request.CreatorId,
+		From:      request.From,
+		To:        request.To,
+	}
+
+	total, err := u.eventRepo.CountByFilter(u.db, filter)
+	if err != nil {
+		log.Println(err)
+		return nil, 0, err
+	}
+
+	events, err := u.eventRepo.FindByFilter(u.db, filter, request.Size, (request.Page-1)*request.Size)
+	if err != nil {
+		log.Println(err)
+		return nil, 0, err
+	}
+
+	return events, total, nil
+}
+
+// GetEventPhotos is public so users can browse an event before their facecam is matched.
+func (u *eventUsecase) GetEventPhotos(ctx context.Context, request *model.EventPhotosRequest) ([]*entity.EventPhoto, int64, error) {
+	if err := normalizePage(&request.Page, &request.Size); err != nil {
+		return nil, 0, err
+	}
+
+	if _, err := u.GetEvent(ctx, request.EventId); err != nil {
+		return nil, 0, err
+	}
+
+	total, err := u.eventRepo.CountPhotos(u.db, request.EventId)
+	if err != nil {
+		log.Println(err)
+		return nil, 0, err
+	}
+
+	photos, err := u.eventRepo.FindPhotos(u.db, request.EventId, request.Size, (request.Page-1)*request.Size)
+	if err != nil {
+		log.Println(err)
+		return nil, 0, err
+	}
+
+	for _, photo := range photos {
+		if photo.PreviewUrl, err = signFileKey(ctx, u.uploadAdapter, photo.PreviewFileKey, enum.PhotoAccessPublic); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return photos, total, nil
+}
+
+func setEventFields(event *entity.Event, request *model.EventRequest) error {
+	name := strings.TrimSpace(request.Name)
+	if name == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "name is required")
+	}
+
+	date, err := time.Parse(time.DateOnly, request.Date)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "date must be a YYYY-MM-DD date")
+	}
+
+	if request.DefaultPrice < 0 {
+		return fiber.NewError(fiber.StatusBadRequest, "default_price can not be negative")
+	}
+
+	event.Name = name
+	event.Description = request.Description
+	event.Location = strings.TrimSpace(request.Location)
+	event.Date = date
+	event.CoverUrl = request.CoverUrl
+	event.DefaultPrice = request.DefaultPrice
+	event.DefaultPriceStr = request.DefaultPriceStr
+	if event.DefaultPriceStr == "" {
+		event.DefaultPriceStr = strconv.Itoa(int(request.DefaultPrice))
+	}
+	event.UpdatedAt = time.Now()
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package usecase
+
+import "github.com/gofiber/fiber/v2"
+
+// normalizePage falls back to the first page of 20 for a zero page or size.
+func normalizePage(page, size *int) error {
+	if *page == 0 {
+		*page = 1
+	}
+	if *size == 0 {
+		*size = 20
+	}
+	if *page < 1 || *size < 1 || *size > 100 {
+		return fiber.NewError(fiber.StatusBadRequest, "page must be positive and size between 1 and 100")
+	}
+
+	return nil
+}
@@ -4,12 +4,16 @@ import (
 	"be-yourmoments/photo-svc/internal/adapter"
 	"be-yourmoments/photo-svc/internal/entity"
 	"be-yourmoments/photo-svc/internal/enum"
+	"be-yourmoments/photo-svc/internal/model"
 	"be-yourmoments/photo-svc/internal/pb"
 	"be-yourmoments/photo-svc/internal/repository"
 	"context"
+	"database/sql"
+	"errors"
 	"log"
 	"time"
 
+	"github.com/gofiber/fiber/v2"
 	"github.com/jmoiron/sqlx"
 	"github.com/oklog/ulid/v2"
 )
@@ -17,6 +21,15 @@ import (
 type PhotoUsecase interface {
 	CreatePhoto(ctx context.Context, request *pb.CreatePhotoRequest) error
 	UpdatePhotoDetail(ctx context.Context, request *pb.UpdatePhotoDetailRequest) error
+	GetPhotoPrice(ctx context.Context, request *pb.GetPhotoPriceRequest) (*entity.Photo, error)
+	UpdatePhotosOwner(ctx context.Context, request *pb.UpdatePhotosOwnerRequest) error
+	ClearPhotosOwner(ctx context.Context, request *pb.ClearPhotosOwnerRequest) error
+	ReservePhotos(ctx context.Context, request *model.ReservePhotosRequest) (time.Time, error)
+	CancelPhotoReservations(ctx context.Context, request *model.ReservePhotosRequest) error
+	ReleaseExpiredReservations(ctx context.Context) error
+	GetCreatorPhotos(ctx context.Context, request *model.CreatorPhotosRequest) ([]*entity.CreatorPhoto, *entity.CreatorPhotoSummary, error)
+	GetPhoto(ctx context.Context, request *model.GetPhotoRequest) (*entity.PhotoView, error)
+	DeletePhoto(ctx context.Context, request *model.DeletePhotoRequest) error
 	// UpdateProcessedPhoto(ctx context.Context, req *model.RequestUpdateProcessedPhoto) (error, error)
 }
 
@@ -25,21 +38,27 @@ type photoUsecase struct {
 	photoRepo       repository.PhotoRepository
 	photoDetailRepo repository.PhotoDetailRepository
 	userSimilarRepo repository.UserSimilarRepository
+	eventRepo       repository.EventRepository
 	aiAdapter       adapter.AiAdapter
 	uploadAdapter   adapter.UploadAdapter
+
+	reservationTimeout time.Duration
 }
 
 func NewPhotoUsecase(db *sqlx.DB, photoRepo repository.PhotoRepository,
 	photoDetailRepo repository.PhotoDetailRepository,
-	userSimilarRepo repository.UserSimilarRepository,
-	aiAdapter adapter.AiAdapter, uploadAdapter adapter.UploadAdapter) PhotoUsecase {
+	userSimilarRepo repository.UserSimilarRepository, eventRepo repository.EventRepository,
+	aiAdapter adapter.AiAdapter, uploadAdapter adapter.UploadAdapter, reservationTimeout time.Duration) PhotoUsecase {
 	return &photoUsecase{
 		db:              db,
 		photoRepo:       photoRepo,
 		photoDetailRepo: photoDetailRepo,
 		userSimilarRepo: userSimilarRepo,
+		eventRepo:       eventRepo,
 		aiAdapter:       aiAdapter,
 		uploadAdapter:   uploadAdapter,
+
+		reservationTimeout: reservationTimeout,
 	}
 }
 
@@ -55,11 +74,17 @@ func (u *photoUsecase) CreatePhoto(ctx context.Context, request *pb.CreatePhotoR
 		}
 	}()
 
+	if request.GetPhoto().GetCreatorId() == "" {
+		err = fiber.NewError(fiber.StatusBadRequest, "creator_id is required")
+		return err
+	}
+
 	newPhoto := &entity.Photo{
 		Id:            request.GetPhoto().GetId(),
-		CreatorId:     "test-create-photo-case-2",
+		CreatorId:     request.GetPhoto().GetCreatorId(),
 		Title:         request.GetPhoto().GetTitle(),
 		CollectionUrl: request.GetPhoto().GetCollectionUrl(),
+		EventId:       request.GetPhoto().GetEventId(),
 		Price:         request.GetPhoto().GetPrice(),
 		PriceStr:      request.GetPhoto().GetPriceStr(),
 
@@ -68,6 +93,29 @@ func (u *photoUsecase) CreatePhoto(ctx context.Context, request *pb.CreatePhotoR
 		UpdatedAt:  request.GetPhoto().GetUpdatedAt().AsTime(),
 	}
 
+	// a photo uploaded to an event without a price of its own sells at the event's default price
+	if newPhoto.EventId != "" {
+		event, findErr := u.eventRepo.FindById(tx, newPhoto.EventId)
+		if findErr != nil {
+			if errors.Is(findErr, sql.ErrNoRows) {
+				err = fiber.NewError(fiber.StatusNotFound, "event not found")
+				return err
+			}
+			err = findErr
+			return err
+		}
+
+		if event.CreatorId != newPhoto.CreatorId {
+			err = fiber.NewError(fiber.StatusForbidden, "event belongs to another photographer")
+			return err
+		}
+
+		if newPhoto.PriceStr == "" {
+			newPhoto.Price = event.DefaultPrice
+			newPhoto.PriceStr = event.DefaultPriceStr
+		}
+	}
+
 	newPhoto, err = u.photoRepo.Create(tx, newPhoto)
 	if err != nil {
 		return err
@@ -89,7 +137,8 @@ func (u *photoUsecase) CreatePhoto(ctx context.Context, request *pb.CreatePhotoR
 		UpdatedAt:       request.GetPhoto().GetDetail().GetUpdatedAt().AsTime(),
 	}
 
-	newPhotoDetail, err = u.photoDetailRepo.Create(tx, newPhotoDetail)
+	// a retried compression sends the same rendition again, it replaces the earlier one
+	newPhotoDetail, err = u.photoDetailRepo.Upsert(tx, newPhotoDetail)
 	if err != nil {
 		return err
 	}
@@ -114,17 +163,41 @@ func (u *photoUsecase) UpdatePhotoDetail(ctx context.Context, request *pb.Update
 		}
 	}()
 
-	photo := &entity.Photo{
-		Id:            request.GetPhotoDetail().PhotoId,
-		CompressedUrl: request.GetPhotoDetail().Url,
-		UpdatedAt:     time.Now(),
+	var current *entity.Photo
+	if current, err = u.photoRepo.FindById(tx, request.GetPhotoDetail().GetPhotoId()); err != nil {
+		log.Println(err)
+		return err
 	}
 
-	err = u.photoRepo.UpdateCompressedUrl(tx, photo)
-	if err != nil {
-		log.Println(err)
+	// the photo was unpublished while it was being compressed
+	if current.DeletedAt != nil {
+		tx.Rollback()
+		if _, deleteErr := u.uploadAdapter.DeleteFile(ctx, request.GetPhotoDetail().GetFileKey()); deleteErr != nil {
+			log.Println(deleteErr)
+		}
+		return nil
+	}
 
-		return err
+	yourMomentsType := enum.YourMomentsType(request.GetPhotoDetail().GetYourMomentsType())
+	if yourMomentsType == enum.YourMomentTypeCompressed {
+		photo := &entity.Photo{
+			Id:            request.GetPhotoDetail().PhotoId,
+			CompressedUrl: request.GetPhotoDetail().Url,
+			UpdatedAt:     time.Now(),
+		}
+
+		err = u.photoRepo.UpdateCompressedUrl(tx, photo)
+		if err != nil {
+			log.Println(err)
+
+			return err
+		}
+	}
+
+	// older upload-svc versions did not send the format of the rendition
+	imageType := request.GetPhotoDetail().GetType()
+	if imageType == "" {
+		imageType = "JPG"
 	}
 
 	newPhotoDetail := &entity.PhotoDetail{
@@ -133,17 +206,18 @@ func (u *photoUsecase) UpdatePhotoDetail(ctx context.Context, request *pb.Update
 		FileName:        request.GetPhotoDetail().GetFileName(),
 		FileKey:         request.GetPhotoDetail().GetFileKey(),
 		Size:            request.GetPhotoDetail().GetSize(),
-		Type:            "JPG",
-		Checksum:        "1212",
-		Height:          121,
-		Width:           1212,
+		Type:            imageType,
+		Checksum:        request.GetPhotoDetail().GetChecksum(),
+		Height:          request.GetPhotoDetail().GetHeight(),
+		Width:           request.GetPhotoDetail().GetWidth(),
 		Url:             request.GetPhotoDetail().GetUrl(),
-		YourMomentsType: enum.YourMomentsType(request.GetPhotoDetail().GetYourMomentsType()),
+		YourMomentsType: yourMomentsType,
 		CreatedAt:       request.GetPhotoDetail().GetCreatedAt().AsTime(),
 		UpdatedAt:       request.GetPhotoDetail().GetUpdatedAt().AsTime(),
 	}
 
-	newPhotoDetail, err = u.photoDetailRepo.Create(tx, newPhotoDetail)
+	// a retried compression sends the same rendition again, it replaces the earlier one
+	newPhotoDetail, err = u.photoDetailRepo.Upsert(tx, newPhotoDetail)
 	if err != nil {
 		return err
 	}
@@ -156,103 +230,416 @@ func (u *photoUsecase) UpdatePhotoDetail(ctx context.Context, request *pb.Update
 
 }
 
-// func (u *photoUsecase) ClaimPhoto(ctx context.Context, req *model.RequestClaimPhoto) (error, error) {
+func (u *photoUsecase) GetPhotoPrice(ctx context.Context, request *pb.GetPhotoPriceRequest) (*entity.Photo, error) {
+	photo, err := u.photoRepo.FindById(u.db, request.GetId())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fiber.NewError(fiber.StatusNotFound, "photo not found")
+		}
+		log.Println(err)
+		return nil, err
+	}
 
-// 	tx, err := u.db.Begin(ctx)
-// 	if err != nil {
-// 		return err, err
-// 	}
+	if photo.DeletedAt != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, "photo not found")
+	}
 
-// 	updatePhoto := &entity.Photo{
-// 		Id:            req.Id,
-// 		OwnedByUserId: req.UserId,
-// 		Status:        "Claimed",
-// 		UpdatedAt:     time.Now(),
-// 	}
+	return photo, nil
+}
 
-// 	err = u.photoRepo.UpdateClaimedPhoto(ctx, tx, updatePhoto)
-// 	if err != nil {
-// 		return err, err
-// 	}
+// UpdatePhotosOwner gives every photo to the same user in one db transaction.
+// If any of them is owned by someone else nothing is changed.
+func (u *photoUsecase) UpdatePhotosOwner(ctx context.Context, request *pb.UpdatePhotosOwnerRequest) error {
+	tx, err := u.db.Beginx()
+	if err != nil {
+		return err
+	}
 
-// 	// err = u.userSimilarRepo.UpdateUsersForPhoto(ctx, tx, req.Id, req.UserId)
-// 	// if err != nil {
-// 	// 	return err, err
-// 	// }
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
 
-// 	if err := tx.Commit(ctx); err != nil {
-// 		return err, err
-// 	}
+	for _, photoId := range request.GetIds() {
+		var current *entity.Photo
+		if current, err = u.photoRepo.FindById(tx, photoId); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fiber.NewError(fiber.StatusNotFound, "photo "+photoId+" not found")
+			}
+			log.Println(err)
+			return err
+		}
 
-// 	// Process photo service will be executed asyncronously by goroutine
+		if current.DeletedAt != nil {
+			err = fiber.NewError(fiber.StatusNotFound, "photo "+photoId+" not found")
+			return err
+		}
 
-// 	return nil, nil
+		photo := &entity.Photo{
+			Id:            photoId,
+			OwnedByUserId: request.GetOwnedByUserId(),
+			UpdatedAt:     time.Now(),
+		}
 
-// }
+		if err = u.photoRepo.UpdateOwner(tx, photo); err != nil {
+			if errors.Is(err, repository.ErrPhotoAlreadyOwned) {
+				if current.OwnedByUserId == "" {
+					return fiber.NewError(fiber.StatusConflict, "photo "+photoId+" is reserved by another user")
+				}
+				return fiber.NewError(fiber.StatusConflict, "photo "+photoId+" is already owned by another user")
+			}
+			log.Println(err)
+			return err
+		}
 
-// func (u *photoUsecase) CancelClaimPhoto(ctx context.Context, req *model.RequestClaimPhoto) (error, error) {
+		if err = u.userSimilarRepo.MoveToSold(tx, photo.Id, photo.OwnedByUserId); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
 
-// 	tx, err := u.db.Begin(ctx)
-// 	if err != nil {
-// 		return err, err
-// 	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
 
-// 	updatePhoto := &entity.Photo{
-// 		Id:            req.Id,
-// 		OwnedByUserId: "",
-// 		Status:        "Unclaimed",
-// 		UpdatedAt:     time.Now(),
-// 	}
+	return nil
+}
 
-// 	err = u.photoRepo.UpdateClaimedPhoto(ctx, tx, updatePhoto)
-// 	if err != nil {
-// 		return err, err
-// 	}
+// ClearPhotosOwner takes the photos back from the user. Photos the user does not own are skipped.
+func (u *photoUsecase) ClearPhotosOwner(ctx context.Context, request *pb.ClearPhotosOwnerRequest) error {
+	tx, err := u.db.Beginx()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	for _, photoId := range request.GetIds() {
+		photo := &entity.Photo{
+			Id:            photoId,
+			OwnedByUserId: request.GetOwnedByUserId(),
+			UpdatedAt:     time.Now(),
+		}
+
+		var cleared bool
+		cleared, err = u.photoRepo.ClearOwner(tx, photo)
+		if err != nil {
+			log.Println(err)
+			return err
+		}
 
-// 	// err = u.userSimilarRepo.UpdateUsersForPhoto(ctx, tx, req.Id, req.UserId)
-// 	// if err != nil {
-// 	// 	return err, err
-// 	// }
+		if !cleared {
+			continue
+		}
+
+		if err = u.userSimilarRepo.RestoreFromSold(tx, photo.Id); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
 
-// 	if err := tx.Commit(ctx); err != nil {
-// 		return err, err
-// 	}
+	return nil
+}
+
+// ReservePhotos holds every photo for the user while they pay, all or nothing. Only photos matched
+// with the user can be reserved. Reserving again extends the reservation, which ends at the returned time.
+func (u *photoUsecase) ReservePhotos(ctx context.Context, request *model.ReservePhotosRequest) (time.Time, error) {
+	tx, err := u.db.Beginx()
+	if err != nil {
+		return time.Time{}, err
+	}
 
-// 	// Process photo service will be executed asyncronously by goroutine
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
 
-// 	return nil, nil
+	now := time.Now()
+	reservedUntil := now.Add(u.reservationTimeout)
+
+	for _, photoId := range request.PhotoIds {
+		var photo *entity.Photo
+		if photo, err = u.photoRepo.FindById(tx, photoId); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				err = fiber.NewError(fiber.StatusNotFound, "photo "+photoId+" not found")
+				return time.Time{}, err
+			}
+			log.Println(err)
+			return time.Time{}, err
+		}
 
-// }
+		if photo.CreatorId == request.UserId {
+			err = fiber.NewError(fiber.StatusBadRequest, "creator can not reserve their own photo "+photoId)
+			return time.Time{}, err
+		}
 
-// func (u *photoUsecase) UpdateBuyyedPhoto(ctx context.Context, req *model.RequestClaimPhoto) (error, error) {
+		var matched bool
+		if matched, err = u.userSimilarRepo.IsMatched(tx, photoId, request.UserId); err != nil {
+			log.Println(err)
+			return time.Time{}, err
+		}
+		if !matched {
+			err = fiber.NewError(fiber.StatusNotFound, "photo "+photoId+" is not matched with this user")
+			return time.Time{}, err
+		}
 
-// 	tx, err := u.db.Begin(ctx)
-// 	if err != nil {
-// 		return err, err
-// 	}
+		photo.ReservedByUserId = request.UserId
+		photo.ReservedUntil = &reservedUntil
+		photo.UpdatedAt = now
+
+		if err = u.photoRepo.Reserve(tx, photo); err != nil {
+			if errors.Is(err, repository.ErrPhotoUnavailable) {
+				err = fiber.NewError(fiber.StatusConflict, "photo "+photoId+" is owned or reserved by another user")
+				return time.Time{}, err
+			}
+			log.Println(err)
+			return time.Time{}, err
+		}
+	}
 
-// 	updatePhoto := &entity.Photo{
-// 		Id:        req.Id,
-// 		Status:    "Owned",
-// 		UpdatedAt: time.Now(),
-// 	}
+	if err = tx.Commit(); err != nil {
+		return time.Time{}, err
+	}
 
-// 	err = u.photoRepo.UpdatePhotoStatus(ctx, tx, updatePhoto)
-// 	if err != nil {
-// 		return err, err
-// 	}
+	return reservedUntil, nil
+}
 
-// 	err = u.userSimilarRepo.DeleteSimilarUsers(ctx, tx, req.Id)
-// 	if err != nil {
-// 		return err, err
-// 	}
+// CancelPhotoReservations releases the user's reservations, photos not reserved by the user are skipped.
+func (u *photoUsecase) CancelPhotoReservations(ctx context.Context, request *model.ReservePhotosRequest) error {
+	tx, err := u.db.Beginx()
+	if err != nil {
+		return err
+	}
 
-// 	if err := tx.Commit(ctx); err != nil {
-// 		return err, err
-// 	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
 
-// 	// Process photo service will be executed asyncronously by goroutine
+	for _, photoId := range request.PhotoIds {
+		photo := &entity.Photo{
+			Id:               photoId,
+			ReservedByUserId: request.UserId,
+			UpdatedAt:        time.Now(),
+		}
 
-// 	return nil, nil
+		if _, err = u.photoRepo.CancelReservation(tx, photo); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
 
-// }
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (u *photoUsecase) ReleaseExpiredReservations(ctx context.Context) error {
+	released, err := u.photoRepo.ReleaseExpiredReservations(u.db)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	if released > 0 {
+		log.Printf("released %d expired photo reservations", released)
+	}
+
+	return nil
+}
+
+// GetCreatorPhotos returns one page of the photographer's photos and the totals over every photo
+// matching the filter, TotalPhotos of the summary is the number of items to page through.
+func (u *photoUsecase) GetCreatorPhotos(ctx context.Context, request *model.CreatorPhotosRequest) ([]*entity.CreatorPhoto, *entity.CreatorPhotoSummary, error) {
+	if err := normalizePage(&request.Page, &request.Size); err != nil {
+		return nil, nil, err
+	}
+
+	switch request.Status {
+	case "", enum.PhotoStatusAvailable, enum.PhotoStatusReserved, enum.PhotoStatusOwned:
+	default:
+		return nil, nil, fiber.NewError(fiber.StatusBadRequest, "status must be AVAILABLE, RESERVED or OWNED")
+	}
+
+	filter := &repository.CreatorPhotoFilter{
+		CreatorId: request.CreatorId,
+		EventId:   request.EventId,
+		Status:    request.Status,
+	}
+
+	summary, err := u.photoRepo.SummarizeByCreator(u.db, filter)
+	if err != nil {
+		log.Println(err)
+		return nil, nil, err
+	}
+
+	photos, err := u.photoRepo.FindByCreator(u.db, filter, request.Size, (request.Page-1)*request.Size)
+	if err != nil {
+		log.Println(err)
+		return nil, nil, err
+	}
+
+	for _, photo := range photos {
+		if photo.CollectionUrl, err = signFileKey(ctx, u.uploadAdapter, photo.CollectionFileKey, enum.PhotoAccessCreator); err != nil {
+			return nil, nil, err
+		}
+		if photo.CompressedUrl, err = signFileKey(ctx, u.uploadAdapter, photo.CompressedFileKey, enum.PhotoAccessCreator); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return photos, summary, nil
+}
+
+// GetPhoto signs fresh urls for the renditions the caller may download. Photos outside any event
+// are only visible to their photographer, their buyer and the users matched to them, and once a
+// photo is sold nobody else gets a rendition of it. An unpublished photo is only left to its buyer.
+func (u *photoUsecase) GetPhoto(ctx context.Context, request *model.GetPhotoRequest) (*entity.PhotoView, error) {
+	photo, err := u.photoRepo.FindById(u.db, request.PhotoId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fiber.NewError(fiber.StatusNotFound, "photo not found")
+		}
+		log.Println(err)
+		return nil, err
+	}
+
+	// without a user an unsold photo would look owned by them, its OwnedByUserId is empty as well
+	isOwner := request.UserId != "" && photo.OwnedByUserId == request.UserId
+	if photo.DeletedAt != nil && !isOwner {
+		return nil, fiber.NewError(fiber.StatusNotFound, "photo not found")
+	}
+
+	access := enum.PhotoAccessPublic
+	switch {
+	case request.UserId == "":
+	case request.UserId == photo.CreatorId:
+		access = enum.PhotoAccessCreator
+	case isOwner:
+		access = enum.PhotoAccessOwner
+	default:
+		matched, err := u.userSimilarRepo.IsMatched(u.db, photo.Id, request.UserId)
+		if err != nil {
+			log.Println(err)
+			return nil, err
+		}
+		if matched {
+			access = enum.PhotoAccessMatched
+		}
+	}
+	if access == enum.PhotoAccessPublic && photo.EventId == "" {
+		return nil, fiber.NewError(fiber.StatusNotFound, "photo not found")
+	}
+
+	photoDetails, err := u.photoDetailRepo.FindByPhotoId(u.db, photo.Id)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	view := &entity.PhotoView{
+		Photo:      photo,
+		Access:     access,
+		Renditions: make([]*entity.PhotoDetail, 0, len(photoDetails)),
+		ExpiresAt:  time.Now().Add(signedUrlExpiry[access]),
+	}
+	isSoldToOther := photo.OwnedByUserId != "" && access != enum.PhotoAccessCreator && access != enum.PhotoAccessOwner
+	for _, photoDetail := range photoDetails {
+		if isSoldToOther || !canAccessRendition(access, photoDetail.YourMomentsType) {
+			continue
+		}
+		if photoDetail.Url, err = signFileKey(ctx, u.uploadAdapter, photoDetail.FileKey, access); err != nil {
+			return nil, err
+		}
+		view.Renditions = append(view.Renditions, photoDetail)
+	}
+
+	return view, nil
+}
+
+// DeletePhoto unpublishes a photo and removes its files from storage. The original of a sold photo
+// is kept so its buyer can still download it, every other rendition and match is dropped. Files are
+// only removed once the db transaction committed, a failed removal is logged and left behind.
+func (u *photoUsecase) DeletePhoto(ctx context.Context, request *model.DeletePhotoRequest) error {
+	tx, err := u.db.Beginx()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	photo, err := u.photoRepo.FindById(tx, request.PhotoId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = fiber.NewError(fiber.StatusNotFound, "photo not found")
+			return err
+		}
+		log.Println(err)
+		return err
+	}
+
+	if photo.DeletedAt != nil {
+		err = fiber.NewError(fiber.StatusNotFound, "photo not found")
+		return err
+	}
+	if request.CreatorId != "" && photo.CreatorId != request.CreatorId {
+		err = fiber.NewError(fiber.StatusForbidden, "photo belongs to another creator")
+		return err
+	}
+
+	now := time.Now()
+	photo.DeletedAt = &now
+	if err = u.photoRepo.SoftDelete(tx, photo); err != nil {
+		if errors.Is(err, repository.ErrPhotoUnavailable) {
+			err = fiber.NewError(fiber.StatusConflict, "photo is reserved for checkout, try again later")
+			return err
+		}
+		log.Println(err)
+		return err
+	}
+
+	var keep enum.YourMomentsType
+	if photo.OwnedByUserId != "" {
+		keep = enum.YourMomentTypeCollection
+	}
+
+	var photoDetails []*entity.PhotoDetail
+	if photoDetails, err = u.photoDetailRepo.DeleteByPhotoId(tx, photo.Id, keep); err != nil {
+		log.Println(err)
+		return err
+	}
+
+	if err = u.userSimilarRepo.DeleteByPhotoId(tx, photo.Id); err != nil {
+		log.Println(err)
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	for _, photoDetail := range photoDetails {
+		if _, deleteErr := u.uploadAdapter.DeleteFile(ctx, photoDetail.FileKey); deleteErr != nil {
+			log.Println(deleteErr)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"be-yourmoments/photo-svc/internal/adapter"
+	"be-yourmoments/photo-svc/internal/enum"
+	"context"
+	"log"
+	"time"
+)
+
+// signedUrlExpiry is how long a signed url stays valid for each access level. Urls stored at upload
+// time expire after an hour, so every read signs fresh ones from the file keys in photo_details.
+var signedUrlExpiry = map[enum.PhotoAccessLevel]time.Duration{
+	enum.PhotoAccessPublic:  10 * time.Minute,
+	enum.PhotoAccessMatched: time.Hour,
+	enum.PhotoAccessOwner:   24 * time.Hour,
+	enum.PhotoAccessCreator: 24 * time.Hour,
+}
+
+// accessRenditions lists the renditions each access level may download. Anything big enough to
+// be worth keeping without buying it is only served to the photographer and the buyer, so a
+// matched user only sees the watermarked ISYOU until the photo is bought.
+var accessRenditions = map[enum.PhotoAccessLevel][]enum.YourMomentsType{
+	enum.PhotoAccessPublic:  {enum.YourMomentTypeThumbnail, enum.YourMomentTypeIsYou},
+	enum.PhotoAccessMatched: {enum.YourMomentTypeThumbnail, enum.YourMomentTypeIsYou},
+	enum.PhotoAccessOwner: {enum.YourMomentTypeThumbnail, enum.YourMomentTypePreview, enum.YourMomentTypeIsYou,
+		enum.YourMomentTypeYou, enum.YourMomentTypeCompressed, enum.YourMomentTypeCollection},
+	enum.PhotoAccessCreator: {enum.YourMomentTypeThumbnail, enum.YourMomentTypePreview, enum.YourMomentTypeIsYou,
+		enum.YourMomentTypeYou, enum.YourMomentTypeCompressed, enum.YourMomentTypeCollection},
+}
+
+// signFileKey signs a fresh url for a stored file, an empty file key gives an empty url.
+func signFileKey(ctx context.Context, uploadAdapter adapter.UploadAdapter, fileKey string, level enum.PhotoAccessLevel) (string, error) {
+	if fileKey == "" {
+		return "", nil
+	}
+
+	url, err := uploadAdapter.PresignFile(ctx, fileKey, signedUrlExpiry[level])
+	if err != nil {
+		log.Println(err)
+		return "", err
+	}
+
+	return url, nil
+}
+
+func canAccessRendition(level enum.PhotoAccessLevel, yourMomentsType enum.YourMomentsType) bool {
+	for _, allowed := range accessRenditions[level] {
+		if allowed == yourMomentsType {
+			return true
+		}
+	}
+
+	return false
+}
@@ -1,14 +1,19 @@
 package usecase
 
 import (
+	"be-yourmoments/photo-svc/internal/adapter"
 	"be-yourmoments/photo-svc/internal/entity"
 	"be-yourmoments/photo-svc/internal/enum"
+	"be-yourmoments/photo-svc/internal/model"
 	"be-yourmoments/photo-svc/internal/pb"
 	"be-yourmoments/photo-svc/internal/repository"
 	"context"
+	"database/sql"
+	"errors"
 	"log"
 	"time"
 
+	"github.com/gofiber/fiber/v2"
 	"github.com/jmoiron/sqlx"
 	"github.com/oklog/ulid/v2"
 )
@@ -16,6 +21,12 @@ import (
 type UserSimilarUsecase interface {
 	CreateUserSimilar(ctx context.Context, request *pb.CreateUserSimilarPhotoRequest) error
 	CreateUserFacecam(ctx context.Context, request *pb.CreateUserSimilarFacecamRequest) error
+	AddToCart(ctx context.Context, request *model.CartRequest) error
+	RemoveFromCart(ctx context.Context, request *model.CartRequest) error
+	GetCart(ctx context.Context, userId string) ([]*entity.Photo, error)
+	UpdateFlag(ctx context.Context, request *model.UserSimilarFlagRequest) error
+	UpdateUserSimilarity(ctx context.Context, request *model.UpdateUserSimilarityRequest) error
+	GetMatchedPhotos(ctx context.Context, request *model.MatchedPhotosRequest) ([]*entity.MatchedPhoto, int64, error)
 }
 
 type userSimilarUsecase struct {
@@ -24,17 +35,22 @@ type userSimilarUsecase struct {
 	photoDetailRepo repository.PhotoDetailRepository
 	facecamRepo     repository.FacecamRepository
 	userSimilarRepo repository.UserSimilarRepository
+	userSettingRepo repository.UserSettingRepository
+	uploadAdapter   adapter.UploadAdapter
 }
 
 func NewUserSimilarUsecase(db *sqlx.DB, photoRepo repository.PhotoRepository,
 	photoDetailRepo repository.PhotoDetailRepository, facecamRepo repository.FacecamRepository,
-	userSimilarRepo repository.UserSimilarRepository) UserSimilarUsecase {
+	userSimilarRepo repository.UserSimilarRepository, userSettingRepo repository.UserSettingRepository,
+	uploadAdapter adapter.UploadAdapter) UserSimilarUsecase {
 	return &userSimilarUsecase{
 		db:              db,
 		photoRepo:       photoRepo,
 		photoDetailRepo: photoDetailRepo,
 		facecamRepo:     facecamRepo,
 		userSimilarRepo: userSimilarRepo,
+		userSettingRepo: userSettingRepo,
+		uploadAdapter:   uploadAdapter,
 	}
 }
 
@@ -56,6 +72,21 @@ func (u *userSimilarUsecase) CreateUserSimilar(ctx context.Context, request *pb.
 		}
 	}()
 
+	var current *entity.Photo
+	if current, err = u.photoRepo.FindById(tx, request.GetPhotoDetail().GetPhotoId()); err != nil {
+		log.Println(err)
+		return err
+	}
+
+	// the photo was unpublished while the ai was matching it
+	if current.DeletedAt != nil {
+		tx.Rollback()
+		if _, deleteErr := u.uploadAdapter.DeleteFile(ctx, request.GetPhotoDetail().GetFileKey()); deleteErr != nil {
+			log.Println(deleteErr)
+		}
+		return nil
+	}
+
 	photo := &entity.Photo{
 		Id:             request.GetPhotoDetail().PhotoId,
 		IsThisYouURL:   "",
@@ -70,23 +101,29 @@ func (u *userSimilarUsecase) CreateUserSimilar(ctx context.Context, request *pb.
 		return err
 	}
 
+	imageType := request.GetPhotoDetail().GetType()
+	if imageType == "" {
+		imageType = "JPG"
+	}
+
 	newPhotoDetail := &entity.PhotoDetail{
 		Id:              ulid.Make().String(),
 		PhotoId:         request.GetPhotoDetail().GetPhotoId(),
 		FileName:        request.GetPhotoDetail().GetFileName(),
 		FileKey:         request.GetPhotoDetail().GetFileKey(),
 		Size:            request.GetPhotoDetail().GetSize(),
-		Type:            "JPG",
-		Checksum:        "1212",
-		Height:          121,
-		Width:           1212,
+		Type:            imageType,
+		Checksum:        request.GetPhotoDetail().GetChecksum(),
+		Height:          request.GetPhotoDetail().GetHeight(),
+		Width:           request.GetPhotoDetail().GetWidth(),
 		Url:             request.GetPhotoDetail().GetUrl(),
 		YourMomentsType: enum.YourMomentsType(request.GetPhotoDetail().GetYourMomentsType()),
 		CreatedAt:       request.GetPhotoDetail().GetCreatedAt().AsTime(),
 		UpdatedAt:       request.GetPhotoDetail().GetUpdatedAt().AsTime(),
 	}
 
-	_, err = u.photoDetailRepo.Create(tx, newPhotoDetail)
+	// the ai sends the YOU rendition again when it matches the photo again, it replaces the earlier one
+	_, err = u.photoDetailRepo.Upsert(tx, newPhotoDetail)
 	if err != nil {
 		log.Println(err)
 
@@ -181,3 +218,167 @@ func (u *userSimilarUsecase) CreateUserFacecam(ctx context.Context, request *pb.
 	return nil
 
 }
+
+func (u *userSimilarUsecase) AddToCart(ctx context.Context, request *model.CartRequest) error {
+	tx, err := u.db.Beginx()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	photo, err := u.photoRepo.FindById(tx, request.PhotoId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fiber.NewError(fiber.StatusNotFound, "photo not found")
+		}
+		log.Println(err)
+		return err
+	}
+
+	if photo.OwnedByUserId != "" {
+		err = fiber.NewError(fiber.StatusConflict, "photo has already been bought")
+		return err
+	}
+
+	matched, err := u.userSimilarRepo.UpdateCart(tx, request.PhotoId, request.UserId, true)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	if !matched {
+		err = fiber.NewError(fiber.StatusNotFound, "photo is not matched with this user")
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (u *userSimilarUsecase) RemoveFromCart(ctx context.Context, request *model.CartRequest) error {
+	matched, err := u.userSimilarRepo.UpdateCart(u.db, request.PhotoId, request.UserId, false)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	if !matched {
+		return fiber.NewError(fiber.StatusNotFound, "photo is not matched with this user")
+	}
+
+	return nil
+}
+
+func (u *userSimilarUsecase) UpdateFlag(ctx context.Context, request *model.UserSimilarFlagRequest) error {
+	matched, err := u.userSimilarRepo.UpdateFlag(u.db, request.PhotoId, request.UserId, request.Flag, request.Value)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	if !matched {
+		return fiber.NewError(fiber.StatusNotFound, "photo is not matched with this user")
+	}
+
+	return nil
+}
+
+// UpdateUserSimilarity stores the threshold a user set in user-svc, GetMatchedPhotos hides
+// matches below it.
+func (u *userSimilarUsecase) UpdateUserSimilarity(ctx context.Context, request *model.UpdateUserSimilarityRequest) error {
+	if !isValidSimilarity(request.Similarity) {
+		return fiber.NewError(fiber.StatusBadRequest, "similarity must be between 1 and 8")
+	}
+
+	userSetting := &entity.UserSetting{
+		UserId:     request.UserId,
+		Similarity: request.Similarity,
+		UpdatedAt:  request.UpdatedAt,
+	}
+	if err := u.userSettingRepo.Upsert(u.db, userSetting); err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+func (u *userSimilarUsecase) GetCart(ctx context.Context, userId string) ([]*entity.Photo, error) {
+	photos, err := u.photoRepo.FindCartByUserId(u.db, userId)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	for _, photo := range photos {
+		if photo.PreviewUrl, err = signFileKey(ctx, u.uploadAdapter, photo.PreviewFileKey, enum.PhotoAccessMatched); err != nil {
+			return nil, err
+		}
+	}
+
+	return photos, nil
+}
+
+// GetMatchedPhotos returns one page of the photos the user was matched to, newest first, and the
+// total number of matches. A zero page or size falls back to the first page of 20.
+func (u *userSimilarUsecase) GetMatchedPhotos(ctx context.Context, request *model.MatchedPhotosRequest) ([]*entity.MatchedPhoto, int64, error) {
+	if err := normalizePage(&request.Page, &request.Size); err != nil {
+		return nil, 0, err
+	}
+	if request.Similarity != "" && !isValidSimilarity(request.Similarity) {
+		return nil, 0, fiber.NewError(fiber.StatusBadRequest, "similarity must be between 1 and 8")
+	}
+	if request.From != nil && request.To != nil && !request.To.After(*request.From) {
+		return nil, 0, fiber.NewError(fiber.StatusBadRequest, "to must be after from")
+	}
+
+	filter := &repository.MatchedPhotoFilter{
+		UserId:     request.UserId,
+		Similarity: request.Similarity,
+		From:       request.From,
+		To:         request.To,
+		IsFavorite: request.IsFavorite,
+		IsWishlist: request.IsWishlist,
+	}
+
+	total, err := u.userSimilarRepo.CountMatchedPhotos(u.db, filter)
+	if err != nil {
+		log.Println(err)
+		return nil, 0, err
+	}
+
+	photos, err := u.userSimilarRepo.FindMatchedPhotos(u.db, filter, request.Size, (request.Page-1)*request.Size)
+	if err != nil {
+		log.Println(err)
+		return nil, 0, err
+	}
+
+	for _, photo := range photos {
+		access := enum.PhotoAccessMatched
+		if photo.IsOwned {
+			access = enum.PhotoAccessOwner
+		}
+		if photo.PreviewUrl, err = signFileKey(ctx, u.uploadAdapter, photo.PreviewFileKey, access); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return photos, total, nil
+}
+
+func isValidSimilarity(similarity enum.SimilarityLevelEnum) bool {
+	switch similarity {
+	case enum.SimilarityLevelOne, enum.SimilarityLevelTwo, enum.SimilarityLevelThree, enum.SimilarityLevelFour,
+		enum.SimilarityLevelFive, enum.SimilarityLevelSix, enum.SimilarityLevelSeven, enum.SimilarityLevelEight:
+		return true
+	}
+	return false
+}
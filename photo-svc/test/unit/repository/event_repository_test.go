@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"be-yourmoments/photo-svc/internal/repository"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindEventPhotos(t *testing.T) {
+	eventRepo := repository.NewEventRepository()
+
+	t.Run("Only the watermarked thumbnail is exposed", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		now := time.Now()
+		mock.ExpectQuery(regexp.QuoteMeta(`WHERE d.photo_id = p.id AND d.your_moments_type = 'THUMBNAIL'`)).
+			WithArgs("event-1", 20, 0).
+			WillReturnRows(sqlmock.NewRows([]string{"photo_id", "creator_id", "title", "preview_file_key", "price",
+				"price_str", "is_sold", "original_at"}).
+				AddRow("photo-1", "creator-1", "red.png", "thumbnail/red.webp", 15000, "15.000", false, now))
+
+		photos, err := eventRepo.FindPhotos(db, "event-1", 20, 0)
+		assert.NoError(t, err)
+		assert.Len(t, photos, 1)
+		assert.Equal(t, "thumbnail/red.webp", photos[0].PreviewFileKey)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"be-yourmoments/photo-svc/internal/entity"
+	"be-yourmoments/photo-svc/internal/repository"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+)
+
+func newMockDB(t *testing.T) (*sqlx.DB, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	return sqlx.NewDb(db, "postgres"), mock
+}
+
+func TestReleaseExpiredReservations(t *testing.T) {
+	photoRepo := repository.NewPhotoRepository()
+
+	t.Run("Only reservations that ran out are released", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		mock.ExpectExec(regexp.QuoteMeta(`SET status = 'AVAILABLE', reserved_by_user_id = NULL, reserved_until = NULL`) +
+			`.*` + regexp.QuoteMeta(`WHERE status = 'RESERVED' AND reserved_until <= now()`)).
+			WillReturnResult(sqlmock.NewResult(0, 3))
+
+		released, err := photoRepo.ReleaseExpiredReservations(db)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), released)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Nothing to release", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		mock.ExpectExec(`UPDATE photos`).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		released, err := photoRepo.ReleaseExpiredReservations(db)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), released)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestReserve(t *testing.T) {
+	photoRepo := repository.NewPhotoRepository()
+
+	now := time.Now()
+	reservedUntil := now.Add(15 * time.Minute)
+	photo := &entity.Photo{
+		Id:               "photo-1",
+		ReservedByUserId: "buyer",
+		ReservedUntil:    &reservedUntil,
+		UpdatedAt:        now,
+	}
+
+	// a photo reserved by someone else can only be taken over once their reservation ran out
+	reserveQuery := regexp.QuoteMeta(`AND (status <> 'RESERVED' OR reserved_by_user_id = $1 OR reserved_until <= now())`)
+
+	t.Run("Available or expired photo is reserved", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		mock.ExpectExec(reserveQuery).
+			WithArgs(photo.ReservedByUserId, photo.ReservedUntil, photo.UpdatedAt, photo.Id).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := photoRepo.Reserve(db, photo)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Photo held by another user", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		mock.ExpectExec(reserveQuery).
+			WithArgs(photo.ReservedByUserId, photo.ReservedUntil, photo.UpdatedAt, photo.Id).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := photoRepo.Reserve(db, photo)
+		assert.ErrorIs(t, err, repository.ErrPhotoUnavailable)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestUpdateOwnerRespectsReservations(t *testing.T) {
+	photoRepo := repository.NewPhotoRepository()
+
+	photo := &entity.Photo{Id: "photo-1", OwnedByUserId: "buyer", UpdatedAt: time.Now()}
+
+	db, mock := newMockDB(t)
+	mock.ExpectExec(regexp.QuoteMeta(`AND NOT (status = 'RESERVED' AND reserved_by_user_id <> $1 AND reserved_until > now())`)).
+		WithArgs(photo.OwnedByUserId, photo.UpdatedAt, photo.Id).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := photoRepo.UpdateOwner(db, photo)
+	assert.ErrorIs(t, err, repository.ErrPhotoAlreadyOwned)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
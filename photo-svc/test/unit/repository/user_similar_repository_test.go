@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"be-yourmoments/photo-svc/internal/entity"
+	"be-yourmoments/photo-svc/internal/enum"
+	"be-yourmoments/photo-svc/internal/repository"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInsertOrUpdate(t *testing.T) {
+	userSimilarRepo := repository.NewUserSimilarRepository()
+
+	// users who lost the photo to a buyer are in sold_user_similar_photos and are not matched again
+	skipSold := `\s*` + regexp.QuoteMeta(`WHERE NOT EXISTS (SELECT 1 FROM sold_user_similar_photos s`) + `\s*` +
+		regexp.QuoteMeta(`WHERE s.photo_id = v.photo_id AND s.user_id = v.user_id) ON CONFLICT (photo_id, user_id)`)
+
+	t.Run("Matches of a sold photo are not stored again", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM user_similar_photos WHERE photo_id = $1 AND user_id NOT IN ($2, $3)`)).
+			WithArgs("photo-1", "user-1", "user-2").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta(`AS v(photo_id, user_id, similarity, created_at, updated_at)`) + skipSold).
+			WithArgs("photo-1", "user-1", enum.SimilarityLevelFour, sqlmock.AnyArg(), sqlmock.AnyArg(),
+				"photo-1", "user-2", enum.SimilarityLevelFive, sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := userSimilarRepo.InsertOrUpdate(db, "photo-1", &[]*entity.UserSimilarPhoto{
+			{UserId: "user-1", Similarity: enum.SimilarityLevelFour},
+			{UserId: "user-2", Similarity: enum.SimilarityLevelFive},
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Matches of a user skip the photos sold to someone else", func(t *testing.T) {
+		db, mock := newMockDB(t)
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM user_similar_photos WHERE user_id = $1 AND photo_id NOT IN ($2)`)).
+			WithArgs("user-1", "photo-1").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta(`AS v(user_id, photo_id, similarity, created_at, updated_at)`) + skipSold).
+			WithArgs("user-1", "photo-1", enum.SimilarityLevelFour, sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := userSimilarRepo.InserOrUpdateByUserId(db, "user-1", &[]*entity.UserSimilarPhoto{
+			{PhotoId: "photo-1", Similarity: enum.SimilarityLevelFour},
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -1,88 +1,58 @@
 package main
 
 import (
-	"be-yourmoments/photo-svc/internal/adapter"
-	"be-yourmoments/photo-svc/internal/config"
-	grpcHandler "be-yourmoments/photo-svc/internal/delivery/grpc"
-	"be-yourmoments/photo-svc/internal/delivery/http"
-	discovery "be-yourmoments/photo-svc/internal/helper"
-
-	"be-yourmoments/photo-svc/internal/helper/consul"
-	"be-yourmoments/photo-svc/internal/helper/logq ger"
-	"be-yourmoments/photo-svc/internal/repository"
-	"be-yourmoments/photo-svc/internal/usecase"
-	"net"
+	"be-yourmoments/transaction-svc/internal/adapter"
+	"be-yourmoments/transaction-svc/internal/config"
+	"be-yourmoments/transaction-svc/internal/delivery/http"
+	"be-yourmoments/transaction-svc/internal/delivery/http/middleware"
+	discovery "be-yourmoments/transaction-svc/internal/helper"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"be-yourmoments/transaction-svc/internal/helper/consul"
+	"be-yourmoments/transaction-svc/internal/helper/logger"
+	"be-yourmoments/transaction-svc/internal/repository"
+	"be-yourmoments/transaction-svc/internal/usecase"
 
 	"context"
 	"fmt"
 	"strconv"
 	"time"
-
-	"github.com/gofiber/fiber/v2/middleware/cors"
-
-	"github.com/gofiber/fiber/v2"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/reflection"
 )
 
 var logs = logger.New("main")
 
 func webServer() error {
-	app := fiber.New()
+	app := config.NewApp()
 
 	serverConfig := config.NewServerConfig()
 	dbConfig := config.NewPostgresDatabase()
 
 	registry, err := consul.NewRegistry(serverConfig.ConsulAddr, serverConfig.Name)
 	if err != nil {
-		logs.Error("Failed to create consul registry for category service")
+		logs.Error("Failed to create consul registry for transaction service" + err.Error())
 		return err
 	}
 
-	GRPCserviceID := discovery.GenerateServiceID(serverConfig.Name + "-grpc")
 	HTTPserviceID := discovery.GenerateServiceID(serverConfig.Name + "-http")
 
-	grpcPortInt, _ := strconv.Atoi(serverConfig.GRPCPort)
 	httpPortInt, _ := strconv.Atoi(serverConfig.HTTPPort)
 
-	ctx := context.Background()
-
-	err = registry.RegisterService(ctx, serverConfig.Name+"-grpc", GRPCserviceID, serverConfig.GRPCAddr, grpcPortInt, []string{"grpc"})
-	if err != nil {
-		logs.Error("Failed to register gRPC book service to consul")
-		return err
-	}
+	// cancelled on shutdown, the background loops stop with it
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
-	err = registry.RegisterService(ctx, serverConfig.Name+"-grpc", HTTPserviceID, serverConfig.HTTPAddr, httpPortInt, []string{"http"})
+	err = registry.RegisterService(ctx, serverConfig.Name+"-http", HTTPserviceID, serverConfig.HTTPAddr, httpPortInt, []string{"http"})
 	if err != nil {
-		logs.Error("Failed to register category service to consul")
+		logs.Error("Failed to register transaction service to consul")
 		return err
 	}
 
 	go func() {
 		failureCount := 0
 		const maxFailures = 5
-		for {
-			err := registry.HealthCheck(GRPCserviceID, serverConfig.Name+"-grpc")
-			if err != nil {
-				logs.Error(fmt.Sprintf("Failed to perform health check for gRPC service: %v", err))
-				failureCount++
-				if failureCount >= maxFailures {
-					logs.Error("Max health check failures reached for gRPC service. Exiting health check loop.")
-					break
-				}
-			} else {
-				failureCount = 0
-			}
-			time.Sleep(time.Second * 2)
-		}
-	}()
-	defer registry.DeregisterService(ctx, GRPCserviceID)
-
-	go func() {
-		failureCount := 0
-		const maxFailures = 5
-		for {
+		for ctx.Err() == nil {
 			err := registry.HealthCheck(HTTPserviceID, serverConfig.Name)
 			if err != nil {
 				logs.Error(fmt.Sprintf("Failed to perform health check: %v", err))
@@ -97,44 +67,113 @@ func webServer() error {
 			time.Sleep(time.Second * 2)
 		}
 	}()
-	defer registry.DeregisterService(ctx, HTTPserviceID)
+	defer registry.DeregisterService(context.Background(), HTTPserviceID)
 
-	aiAdapter, err := adapter.NewAiAdapter(ctx, registry)
+	photoAdapter, err := adapter.NewPhotoAdapter(ctx, registry)
 	if err != nil {
 		logs.Error(err)
 	}
-	minioAdpter := adapter.NewMinio()
-	photoRepo := repository.NewPhotoRepository()
-	photoUsecase := usecase.NewPhotoUsecase(dbConfig, photoRepo, aiAdapter, minioAdpter)
-	photoController := http.NewPhotoController(photoUsecase)
 
-	go func() {
-		// gRPC server + reflection
-		grpcServer := grpc.NewServer()
-		reflection.Register(grpcServer)
+	userAdapter, err := adapter.NewUserAdapter(ctx, registry)
+	if err != nil {
+		logs.Error("Failed to connect to user service: " + err.Error())
+		return err
+	}
 
-		l, err := net.Listen("tcp", serverConfig.GRPC)
-		if err != nil {
-			logs.Error(fmt.Sprintf("Failed to listen: %v", err))
-		}
-		logs.Log(fmt.Sprintf("gRPC server started on %s", serverConfig.GRPC))
-		defer l.Close()
+	paymentAdapter := adapter.NewFakePaymentAdapter()
+	disbursementAdapter := adapter.NewFakeDisbursementAdapter()
+
+	transactionRepo := repository.NewTransactionRepository()
+	paymentCallbackRepo := repository.NewPaymentCallbackRepository()
+	commissionRuleRepo := repository.NewCommissionRuleRepository()
+	walletRepo := repository.NewWalletRepository()
+	ledgerRepo := repository.NewLedgerRepository()
+	withdrawalRepo := repository.NewWithdrawalRepository()
+	idempotencyKeyRepo := repository.NewIdempotencyKeyRepository()
+	promoCodeRepo := repository.NewPromoCodeRepository()
+
+	transactionUsecase := usecase.NewTransactionUsecase(dbConfig, transactionRepo, paymentCallbackRepo, commissionRuleRepo,
+		promoCodeRepo, walletRepo, ledgerRepo, photoAdapter, paymentAdapter)
+	walletUsecase := usecase.NewWalletUsecase(dbConfig, walletRepo, ledgerRepo, withdrawalRepo, disbursementAdapter)
+	commissionUsecase := usecase.NewCommissionUsecase(dbConfig, commissionRuleRepo)
+	idempotencyUsecase := usecase.NewIdempotencyUsecase(dbConfig, idempotencyKeyRepo)
+	promoCodeUsecase := usecase.NewPromoCodeUsecase(dbConfig, promoCodeRepo)
+	reconciliationUsecase := usecase.NewReconciliationUsecase(dbConfig, transactionRepo, transactionUsecase)
+
+	go runEvery(ctx, time.Minute, func() {
+		transactionUsecase.RetryPhotoSync(ctx)
+	})
+
+	if serverConfig.PendingTransactionTimeout > 0 {
+		go runEvery(ctx, 5*time.Minute, func() {
+			expiredIds, err := transactionUsecase.ExpireStalePending(ctx, serverConfig.PendingTransactionTimeout)
+			if err == nil && len(expiredIds) > 0 {
+				logs.Log(fmt.Sprintf("Expired %d stale pending transactions", len(expiredIds)))
+			}
+		})
+	}
 
-		grpcHandler.NewPhotoGRPCHandler(grpcServer, photoUsecase)
+	authMiddleware := middleware.NewUserAuth(userAdapter)
+	adminMiddleware := middleware.RequireAdmin(serverConfig.AdminUserIds)
+	idempotencyMiddleware := middleware.NewIdempotency(idempotencyUsecase)
 
-		if err := grpcServer.Serve(l); err != nil {
-			logs.Error(fmt.Sprintf("Failed to start gRPC category server: %v", err))
-		}
+	transactionController := http.NewTransactionController(transactionUsecase, authMiddleware, adminMiddleware, idempotencyMiddleware)
+	walletController := http.NewWalletController(walletUsecase, authMiddleware, adminMiddleware, idempotencyMiddleware)
+	commissionController := http.NewCommissionController(commissionUsecase, authMiddleware, adminMiddleware, idempotencyMiddleware)
+	promoCodeController := http.NewPromoCodeController(promoCodeUsecase, authMiddleware, adminMiddleware, idempotencyMiddleware)
+	reconciliationController := http.NewReconciliationController(reconciliationUsecase, authMiddleware,
+		adminMiddleware, idempotencyMiddleware)
+
+	transactionController.Route(app)
+	walletController.Route(app)
+	commissionController.Route(app)
+	promoCodeController.Route(app)
+	reconciliationController.Route(app)
+
+	listenErr := make(chan error, 1)
+	go func() {
+		listenErr <- app.Listen(serverConfig.HTTP)
 	}()
+	logs.Log(fmt.Sprintf("Succsess connected http service at port: %v", serverConfig.HTTP))
 
-	app.Use(cors.New())
-	photoController.Route(app)
+	sigchan := make(chan os.Signal, 1)
+	signal.Notify(sigchan, syscall.SIGINT, syscall.SIGTERM)
 
-	err = app.Listen(serverConfig.HTTP)
+	select {
+	case sig := <-sigchan:
+		logs.Log(fmt.Sprintf("Received signal: %s. Shutting down gracefully...", sig))
+	case err := <-listenErr:
+		logs.Error(fmt.Sprintf("Failed to start HTTP transaction server: %v", err))
+		return err
+	}
 
-	if err != nil {
-		logs.Error(fmt.Sprintf("Failed to start HTTP category server: %v", err))
+	cancel()
+	if err := app.Shutdown(); err != nil {
+		logs.Error(fmt.Sprintf("Failed to shut down HTTP server: %v", err))
 		return err
 	}
 	return nil
 }
+
+// runEvery calls run every interval until ctx is cancelled.
+func runEvery(ctx context.Context, interval time.Duration, run func()) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			run()
+		}
+	}
+}
+
+func main() {
+	if err := webServer(); err != nil {
+		logs.Error(err)
+	}
+
+	logs.Log("Transaction service stopped")
+}
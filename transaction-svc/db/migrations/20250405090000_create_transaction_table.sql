@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE transaction_status AS ENUM ('PENDING', 'SETTLED', 'DENIED');
+
+CREATE TABLE IF NOT EXISTS transactions (
+    id CHAR(26) PRIMARY KEY NOT NULL,
+    user_id CHAR(26) NOT NULL,
+    photo_id CHAR(26) NOT NULL,
+    creator_id CHAR(26) NOT NULL,
+    amount BIGINT NOT NULL,
+    status transaction_status NOT NULL DEFAULT 'PENDING',
+    snap_token VARCHAR(255) NOT NULL DEFAULT '',
+    external_callback_response JSONB,
+    paid_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_transactions_user_id ON transactions(user_id);
+CREATE INDEX IF NOT EXISTS idx_transactions_photo_id ON transactions(photo_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS transactions;
+
+DROP TYPE transaction_status;
+
+-- +goose StatementEnd
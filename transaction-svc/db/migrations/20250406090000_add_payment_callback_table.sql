@@ -0,0 +1,25 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+ALTER TYPE transaction_status ADD VALUE IF NOT EXISTS 'EXPIRED';
+
+ALTER TYPE transaction_status ADD VALUE IF NOT EXISTS 'REFUNDED';
+
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS external_status VARCHAR(50) NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS payment_callbacks (
+    id CHAR(26) PRIMARY KEY NOT NULL,
+    transaction_id CHAR(26) NOT NULL,
+    external_status VARCHAR(50) NOT NULL DEFAULT '',
+    payload JSONB NOT NULL,
+    applied BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_payment_callbacks_transaction_id ON payment_callbacks(transaction_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS payment_callbacks;
+
+ALTER TABLE transactions DROP COLUMN IF EXISTS external_status;
+
+-- postgres can not drop enum values, EXPIRED and REFUNDED are kept on purpose
@@ -0,0 +1,50 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE wallet_type AS ENUM ('CREATOR', 'PLATFORM_CLEARING');
+
+CREATE TABLE IF NOT EXISTS wallets (
+    id CHAR(26) PRIMARY KEY NOT NULL,
+    owner_id VARCHAR(26) NOT NULL,
+    type wallet_type NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    UNIQUE (owner_id, type)
+);
+
+CREATE TABLE IF NOT EXISTS ledger_entries (
+    id CHAR(26) PRIMARY KEY NOT NULL,
+    journal_id CHAR(26) NOT NULL,
+    wallet_id CHAR(26) NOT NULL REFERENCES wallets(id),
+    transaction_id CHAR(26),
+    type VARCHAR(50) NOT NULL,
+    amount BIGINT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    UNIQUE (wallet_id, transaction_id, type)
+);
+
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_wallet_id_created_at ON ledger_entries(wallet_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_journal_id ON ledger_entries(journal_id);
+
+-- ledger entries are append-only
+CREATE OR REPLACE FUNCTION prevent_ledger_entry_change() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'ledger_entries is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER ledger_entries_append_only
+    BEFORE UPDATE OR DELETE ON ledger_entries
+    FOR EACH ROW EXECUTE FUNCTION prevent_ledger_entry_change();
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS ledger_entries;
+
+DROP FUNCTION IF EXISTS prevent_ledger_entry_change;
+
+DROP TABLE IF EXISTS wallets;
+
+DROP TYPE wallet_type;
+
+-- +goose StatementEnd
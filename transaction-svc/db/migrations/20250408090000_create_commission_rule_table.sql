@@ -0,0 +1,32 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+ALTER TYPE wallet_type ADD VALUE IF NOT EXISTS 'PLATFORM_REVENUE';
+
+-- creator_id '' is the platform default rule
+CREATE TABLE IF NOT EXISTS commission_rules (
+    id CHAR(26) PRIMARY KEY NOT NULL,
+    creator_id VARCHAR(26) NOT NULL DEFAULT '' UNIQUE,
+    percentage_bps INTEGER NOT NULL DEFAULT 0 CHECK (percentage_bps BETWEEN 0 AND 10000),
+    flat_fee BIGINT NOT NULL DEFAULT 0 CHECK (flat_fee >= 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp
+);
+
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS fee_amount BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS net_amount BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS commission_rule_id CHAR(26),
+    ADD COLUMN IF NOT EXISTS commission_percentage_bps INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS commission_flat_fee BIGINT NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE transactions
+    DROP COLUMN IF EXISTS fee_amount,
+    DROP COLUMN IF EXISTS net_amount,
+    DROP COLUMN IF EXISTS commission_rule_id,
+    DROP COLUMN IF EXISTS commission_percentage_bps,
+    DROP COLUMN IF EXISTS commission_flat_fee;
+
+DROP TABLE IF EXISTS commission_rules;
+
+-- postgres can not drop enum values, PLATFORM_REVENUE is kept on purpose
@@ -0,0 +1,41 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+ALTER TYPE wallet_type ADD VALUE IF NOT EXISTS 'CREATOR_HOLD';
+
+CREATE TYPE withdrawal_status AS ENUM ('REQUESTED', 'APPROVED', 'PAID', 'REJECTED');
+
+CREATE TABLE IF NOT EXISTS withdrawals (
+    id CHAR(26) PRIMARY KEY NOT NULL,
+    user_id CHAR(26) NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    status withdrawal_status NOT NULL DEFAULT 'REQUESTED',
+    bank_name VARCHAR(100) NOT NULL,
+    bank_account_number VARCHAR(50) NOT NULL,
+    bank_account_name VARCHAR(255) NOT NULL,
+    rejection_reason TEXT NOT NULL DEFAULT '',
+    disbursement_reference VARCHAR(255) NOT NULL DEFAULT '',
+    approved_at TIMESTAMPTZ,
+    paid_at TIMESTAMPTZ,
+    rejected_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_withdrawals_user_id ON withdrawals(user_id);
+CREATE INDEX IF NOT EXISTS idx_withdrawals_status ON withdrawals(status);
+
+ALTER TABLE ledger_entries ADD COLUMN IF NOT EXISTS withdrawal_id CHAR(26);
+
+ALTER TABLE ledger_entries ADD CONSTRAINT ledger_entries_wallet_id_withdrawal_id_type_key
+    UNIQUE (wallet_id, withdrawal_id, type);
+
+-- +goose Down
+ALTER TABLE ledger_entries DROP CONSTRAINT IF EXISTS ledger_entries_wallet_id_withdrawal_id_type_key;
+
+ALTER TABLE ledger_entries DROP COLUMN IF EXISTS withdrawal_id;
+
+DROP TABLE IF EXISTS withdrawals;
+
+DROP TYPE withdrawal_status;
+
+-- postgres can not drop enum values, CREATOR_HOLD is kept on purpose
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- false while photo-svc has not yet been told about the latest settle or refund
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS photo_synced BOOLEAN NOT NULL DEFAULT TRUE;
+
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS refunded_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_transactions_photo_unsynced ON transactions(updated_at) WHERE photo_synced = FALSE;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_transactions_photo_unsynced;
+
+ALTER TABLE transactions DROP COLUMN IF EXISTS refunded_at;
+
+ALTER TABLE transactions DROP COLUMN IF EXISTS photo_synced;
+
+-- +goose StatementEnd
@@ -0,0 +1,61 @@
+-- +goose Up
+-- +goose StatementBegin
+-- a transaction is an order, every bought photo is a line item with its own commission split
+CREATE TABLE IF NOT EXISTS transaction_items (
+    id CHAR(26) PRIMARY KEY NOT NULL,
+    transaction_id CHAR(26) NOT NULL REFERENCES transactions(id),
+    photo_id CHAR(26) NOT NULL,
+    creator_id CHAR(26) NOT NULL,
+    amount BIGINT NOT NULL,
+    fee_amount BIGINT NOT NULL DEFAULT 0,
+    net_amount BIGINT NOT NULL DEFAULT 0,
+    commission_rule_id CHAR(26),
+    commission_percentage_bps INTEGER NOT NULL DEFAULT 0,
+    commission_flat_fee BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    UNIQUE (transaction_id, photo_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_transaction_items_photo_id ON transaction_items(photo_id);
+CREATE INDEX IF NOT EXISTS idx_transaction_items_creator_id ON transaction_items(creator_id);
+
+INSERT INTO transaction_items
+    (id, transaction_id, photo_id, creator_id, amount, fee_amount, net_amount,
+     commission_rule_id, commission_percentage_bps, commission_flat_fee, created_at)
+SELECT id, id, photo_id, creator_id, amount, fee_amount, net_amount,
+       commission_rule_id, commission_percentage_bps, commission_flat_fee, created_at
+FROM transactions;
+
+DROP INDEX IF EXISTS idx_transactions_photo_id;
+
+ALTER TABLE transactions
+    DROP COLUMN IF EXISTS photo_id,
+    DROP COLUMN IF EXISTS creator_id,
+    DROP COLUMN IF EXISTS commission_rule_id,
+    DROP COLUMN IF EXISTS commission_percentage_bps,
+    DROP COLUMN IF EXISTS commission_flat_fee;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS photo_id CHAR(26),
+    ADD COLUMN IF NOT EXISTS creator_id CHAR(26),
+    ADD COLUMN IF NOT EXISTS commission_rule_id CHAR(26),
+    ADD COLUMN IF NOT EXISTS commission_percentage_bps INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS commission_flat_fee BIGINT NOT NULL DEFAULT 0;
+
+-- orders with more than one item keep only their first item
+UPDATE transactions t
+SET photo_id = i.photo_id, creator_id = i.creator_id, commission_rule_id = i.commission_rule_id,
+    commission_percentage_bps = i.commission_percentage_bps, commission_flat_fee = i.commission_flat_fee
+FROM (
+    SELECT DISTINCT ON (transaction_id) * FROM transaction_items ORDER BY transaction_id, id
+) i
+WHERE i.transaction_id = t.id;
+
+CREATE INDEX IF NOT EXISTS idx_transactions_photo_id ON transactions(photo_id);
+
+DROP TABLE IF EXISTS transaction_items;
+
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    key VARCHAR(255) PRIMARY KEY NOT NULL,
+    fingerprint CHAR(64) NOT NULL,
+    status_code INTEGER,
+    content_type VARCHAR(255) NOT NULL DEFAULT '',
+    response_body BYTEA,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    completed_at TIMESTAMPTZ
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS idempotency_keys;
+
+-- +goose StatementEnd
@@ -0,0 +1,54 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE promo_type AS ENUM ('PERCENTAGE', 'FIXED');
+
+-- value is in basis points for PERCENTAGE and in rupiah for FIXED, 0 limits mean unlimited
+CREATE TABLE IF NOT EXISTS promo_codes (
+    id CHAR(26) PRIMARY KEY NOT NULL,
+    code VARCHAR(50) NOT NULL UNIQUE,
+    type promo_type NOT NULL,
+    value BIGINT NOT NULL CHECK (value > 0),
+    max_redemptions INTEGER NOT NULL DEFAULT 0,
+    max_redemptions_per_user INTEGER NOT NULL DEFAULT 1,
+    creator_id VARCHAR(26) NOT NULL DEFAULT '',
+    starts_at TIMESTAMPTZ,
+    expires_at TIMESTAMPTZ,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp
+);
+
+CREATE TABLE IF NOT EXISTS promo_redemptions (
+    id CHAR(26) PRIMARY KEY NOT NULL,
+    promo_code_id CHAR(26) NOT NULL REFERENCES promo_codes(id),
+    user_id CHAR(26) NOT NULL,
+    transaction_id CHAR(26) NOT NULL UNIQUE REFERENCES transactions(id),
+    discount_amount BIGINT NOT NULL,
+    voided_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_promo_redemptions_promo_code_id_user_id ON promo_redemptions(promo_code_id, user_id);
+
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS discount_amount BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS promo_code_id CHAR(26);
+
+ALTER TABLE transaction_items ADD COLUMN IF NOT EXISTS discount_amount BIGINT NOT NULL DEFAULT 0;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE transaction_items DROP COLUMN IF EXISTS discount_amount;
+
+ALTER TABLE transactions
+    DROP COLUMN IF EXISTS discount_amount,
+    DROP COLUMN IF EXISTS promo_code_id;
+
+DROP TABLE IF EXISTS promo_redemptions;
+
+DROP TABLE IF EXISTS promo_codes;
+
+DROP TYPE promo_type;
+
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS payment_method_type VARCHAR(50) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS payment_method_provider VARCHAR(50) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS payment_method_account VARCHAR(50) NOT NULL DEFAULT '';
+
+-- the title at the time of purchase, a receipt must not change when the photo is renamed
+ALTER TABLE transaction_items ADD COLUMN IF NOT EXISTS photo_title VARCHAR(255) NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_transactions_user_id_created_at ON transactions(user_id, created_at DESC);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_transactions_user_id_created_at;
+
+ALTER TABLE transaction_items DROP COLUMN IF EXISTS photo_title;
+
+ALTER TABLE transactions
+    DROP COLUMN IF EXISTS payment_method_type,
+    DROP COLUMN IF EXISTS payment_method_provider,
+    DROP COLUMN IF EXISTS payment_method_account;
+
+-- +goose StatementEnd
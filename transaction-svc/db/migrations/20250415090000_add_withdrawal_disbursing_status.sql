@@ -0,0 +1,11 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+ALTER TYPE withdrawal_status ADD VALUE IF NOT EXISTS 'DISBURSING' AFTER 'APPROVED';
+
+-- set while an approval is calling the payout provider, cleared when the call returns
+ALTER TABLE withdrawals ADD COLUMN IF NOT EXISTS disbursement_claimed_at TIMESTAMPTZ;
+
+-- +goose Down
+ALTER TABLE withdrawals DROP COLUMN IF EXISTS disbursement_claimed_at;
+
+-- postgres can not drop enum values, DISBURSING is kept on purpose
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- keys are only remembered for a day, the ones stored without a user are dropped
+DELETE FROM idempotency_keys;
+
+ALTER TABLE idempotency_keys ADD COLUMN user_id CHAR(26) NOT NULL;
+ALTER TABLE idempotency_keys DROP CONSTRAINT idempotency_keys_pkey;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (user_id, key);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM idempotency_keys;
+
+ALTER TABLE idempotency_keys DROP CONSTRAINT idempotency_keys_pkey;
+ALTER TABLE idempotency_keys DROP COLUMN user_id;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (key);
+
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- set while a refund is calling the payment gateway, cleared when the call fails
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS refund_claimed_at TIMESTAMPTZ;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE transactions DROP COLUMN IF EXISTS refund_claimed_at;
+
+-- +goose StatementEnd
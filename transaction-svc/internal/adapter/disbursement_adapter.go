@@ -0,0 +1,54 @@
+package adapter
+
+import (
+	"be-yourmoments/transaction-svc/internal/entity"
+	"be-yourmoments/transaction-svc/internal/helper/utils"
+	"context"
+	"errors"
+	"log"
+	"sync"
+
+	"github.com/oklog/ulid/v2"
+)
+
+type DisbursementAdapter interface {
+	// Disburse sends the withdrawal to the creator's bank account and returns the provider reference.
+	// Implementations must pass idempotencyKey to the provider, a retried withdrawal is paid once.
+	Disburse(ctx context.Context, idempotencyKey string, withdrawal *entity.Withdrawal) (string, error)
+}
+
+// fakeDisbursementAdapter pays out nothing. Set FAKE_DISBURSEMENT_FAIL=true to make every payout fail.
+type fakeDisbursementAdapter struct {
+	fail       bool
+	mu         sync.Mutex
+	references map[string]string
+}
+
+func NewFakeDisbursementAdapter() DisbursementAdapter {
+	fail := utils.GetEnv("FAKE_DISBURSEMENT_FAIL") == "true"
+
+	log.Printf("using fake disbursement, failing payouts: %v", fail)
+
+	return &fakeDisbursementAdapter{
+		fail:       fail,
+		references: make(map[string]string),
+	}
+}
+
+func (a *fakeDisbursementAdapter) Disburse(ctx context.Context, idempotencyKey string, withdrawal *entity.Withdrawal) (string, error) {
+	if a.fail {
+		return "", errors.New("fake disbursement failed")
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if reference, ok := a.references[idempotencyKey]; ok {
+		return reference, nil
+	}
+
+	reference := "fake-disbursement-" + ulid.Make().String()
+	a.references[idempotencyKey] = reference
+
+	return reference, nil
+}
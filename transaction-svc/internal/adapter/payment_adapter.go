@@ -0,0 +1,102 @@
+package adapter
+
+import (
+	"be-yourmoments/transaction-svc/internal/entity"
+	"be-yourmoments/transaction-svc/internal/enum"
+	"be-yourmoments/transaction-svc/internal/helper/utils"
+	"be-yourmoments/transaction-svc/internal/model"
+	"context"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+type PaymentAdapter interface {
+	CreatePayment(ctx context.Context, transaction *entity.Transaction) (*model.PaymentResponse, error)
+	VerifySignature(notification *model.PaymentNotification) bool
+	// Refund refunds the full amount, transaction.Id is the refund key so retries refund once.
+	Refund(ctx context.Context, transaction *entity.Transaction, reason string) (json.RawMessage, error)
+}
+
+// fakePaymentAdapter stands in for Midtrans so the purchase flow can run locally.
+// FAKE_PAYMENT_STATUS decides how every charge ends (SETTLED by default).
+// Callbacks are still signed with PAYMENT_SERVER_KEY the same way Midtrans does it.
+type fakePaymentAdapter struct {
+	status    enum.TransactionStatus
+	serverKey string
+}
+
+func NewFakePaymentAdapter() PaymentAdapter {
+	status := enum.TransactionStatus(utils.GetEnv("FAKE_PAYMENT_STATUS"))
+	if status == "" {
+		status = enum.TransactionStatusSettled
+	}
+
+	log.Printf("using fake payment gateway, every payment will be %s", status)
+
+	serverKey := utils.GetEnv("PAYMENT_SERVER_KEY")
+	if serverKey == "" {
+		log.Println("PAYMENT_SERVER_KEY is not set, every payment callback will be rejected")
+	}
+
+	return &fakePaymentAdapter{
+		status:    status,
+		serverKey: serverKey,
+	}
+}
+
+func (a *fakePaymentAdapter) CreatePayment(ctx context.Context, transaction *entity.Transaction) (*model.PaymentResponse, error) {
+	token := "fake-" + ulid.Make().String()
+
+	rawResponse, err := json.Marshal(map[string]interface{}{
+		"gateway":        "fake",
+		"token":          token,
+		"order_id":       transaction.Id,
+		"gross_amount":   transaction.Amount,
+		"payment_status": a.status,
+		"created_at":     time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.PaymentResponse{
+		Token:       token,
+		Status:      a.status,
+		RawResponse: rawResponse,
+	}, nil
+}
+
+func (a *fakePaymentAdapter) Refund(ctx context.Context, transaction *entity.Transaction, reason string) (json.RawMessage, error) {
+	return json.Marshal(map[string]interface{}{
+		"gateway":        "fake",
+		"order_id":       transaction.Id,
+		"refund_key":     transaction.Id,
+		"refund_amount":  transaction.Amount,
+		"reason":         reason,
+		"payment_status": enum.MidtransPaymentStatusRefund,
+		"created_at":     time.Now(),
+	})
+}
+
+func (a *fakePaymentAdapter) VerifySignature(notification *model.PaymentNotification) bool {
+	return verifyMidtransSignature(a.serverKey, notification)
+}
+
+// verifyMidtransSignature checks signature_key = SHA512(order_id + status_code + gross_amount + server_key).
+func verifyMidtransSignature(serverKey string, notification *model.PaymentNotification) bool {
+	if serverKey == "" || notification.SignatureKey == "" {
+		return false
+	}
+
+	sum := sha512.Sum512([]byte(notification.OrderId + notification.StatusCode + notification.GrossAmount + serverKey))
+	expected := hex.EncodeToString(sum[:])
+
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(strings.ToLower(notification.SignatureKey))) == 1
+}
@@ -0,0 +1,165 @@
+package adapter
+
+import (
+	discovery "be-yourmoments/transaction-svc/internal/helper"
+	"be-yourmoments/transaction-svc/internal/model"
+	"be-yourmoments/transaction-svc/internal/pb"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PhotoAdapter interface {
+	GetPhotoPrice(ctx context.Context, photoId string) (*model.PhotoPrice, error)
+	GetCart(ctx context.Context, userId string) ([]*model.PhotoPrice, error)
+	// UpdatePhotosOwner is all or nothing, a conflict on one photo leaves every photo unchanged.
+	UpdatePhotosOwner(ctx context.Context, photoIds []string, userId string) error
+	// ClearPhotosOwner skips photos that are not owned by userId.
+	ClearPhotosOwner(ctx context.Context, photoIds []string, userId string) error
+	// ReservePhotos is all or nothing, a photo owned or reserved by someone else fails with a conflict.
+	ReservePhotos(ctx context.Context, photoIds []string, userId string) error
+	// CancelPhotoReservations skips photos that are not reserved by userId.
+	CancelPhotoReservations(ctx context.Context, photoIds []string, userId string) error
+}
+
+type photoAdapter struct {
+	client pb.PhotoServiceClient
+}
+
+func NewPhotoAdapter(ctx context.Context, registry discovery.Registry) (PhotoAdapter, error) {
+	conn, err := discovery.ServiceConnection(ctx, "photo-svc-grpc", registry)
+	if err != nil {
+		return nil, err
+	}
+
+	client := pb.NewPhotoServiceClient(conn)
+
+	return &photoAdapter{
+		client: client,
+	}, nil
+}
+
+func (a *photoAdapter) GetPhotoPrice(ctx context.Context, photoId string) (*model.PhotoPrice, error) {
+	pbRequest := &pb.GetPhotoPriceRequest{
+		Id: photoId,
+	}
+
+	res, err := a.client.GetPhotoPrice(ctx, pbRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Status >= 400 || res.Error != "" {
+		return nil, fiber.NewError(int(res.Status), res.Error)
+	}
+
+	return &model.PhotoPrice{
+		Id:            res.GetPhoto().GetId(),
+		CreatorId:     res.GetPhoto().GetCreatorId(),
+		OwnedByUserId: res.GetPhoto().GetOwnedByUserId(),
+		Title:         res.GetPhoto().GetTitle(),
+		Price:         int64(res.GetPhoto().GetPrice()),
+		PriceStr:      res.GetPhoto().GetPriceStr(),
+	}, nil
+}
+
+func (a *photoAdapter) GetCart(ctx context.Context, userId string) ([]*model.PhotoPrice, error) {
+	pbRequest := &pb.GetCartRequest{
+		UserId: userId,
+	}
+
+	res, err := a.client.GetCart(ctx, pbRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Status >= 400 || res.Error != "" {
+		return nil, fiber.NewError(int(res.Status), res.Error)
+	}
+
+	photos := make([]*model.PhotoPrice, 0, len(res.GetPhotos()))
+	for _, photo := range res.GetPhotos() {
+		photos = append(photos, &model.PhotoPrice{
+			Id:            photo.GetId(),
+			CreatorId:     photo.GetCreatorId(),
+			OwnedByUserId: photo.GetOwnedByUserId(),
+			Title:         photo.GetTitle(),
+			Price:         int64(photo.GetPrice()),
+			PriceStr:      photo.GetPriceStr(),
+		})
+	}
+
+	return photos, nil
+}
+
+func (a *photoAdapter) UpdatePhotosOwner(ctx context.Context, photoIds []string, userId string) error {
+	pbRequest := &pb.UpdatePhotosOwnerRequest{
+		Ids:           photoIds,
+		OwnedByUserId: userId,
+	}
+
+	res, err := a.client.UpdatePhotosOwner(ctx, pbRequest)
+	if err != nil {
+		return err
+	}
+
+	if res.Status >= 400 || res.Error != "" {
+		return fiber.NewError(int(res.Status), res.Error)
+	}
+
+	return nil
+}
+
+func (a *photoAdapter) ClearPhotosOwner(ctx context.Context, photoIds []string, userId string) error {
+	pbRequest := &pb.ClearPhotosOwnerRequest{
+		Ids:           photoIds,
+		OwnedByUserId: userId,
+	}
+
+	res, err := a.client.ClearPhotosOwner(ctx, pbRequest)
+	if err != nil {
+		return err
+	}
+
+	if res.Status >= 400 || res.Error != "" {
+		return fiber.NewError(int(res.Status), res.Error)
+	}
+
+	return nil
+}
+
+func (a *photoAdapter) ReservePhotos(ctx context.Context, photoIds []string, userId string) error {
+	pbRequest := &pb.ReservePhotosRequest{
+		Ids:    photoIds,
+		UserId: userId,
+	}
+
+	res, err := a.client.ReservePhotos(ctx, pbRequest)
+	if err != nil {
+		return err
+	}
+
+	if res.Status >= 400 || res.Error != "" {
+		return fiber.NewError(int(res.Status), res.Error)
+	}
+
+	return nil
+}
+
+func (a *photoAdapter) CancelPhotoReservations(ctx context.Context, photoIds []string, userId string) error {
+	pbRequest := &pb.CancelPhotoReservationsRequest{
+		Ids:    photoIds,
+		UserId: userId,
+	}
+
+	res, err := a.client.CancelPhotoReservations(ctx, pbRequest)
+	if err != nil {
+		return err
+	}
+
+	if res.Status >= 400 || res.Error != "" {
+		return fiber.NewError(int(res.Status), res.Error)
+	}
+
+	return nil
+}
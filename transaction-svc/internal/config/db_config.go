@@ -1,8 +1,8 @@
 package config
 
 import (
-	logs "be-yourmoments/photo-svc/internal/helper/logger"
-	"be-yourmoments/photo-svc/internal/helper/utils"
+	logs "be-yourmoments/transaction-svc/internal/helper/logger"
+	"be-yourmoments/transaction-svc/internal/helper/utils"
 
 	"context"
 	"fmt"
@@ -0,0 +1,40 @@
+package config
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/cors"
+)
+
+func NewApp() *fiber.App {
+	app := fiber.New(fiber.Config{
+		Prefork:      false,
+		AppName:      "transaction-svc",
+		ErrorHandler: CustomError(),
+	})
+
+	app.Use(cors.ConfigDefault)
+	return app
+}
+
+func CustomError() fiber.ErrorHandler {
+	return func(ctx *fiber.Ctx, err error) error {
+		code := http.StatusInternalServerError
+		if err, ok := err.(*fiber.Error); ok {
+			code = err.Code
+		}
+
+		message := &Message{
+			Success: false,
+			Error:   err.Error(),
+		}
+
+		return ctx.Status(code).JSON(message)
+	}
+}
+
+type Message struct {
+	Success bool   `json:"success"`
+	Error   string `json:"error"`
+}
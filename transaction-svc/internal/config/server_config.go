@@ -1,9 +1,11 @@
 package config
 
 import (
-	"be-yourmoments/photo-svc/internal/helper/utils"
+	"be-yourmoments/transaction-svc/internal/helper/utils"
 	"fmt"
 	"log"
+	"strings"
+	"time"
 )
 
 var EndpointPrefix = utils.GetEnv("ENDPOINT_PREFIX")
@@ -17,6 +19,10 @@ type ServerConfig struct {
 	GRPCPort   string
 	ConsulAddr string
 	Name       string
+	// PendingTransactionTimeout expires transactions pending for longer, zero leaves them pending.
+	PendingTransactionTimeout time.Duration
+	// AdminUserIds are the users allowed on the admin endpoints, until user-svc has roles.
+	AdminUserIds []string
 }
 
 func NewServerConfig() ServerConfig {
@@ -44,6 +50,19 @@ func NewServerConfig() ServerConfig {
 	if name == "" {
 		log.Fatal("SERVICE_NAME environment variable is not set")
 	}
+	var pendingTimeout time.Duration
+	if value := utils.GetEnv("PENDING_TRANSACTION_TIMEOUT"); value != "" {
+		var err error
+		if pendingTimeout, err = time.ParseDuration(value); err != nil || pendingTimeout <= 0 {
+			log.Fatal("PENDING_TRANSACTION_TIMEOUT must be a positive duration like 24h")
+		}
+	}
+	var adminUserIds []string
+	for _, userId := range strings.Split(utils.GetEnv("ADMIN_USER_IDS"), ",") {
+		if userId = strings.TrimSpace(userId); userId != "" {
+			adminUserIds = append(adminUserIds, userId)
+		}
+	}
 	return ServerConfig{
 		HTTP:       fmt.Sprintf("%s:%s", httpAddr, port),
 		HTTPAddr:   httpAddr,
@@ -53,5 +72,8 @@ func NewServerConfig() ServerConfig {
 		GRPCPort:   grpcPort,
 		ConsulAddr: consulAddr,
 		Name:       name,
+
+		PendingTransactionTimeout: pendingTimeout,
+		AdminUserIds:              adminUserIds,
 	}
 }
@@ -0,0 +1,83 @@
+package http
+
+import (
+	"be-yourmoments/transaction-svc/internal/model"
+	"be-yourmoments/transaction-svc/internal/usecase"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type CommissionController interface {
+	ListRules(ctx *fiber.Ctx) error
+	UpsertDefaultRule(ctx *fiber.Ctx) error
+	UpsertCreatorRule(ctx *fiber.Ctx) error
+	DeleteCreatorRule(ctx *fiber.Ctx) error
+	Route(app *fiber.App)
+}
+
+type commissionController struct {
+	commissionUsecase     usecase.CommissionUsecase
+	authMiddleware        fiber.Handler
+	adminMiddleware       fiber.Handler
+	idempotencyMiddleware fiber.Handler
+}
+
+func NewCommissionController(commissionUsecase usecase.CommissionUsecase, authMiddleware fiber.Handler, adminMiddleware fiber.Handler,
+	idempotencyMiddleware fiber.Handler) CommissionController {
+	return &commissionController{
+		commissionUsecase:     commissionUsecase,
+		authMiddleware:        authMiddleware,
+		adminMiddleware:       adminMiddleware,
+		idempotencyMiddleware: idempotencyMiddleware,
+	}
+}
+
+func (c *commissionController) ListRules(ctx *fiber.Ctx) error {
+	response, err := c.commissionUsecase.ListRules(ctx.UserContext())
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[[]*model.CommissionRuleResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *commissionController) UpsertDefaultRule(ctx *fiber.Ctx) error {
+	return c.upsertRule(ctx, "")
+}
+
+func (c *commissionController) UpsertCreatorRule(ctx *fiber.Ctx) error {
+	return c.upsertRule(ctx, ctx.Params("creatorId"))
+}
+
+func (c *commissionController) upsertRule(ctx *fiber.Ctx, creatorId string) error {
+	request := new(model.UpsertCommissionRuleRequest)
+	if err := ctx.BodyParser(request); err != nil {
+		return fiber.NewError(http.StatusBadRequest, err.Error())
+	}
+
+	request.CreatorId = creatorId
+
+	response, err := c.commissionUsecase.UpsertRule(ctx.UserContext(), request)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.CommissionRuleResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *commissionController) DeleteCreatorRule(ctx *fiber.Ctx) error {
+	if err := c.commissionUsecase.DeleteCreatorRule(ctx.UserContext(), ctx.Params("creatorId")); err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[any]{
+		Success: true,
+	})
+}
@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"be-yourmoments/transaction-svc/internal/model"
+	"be-yourmoments/transaction-svc/internal/usecase"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// NewIdempotency replays the stored response when a user sends a mutating request again with the
+// same Idempotency-Key. It runs after NewUserAuth, keys are scoped to the authenticated user so one
+// user's key never replays another user's response. Requests without the header are passed through
+// unchanged. Server errors are not stored so the client can retry them with the same key.
+func NewIdempotency(idempotencyUsecase usecase.IdempotencyUsecase) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		key := ctx.Get(IdempotencyKeyHeader)
+		if key == "" || !isMutation(ctx.Method()) {
+			return ctx.Next()
+		}
+
+		if len(key) > 255 {
+			return fiber.NewError(fiber.StatusBadRequest, IdempotencyKeyHeader+" must be at most 255 characters")
+		}
+
+		userId := GetUser(ctx).UserId
+		stored, err := idempotencyUsecase.Begin(ctx.UserContext(), userId, key, fingerprint(ctx))
+		if err != nil {
+			return err
+		}
+
+		if stored != nil {
+			ctx.Set("Idempotent-Replayed", "true")
+			ctx.Set(fiber.HeaderContentType, stored.ContentType)
+			return ctx.Status(stored.StatusCode).Send(stored.Body)
+		}
+
+		if err := ctx.Next(); err != nil {
+			if err := ctx.App().ErrorHandler(ctx, err); err != nil {
+				return err
+			}
+		}
+
+		statusCode := ctx.Response().StatusCode()
+		if statusCode >= fiber.StatusInternalServerError {
+			if err := idempotencyUsecase.Release(ctx.UserContext(), userId, key); err != nil {
+				log.Println(err)
+			}
+			return nil
+		}
+
+		err = idempotencyUsecase.Complete(ctx.UserContext(), userId, key, &model.IdempotentResponse{
+			StatusCode:  statusCode,
+			ContentType: string(ctx.Response().Header.ContentType()),
+			Body:        append([]byte(nil), ctx.Response().Body()...),
+		})
+		if err != nil {
+			log.Println(err)
+		}
+
+		return nil
+	}
+}
+
+func isMutation(method string) bool {
+	switch method {
+	case fiber.MethodPost, fiber.MethodPut, fiber.MethodPatch, fiber.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func fingerprint(ctx *fiber.Ctx) string {
+	sum := sha256.New()
+	sum.Write([]byte(ctx.Method()))
+	sum.Write([]byte{0})
+	sum.Write([]byte(ctx.OriginalURL()))
+	sum.Write([]byte{0})
+	sum.Write(ctx.Body())
+	return hex.EncodeToString(sum.Sum(nil))
+}
@@ -0,0 +1,105 @@
+package http
+
+import (
+	"be-yourmoments/transaction-svc/internal/model"
+	"be-yourmoments/transaction-svc/internal/usecase"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PromoCodeController interface {
+	CreatePromoCode(ctx *fiber.Ctx) error
+	ListPromoCodes(ctx *fiber.Ctx) error
+	GetPromoCode(ctx *fiber.Ctx) error
+	UpdatePromoCode(ctx *fiber.Ctx) error
+	DeactivatePromoCode(ctx *fiber.Ctx) error
+	Route(app *fiber.App)
+}
+
+type promoCodeController struct {
+	promoCodeUsecase      usecase.PromoCodeUsecase
+	authMiddleware        fiber.Handler
+	adminMiddleware       fiber.Handler
+	idempotencyMiddleware fiber.Handler
+}
+
+func NewPromoCodeController(promoCodeUsecase usecase.PromoCodeUsecase, authMiddleware fiber.Handler, adminMiddleware fiber.Handler,
+	idempotencyMiddleware fiber.Handler) PromoCodeController {
+	return &promoCodeController{
+		promoCodeUsecase:      promoCodeUsecase,
+		authMiddleware:        authMiddleware,
+		adminMiddleware:       adminMiddleware,
+		idempotencyMiddleware: idempotencyMiddleware,
+	}
+}
+
+func (c *promoCodeController) CreatePromoCode(ctx *fiber.Ctx) error {
+	request := new(model.PromoCodeRequest)
+	if err := ctx.BodyParser(request); err != nil {
+		return fiber.NewError(http.StatusBadRequest, err.Error())
+	}
+
+	response, err := c.promoCodeUsecase.CreatePromoCode(ctx.UserContext(), request)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusCreated).JSON(model.WebResponse[*model.PromoCodeResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *promoCodeController) ListPromoCodes(ctx *fiber.Ctx) error {
+	response, err := c.promoCodeUsecase.ListPromoCodes(ctx.UserContext())
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[[]*model.PromoCodeResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *promoCodeController) GetPromoCode(ctx *fiber.Ctx) error {
+	response, err := c.promoCodeUsecase.GetPromoCode(ctx.UserContext(), ctx.Params("promoCodeId"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.PromoCodeResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *promoCodeController) UpdatePromoCode(ctx *fiber.Ctx) error {
+	request := new(model.PromoCodeRequest)
+	if err := ctx.BodyParser(request); err != nil {
+		return fiber.NewError(http.StatusBadRequest, err.Error())
+	}
+
+	request.Id = ctx.Params("promoCodeId")
+
+	response, err := c.promoCodeUsecase.UpdatePromoCode(ctx.UserContext(), request)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.PromoCodeResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *promoCodeController) DeactivatePromoCode(ctx *fiber.Ctx) error {
+	if err := c.promoCodeUsecase.DeactivatePromoCode(ctx.UserContext(), ctx.Params("promoCodeId")); err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[any]{
+		Success: true,
+	})
+}
@@ -0,0 +1,86 @@
+package http
+
+import (
+	"be-yourmoments/transaction-svc/internal/helper/document"
+	"be-yourmoments/transaction-svc/internal/model"
+	"be-yourmoments/transaction-svc/internal/usecase"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ReconciliationController interface {
+	Reconcile(ctx *fiber.Ctx) error
+	Route(app *fiber.App)
+}
+
+type reconciliationController struct {
+	reconciliationUsecase usecase.ReconciliationUsecase
+	authMiddleware        fiber.Handler
+	adminMiddleware       fiber.Handler
+	idempotencyMiddleware fiber.Handler
+}
+
+func NewReconciliationController(reconciliationUsecase usecase.ReconciliationUsecase, authMiddleware fiber.Handler, adminMiddleware fiber.Handler,
+	idempotencyMiddleware fiber.Handler) ReconciliationController {
+	return &reconciliationController{
+		reconciliationUsecase: reconciliationUsecase,
+		authMiddleware:        authMiddleware,
+		adminMiddleware:       adminMiddleware,
+		idempotencyMiddleware: idempotencyMiddleware,
+	}
+}
+
+// Reconcile takes the settlement report as the multipart file "report" (.csv or .json) covering the
+// from and to dates (YYYY-MM-DD, both days included). pending_timeout defaults to 24h and stuck
+// transactions are only expired when expire_pending is true.
+func (c *reconciliationController) Reconcile(ctx *fiber.Ctx) error {
+	request := &model.ReconciliationRequest{
+		ExpirePending: ctx.FormValue("expire_pending") == "true",
+	}
+
+	var err error
+	if request.PendingTimeout, err = time.ParseDuration(ctx.FormValue("pending_timeout", "24h")); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "pending_timeout must be a duration like 24h")
+	}
+
+	from, err := time.ParseInLocation(time.DateOnly, ctx.FormValue("from"), time.Local)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "from must be a date in YYYY-MM-DD format")
+	}
+	to, err := time.ParseInLocation(time.DateOnly, ctx.FormValue("to"), time.Local)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "to must be a date in YYYY-MM-DD format")
+	}
+	request.From = from
+	request.To = to.AddDate(0, 0, 1)
+
+	fileHeader, err := ctx.FormFile("report")
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "report file is required")
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	format := strings.TrimPrefix(filepath.Ext(fileHeader.Filename), ".")
+	if request.Records, err = document.ParseSettlementReport(file, format); err != nil {
+		return fiber.NewError(http.StatusBadRequest, err.Error())
+	}
+
+	response, err := c.reconciliationUsecase.Reconcile(ctx.UserContext(), request)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.ReconciliationResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
@@ -1,13 +1,56 @@
 package http
 
 import (
-	"be-yourmoments/photo-svc/internal/config"
+	"be-yourmoments/transaction-svc/internal/config"
+	"be-yourmoments/transaction-svc/internal/delivery/http/middleware"
 
 	"github.com/gofiber/fiber/v2"
 )
 
-func (c *photoController) Route(app *fiber.App) {
+func (c *transactionController) Route(app *fiber.App) {
 	api := app.Group(config.EndpointPrefix)
-	api.Post("/:id", c.UploadPhoto)
+	self := middleware.RequireSelf("userId")
+	api.Post("/photos/:photoId/buy", c.authMiddleware, c.idempotencyMiddleware, c.BuyPhoto)
+	api.Post("/checkout", c.authMiddleware, c.idempotencyMiddleware, c.Checkout)
+	api.Post("/payments/notification", c.PaymentNotification)
+	api.Post("/admin/transactions/:transactionId/refund", c.authMiddleware, c.adminMiddleware, c.idempotencyMiddleware, c.Refund)
+	api.Get("/users/:userId/transactions", c.authMiddleware, self, c.GetHistory)
+	api.Get("/users/:userId/transactions/:transactionId/receipt", c.authMiddleware, self, c.GetReceipt)
+	api.Get("/users/:userId/transactions/:transactionId/receipt/pdf", c.authMiddleware, self, c.GetReceiptPdf)
+}
+
+func (c *walletController) Route(app *fiber.App) {
+	api := app.Group(config.EndpointPrefix)
+	self := middleware.RequireSelf("userId")
+	api.Get("/users/:userId/wallet", c.authMiddleware, self, c.GetBalance)
+	api.Get("/users/:userId/wallet/entries", c.authMiddleware, self, c.GetHistory)
+	api.Post("/users/:userId/wallet/withdrawals", c.authMiddleware, self, c.idempotencyMiddleware, c.RequestWithdrawal)
+	api.Get("/users/:userId/wallet/withdrawals", c.authMiddleware, self, c.GetUserWithdrawals)
+
+	admin := app.Group(config.EndpointPrefix+"/admin/withdrawals", c.authMiddleware, c.adminMiddleware, c.idempotencyMiddleware)
+	admin.Get("/", c.GetWithdrawals)
+	admin.Post("/:withdrawalId/approve", c.ApproveWithdrawal)
+	admin.Post("/:withdrawalId/reject", c.RejectWithdrawal)
+}
+
+func (c *commissionController) Route(app *fiber.App) {
+	api := app.Group(config.EndpointPrefix+"/admin/commission-rules", c.authMiddleware, c.adminMiddleware, c.idempotencyMiddleware)
+	api.Get("/", c.ListRules)
+	api.Put("/default", c.UpsertDefaultRule)
+	api.Put("/creators/:creatorId", c.UpsertCreatorRule)
+	api.Delete("/creators/:creatorId", c.DeleteCreatorRule)
+}
+
+func (c *promoCodeController) Route(app *fiber.App) {
+	api := app.Group(config.EndpointPrefix+"/admin/promo-codes", c.authMiddleware, c.adminMiddleware, c.idempotencyMiddleware)
+	api.Post("/", c.CreatePromoCode)
+	api.Get("/", c.ListPromoCodes)
+	api.Get("/:promoCodeId", c.GetPromoCode)
+	api.Put("/:promoCodeId", c.UpdatePromoCode)
+	api.Delete("/:promoCodeId", c.DeactivatePromoCode)
+}
 
+func (c *reconciliationController) Route(app *fiber.App) {
+	api := app.Group(config.EndpointPrefix+"/admin/reconciliations", c.authMiddleware, c.adminMiddleware, c.idempotencyMiddleware)
+	api.Post("/", c.Reconcile)
 }
@@ -0,0 +1,200 @@
+package http
+
+import (
+	"be-yourmoments/transaction-svc/internal/delivery/http/middleware"
+	"be-yourmoments/transaction-svc/internal/enum"
+	"be-yourmoments/transaction-svc/internal/model"
+	"be-yourmoments/transaction-svc/internal/usecase"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type TransactionController interface {
+	BuyPhoto(ctx *fiber.Ctx) error
+	Checkout(ctx *fiber.Ctx) error
+	PaymentNotification(ctx *fiber.Ctx) error
+	Refund(ctx *fiber.Ctx) error
+	GetHistory(ctx *fiber.Ctx) error
+	GetReceipt(ctx *fiber.Ctx) error
+	GetReceiptPdf(ctx *fiber.Ctx) error
+	Route(app *fiber.App)
+}
+
+type transactionController struct {
+	transactionUsecase    usecase.TransactionUsecase
+	authMiddleware        fiber.Handler
+	adminMiddleware       fiber.Handler
+	idempotencyMiddleware fiber.Handler
+}
+
+func NewTransactionController(transactionUsecase usecase.TransactionUsecase, authMiddleware fiber.Handler, adminMiddleware fiber.Handler,
+	idempotencyMiddleware fiber.Handler) TransactionController {
+	return &transactionController{
+		transactionUsecase:    transactionUsecase,
+		authMiddleware:        authMiddleware,
+		adminMiddleware:       adminMiddleware,
+		idempotencyMiddleware: idempotencyMiddleware,
+	}
+}
+
+func (c *transactionController) BuyPhoto(ctx *fiber.Ctx) error {
+	request := new(model.BuyPhotoRequest)
+	if err := ctx.BodyParser(request); err != nil {
+		return fiber.NewError(http.StatusBadRequest, err.Error())
+	}
+
+	request.UserId = middleware.GetUser(ctx).UserId
+	request.PhotoId = ctx.Params("photoId")
+	if request.PhotoId == "" {
+		return fiber.NewError(http.StatusBadRequest, "photo id is required")
+	}
+
+	response, err := c.transactionUsecase.BuyPhoto(ctx.UserContext(), request)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusCreated).JSON(model.WebResponse[*model.TransactionResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *transactionController) Checkout(ctx *fiber.Ctx) error {
+	request := new(model.CheckoutRequest)
+	if err := ctx.BodyParser(request); err != nil {
+		return fiber.NewError(http.StatusBadRequest, err.Error())
+	}
+
+	request.UserId = middleware.GetUser(ctx).UserId
+
+	response, err := c.transactionUsecase.Checkout(ctx.UserContext(), request)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusCreated).JSON(model.WebResponse[*model.TransactionResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *transactionController) PaymentNotification(ctx *fiber.Ctx) error {
+	request := new(model.PaymentNotification)
+	if err := ctx.BodyParser(request); err != nil {
+		return fiber.NewError(http.StatusBadRequest, err.Error())
+	}
+
+	if request.OrderId == "" {
+		return fiber.NewError(http.StatusBadRequest, "order_id is required")
+	}
+
+	request.Raw = append([]byte(nil), ctx.Body()...)
+
+	if err := c.transactionUsecase.HandlePaymentNotification(ctx.UserContext(), request); err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[any]{
+		Success: true,
+	})
+}
+
+func (c *transactionController) Refund(ctx *fiber.Ctx) error {
+	request := new(model.RefundRequest)
+	if err := ctx.BodyParser(request); err != nil {
+		return fiber.NewError(http.StatusBadRequest, err.Error())
+	}
+
+	request.TransactionId = ctx.Params("transactionId")
+
+	response, err := c.transactionUsecase.Refund(ctx.UserContext(), request)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.TransactionResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+// GetHistory filters by the status query and by the from and to dates (YYYY-MM-DD), both days included.
+func (c *transactionController) GetHistory(ctx *fiber.Ctx) error {
+	request := &model.TransactionHistoryRequest{
+		UserId: ctx.Params("userId"),
+		Status: enum.TransactionStatus(ctx.Query("status")),
+		Page:   ctx.QueryInt("page", 1),
+		Size:   ctx.QueryInt("size", 20),
+	}
+
+	if request.Page < 1 || request.Size < 1 || request.Size > 100 {
+		return fiber.NewError(http.StatusBadRequest, "page must be at least 1 and size between 1 and 100")
+	}
+
+	var err error
+	if request.From, err = parseDateQuery(ctx, "from"); err != nil {
+		return err
+	}
+	if request.To, err = parseDateQuery(ctx, "to"); err != nil {
+		return err
+	}
+	if request.To != nil {
+		to := request.To.AddDate(0, 0, 1)
+		request.To = &to
+	}
+
+	response, pageMetadata, err := c.transactionUsecase.GetHistory(ctx.UserContext(), request)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[[]*model.TransactionResponse]{
+		Success:      true,
+		Data:         response,
+		PageMetadata: pageMetadata,
+	})
+}
+
+func (c *transactionController) GetReceipt(ctx *fiber.Ctx) error {
+	response, err := c.transactionUsecase.GetReceipt(ctx.UserContext(), ctx.Params("userId"), ctx.Params("transactionId"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.ReceiptResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *transactionController) GetReceiptPdf(ctx *fiber.Ctx) error {
+	transactionId := ctx.Params("transactionId")
+
+	pdf, err := c.transactionUsecase.GetReceiptPdf(ctx.UserContext(), ctx.Params("userId"), transactionId)
+	if err != nil {
+		return err
+	}
+
+	ctx.Set(fiber.HeaderContentType, "application/pdf")
+	ctx.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="receipt-%s.pdf"`, transactionId))
+
+	return ctx.Status(http.StatusOK).Send(pdf)
+}
+
+func parseDateQuery(ctx *fiber.Ctx, key string) (*time.Time, error) {
+	value := ctx.Query(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	date, err := time.ParseInLocation(time.DateOnly, value, time.Local)
+	if err != nil {
+		return nil, fiber.NewError(http.StatusBadRequest, key+" must be a date in YYYY-MM-DD format")
+	}
+
+	return &date, nil
+}
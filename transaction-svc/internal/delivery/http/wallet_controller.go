@@ -0,0 +1,149 @@
+package http
+
+import (
+	"be-yourmoments/transaction-svc/internal/enum"
+	"be-yourmoments/transaction-svc/internal/model"
+	"be-yourmoments/transaction-svc/internal/usecase"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type WalletController interface {
+	GetBalance(ctx *fiber.Ctx) error
+	GetHistory(ctx *fiber.Ctx) error
+	RequestWithdrawal(ctx *fiber.Ctx) error
+	GetUserWithdrawals(ctx *fiber.Ctx) error
+	GetWithdrawals(ctx *fiber.Ctx) error
+	ApproveWithdrawal(ctx *fiber.Ctx) error
+	RejectWithdrawal(ctx *fiber.Ctx) error
+	Route(app *fiber.App)
+}
+
+type walletController struct {
+	walletUsecase         usecase.WalletUsecase
+	authMiddleware        fiber.Handler
+	adminMiddleware       fiber.Handler
+	idempotencyMiddleware fiber.Handler
+}
+
+func NewWalletController(walletUsecase usecase.WalletUsecase, authMiddleware fiber.Handler, adminMiddleware fiber.Handler,
+	idempotencyMiddleware fiber.Handler) WalletController {
+	return &walletController{
+		walletUsecase:         walletUsecase,
+		authMiddleware:        authMiddleware,
+		adminMiddleware:       adminMiddleware,
+		idempotencyMiddleware: idempotencyMiddleware,
+	}
+}
+
+func (c *walletController) GetBalance(ctx *fiber.Ctx) error {
+	response, err := c.walletUsecase.GetBalance(ctx.UserContext(), ctx.Params("userId"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.WalletBalanceResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *walletController) GetHistory(ctx *fiber.Ctx) error {
+	request := &model.WalletHistoryRequest{
+		UserId: ctx.Params("userId"),
+		Page:   ctx.QueryInt("page", 1),
+		Size:   ctx.QueryInt("size", 20),
+	}
+
+	if request.Page < 1 || request.Size < 1 || request.Size > 100 {
+		return fiber.NewError(http.StatusBadRequest, "page must be at least 1 and size between 1 and 100")
+	}
+
+	response, pageMetadata, err := c.walletUsecase.GetHistory(ctx.UserContext(), request)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[[]*model.LedgerEntryResponse]{
+		Success:      true,
+		Data:         response,
+		PageMetadata: pageMetadata,
+	})
+}
+
+func (c *walletController) RequestWithdrawal(ctx *fiber.Ctx) error {
+	request := new(model.CreateWithdrawalRequest)
+	if err := ctx.BodyParser(request); err != nil {
+		return fiber.NewError(http.StatusBadRequest, err.Error())
+	}
+
+	request.UserId = ctx.Params("userId")
+
+	response, err := c.walletUsecase.RequestWithdrawal(ctx.UserContext(), request)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusCreated).JSON(model.WebResponse[*model.WithdrawalResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *walletController) GetUserWithdrawals(ctx *fiber.Ctx) error {
+	response, err := c.walletUsecase.GetUserWithdrawals(ctx.UserContext(), ctx.Params("userId"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[[]*model.WithdrawalResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *walletController) GetWithdrawals(ctx *fiber.Ctx) error {
+	status := enum.WithdrawalStatus(ctx.Query("status", string(enum.WithdrawalStatusRequested)))
+
+	response, err := c.walletUsecase.GetWithdrawalsByStatus(ctx.UserContext(), status)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[[]*model.WithdrawalResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *walletController) ApproveWithdrawal(ctx *fiber.Ctx) error {
+	response, err := c.walletUsecase.ApproveWithdrawal(ctx.UserContext(), ctx.Params("withdrawalId"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.WithdrawalResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
+func (c *walletController) RejectWithdrawal(ctx *fiber.Ctx) error {
+	request := new(model.RejectWithdrawalRequest)
+	if err := ctx.BodyParser(request); err != nil {
+		return fiber.NewError(http.StatusBadRequest, err.Error())
+	}
+
+	request.Id = ctx.Params("withdrawalId")
+
+	response, err := c.walletUsecase.RejectWithdrawal(ctx.UserContext(), request)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.WithdrawalResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
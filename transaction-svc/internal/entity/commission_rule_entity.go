@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+// CommissionRule is the platform fee taken from a sale. A rule with an empty CreatorId
+// is the platform default, a creator rule overrides it completely.
+type CommissionRule struct {
+	Id            string
+	CreatorId     string
+	PercentageBps int
+	FlatFee       int64
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+// IdempotencyKey is a client supplied Idempotency-Key with the response of its first request. Keys
+// belong to the user that sent them. CompletedAt is nil while that request is still running.
+type IdempotencyKey struct {
+	UserId       string
+	Key          string
+	Fingerprint  string
+	StatusCode   int
+	ContentType  string
+	ResponseBody []byte
+	CreatedAt    time.Time
+	CompletedAt  *time.Time
+}
@@ -1 +1,11 @@
 package entity
+
+import "be-yourmoments/transaction-svc/internal/enum"
+
+// PaymentMethod is how the buyer paid as reported by the payment gateway. Provider is the bank,
+// e-wallet or store and Account the masked card or virtual account number, when the method has one.
+type PaymentMethod struct {
+	Type     enum.PaymentType
+	Provider string
+	Account  string
+}
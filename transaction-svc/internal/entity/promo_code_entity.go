@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"be-yourmoments/transaction-svc/internal/enum"
+	"time"
+)
+
+// PromoCode Value is in basis points for percentage codes and in rupiah for fixed codes.
+// A zero MaxRedemptions means unlimited, an empty CreatorId means every creator's photos.
+type PromoCode struct {
+	Id                    string
+	Code                  string
+	Type                  enum.PromoType
+	Value                 int64
+	MaxRedemptions        int
+	MaxRedemptionsPerUser int
+	CreatorId             string
+	StartsAt              *time.Time
+	ExpiresAt             *time.Time
+	IsActive              bool
+	RedemptionCount       int
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+}
+
+type PromoRedemption struct {
+	Id             string
+	PromoCodeId    string
+	UserId         string
+	TransactionId  string
+	DiscountAmount int64
+	VoidedAt       *time.Time
+	CreatedAt      time.Time
+}
@@ -1,18 +1,56 @@
 package entity
 
 import (
+	"be-yourmoments/transaction-svc/internal/enum"
 	"encoding/json"
 	"time"
 )
 
 type Transaction struct {
-	Id        string
-	UserId    string
-	PhotoId   string
-	SnapToken string
-	// ExternalStatus           enum.MidtransPaymentStatus
+	Id                       string
+	UserId                   string
+	Amount                   int64
+	DiscountAmount           int64
+	PromoCodeId              string
+	FeeAmount                int64
+	NetAmount                int64
+	Status                   enum.TransactionStatus
+	SnapToken                string
+	PaymentMethod            PaymentMethod
+	ExternalStatus           enum.MidtransPaymentStatus
 	ExternalCallbackResponse json.RawMessage
-	PaidAt                   time.Time
+	PhotoSynced              bool
+	PaidAt                   *time.Time
+	RefundedAt               *time.Time
 	CreatedAt                time.Time
 	UpdatedAt                time.Time
+	Items                    []*TransactionItem
+}
+
+// TransactionItem is one photo of an order, Amount is what the buyer pays after DiscountAmount.
+// The commission rule is copied onto the item so the creator's share can be reproduced after the rule changes.
+type TransactionItem struct {
+	Id                      string
+	TransactionId           string
+	PhotoId                 string
+	PhotoTitle              string
+	CreatorId               string
+	Amount                  int64
+	DiscountAmount          int64
+	FeeAmount               int64
+	NetAmount               int64
+	CommissionRuleId        string
+	CommissionPercentageBps int
+	CommissionFlatFee       int64
+	CreatedAt               time.Time
+}
+
+// PaymentCallback is a raw gateway notification, kept whether or not it changed the transaction.
+type PaymentCallback struct {
+	Id             string
+	TransactionId  string
+	ExternalStatus enum.MidtransPaymentStatus
+	Payload        json.RawMessage
+	Applied        bool
+	CreatedAt      time.Time
 }
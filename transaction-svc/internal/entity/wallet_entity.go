@@ -1,8 +1,47 @@
 package entity
 
+import (
+	"be-yourmoments/transaction-svc/internal/enum"
+	"time"
+)
+
+// Wallet holds no balance itself, the balance is always the sum of its ledger entries.
 type Wallet struct {
+	Id        string
+	OwnerId   string
+	Type      enum.WalletType
+	CreatedAt time.Time
+}
+
+// LedgerEntry is one side of a journal. Amount is in minor units (rupiah),
+// positive for credit and negative for debit, and every journal sums to zero.
+type LedgerEntry struct {
 	Id            string
-	UserId        string
-	IncomeString  string
-	IncomeDecimal float32
+	JournalId     string
+	WalletId      string
+	TransactionId string
+	WithdrawalId  string
+	Type          enum.LedgerEntryType
+	Amount        int64
+	Description   string
+	CreatedAt     time.Time
+}
+
+type Withdrawal struct {
+	Id                    string
+	UserId                string
+	Amount                int64
+	Status                enum.WithdrawalStatus
+	BankName              string
+	BankAccountNumber     string
+	BankAccountName       string
+	RejectionReason       string
+	DisbursementReference string
+	// DisbursementClaimedAt is set while an approval is calling the payout provider.
+	DisbursementClaimedAt *time.Time
+	ApprovedAt            *time.Time
+	PaidAt                *time.Time
+	RejectedAt            *time.Time
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
 }
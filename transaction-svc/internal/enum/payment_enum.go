@@ -0,0 +1,16 @@
+package enum
+
+// PaymentType is the Midtrans payment_type, values not listed here are stored as the gateway sends them.
+type PaymentType string
+
+const (
+	PaymentTypeCreditCard   PaymentType = "credit_card"
+	PaymentTypeBankTransfer PaymentType = "bank_transfer"
+	PaymentTypeEchannel     PaymentType = "echannel"
+	PaymentTypeGopay        PaymentType = "gopay"
+	PaymentTypeShopeepay    PaymentType = "shopeepay"
+	PaymentTypeQris         PaymentType = "qris"
+	PaymentTypeCstore       PaymentType = "cstore"
+	// PaymentTypeFree marks orders a promo code made free, they never reach the gateway.
+	PaymentTypeFree PaymentType = "free"
+)
@@ -0,0 +1,8 @@
+package enum
+
+type PromoType string
+
+const (
+	PromoTypePercentage PromoType = "PERCENTAGE"
+	PromoTypeFixed      PromoType = "FIXED"
+)
@@ -0,0 +1,14 @@
+package enum
+
+type ReconciliationIssueType string
+
+const (
+	// ReconciliationIssueMissingLocal is a gateway settlement without a local transaction.
+	ReconciliationIssueMissingLocal ReconciliationIssueType = "MISSING_LOCAL"
+	// ReconciliationIssueMissingGateway is a local paid transaction the gateway did not report.
+	ReconciliationIssueMissingGateway ReconciliationIssueType = "MISSING_GATEWAY"
+	ReconciliationIssueAmountMismatch ReconciliationIssueType = "AMOUNT_MISMATCH"
+	ReconciliationIssueStatusMismatch ReconciliationIssueType = "STATUS_MISMATCH"
+	// ReconciliationIssueStuckPending is a transaction that stayed pending longer than the timeout.
+	ReconciliationIssueStuckPending ReconciliationIssueType = "STUCK_PENDING"
+)
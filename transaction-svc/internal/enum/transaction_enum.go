@@ -0,0 +1,60 @@
+package enum
+
+type TransactionStatus string
+
+const (
+	TransactionStatusPending  TransactionStatus = "PENDING"
+	TransactionStatusSettled  TransactionStatus = "SETTLED"
+	TransactionStatusExpired  TransactionStatus = "EXPIRED"
+	TransactionStatusDenied   TransactionStatus = "DENIED"
+	TransactionStatusRefunded TransactionStatus = "REFUNDED"
+)
+
+// transactionTransitions lists every status a transaction may move to from a given status.
+// EXPIRED, DENIED and REFUNDED are final.
+var transactionTransitions = map[TransactionStatus][]TransactionStatus{
+	TransactionStatusPending: {TransactionStatusSettled, TransactionStatusExpired, TransactionStatusDenied},
+	TransactionStatusSettled: {TransactionStatusRefunded},
+}
+
+func (s TransactionStatus) CanTransitionTo(next TransactionStatus) bool {
+	for _, status := range transactionTransitions[s] {
+		if status == next {
+			return true
+		}
+	}
+	return false
+}
+
+type MidtransPaymentStatus string
+
+const (
+	MidtransPaymentStatusCapture       MidtransPaymentStatus = "capture"
+	MidtransPaymentStatusSettlement    MidtransPaymentStatus = "settlement"
+	MidtransPaymentStatusPending       MidtransPaymentStatus = "pending"
+	MidtransPaymentStatusDeny          MidtransPaymentStatus = "deny"
+	MidtransPaymentStatusCancel        MidtransPaymentStatus = "cancel"
+	MidtransPaymentStatusFailure       MidtransPaymentStatus = "failure"
+	MidtransPaymentStatusExpire        MidtransPaymentStatus = "expire"
+	MidtransPaymentStatusRefund        MidtransPaymentStatus = "refund"
+	MidtransPaymentStatusPartialRefund MidtransPaymentStatus = "partial_refund"
+)
+
+// TransactionStatus maps a gateway status to ours, ok is false for statuses we do not handle.
+// A partial refund leaves a settled order SETTLED, only a full refund reverses the sale and takes
+// the photos back.
+func (s MidtransPaymentStatus) TransactionStatus() (TransactionStatus, bool) {
+	switch s {
+	case MidtransPaymentStatusCapture, MidtransPaymentStatusSettlement, MidtransPaymentStatusPartialRefund:
+		return TransactionStatusSettled, true
+	case MidtransPaymentStatusPending:
+		return TransactionStatusPending, true
+	case MidtransPaymentStatusDeny, MidtransPaymentStatusCancel, MidtransPaymentStatusFailure:
+		return TransactionStatusDenied, true
+	case MidtransPaymentStatusExpire:
+		return TransactionStatusExpired, true
+	case MidtransPaymentStatusRefund:
+		return TransactionStatusRefunded, true
+	}
+	return "", false
+}
@@ -0,0 +1,50 @@
+package enum
+
+type WalletType string
+
+const (
+	WalletTypeCreator          WalletType = "CREATOR"
+	WalletTypeCreatorHold      WalletType = "CREATOR_HOLD"
+	WalletTypePlatformClearing WalletType = "PLATFORM_CLEARING"
+	WalletTypePlatformRevenue  WalletType = "PLATFORM_REVENUE"
+)
+
+// PlatformWalletOwnerId is the owner of platform wallets, which do not belong to a user.
+const PlatformWalletOwnerId = "PLATFORM"
+
+type LedgerEntryType string
+
+const (
+	LedgerEntryTypeSale              LedgerEntryType = "SALE"
+	LedgerEntryTypeSaleReversal      LedgerEntryType = "SALE_REVERSAL"
+	LedgerEntryTypeWithdrawalHold    LedgerEntryType = "WITHDRAWAL_HOLD"
+	LedgerEntryTypeWithdrawalRelease LedgerEntryType = "WITHDRAWAL_RELEASE"
+	LedgerEntryTypeWithdrawalPayout  LedgerEntryType = "WITHDRAWAL_PAYOUT"
+)
+
+type WithdrawalStatus string
+
+const (
+	WithdrawalStatusRequested  WithdrawalStatus = "REQUESTED"
+	WithdrawalStatusApproved   WithdrawalStatus = "APPROVED"
+	WithdrawalStatusDisbursing WithdrawalStatus = "DISBURSING"
+	WithdrawalStatusPaid       WithdrawalStatus = "PAID"
+	WithdrawalStatusRejected   WithdrawalStatus = "REJECTED"
+)
+
+// withdrawalTransitions lists every status a withdrawal may move to from a given status. Once
+// approved the payout may already be on its way, so only PAID can follow. PAID and REJECTED are final.
+var withdrawalTransitions = map[WithdrawalStatus][]WithdrawalStatus{
+	WithdrawalStatusRequested:  {WithdrawalStatusApproved, WithdrawalStatusRejected},
+	WithdrawalStatusApproved:   {WithdrawalStatusDisbursing},
+	WithdrawalStatusDisbursing: {WithdrawalStatusPaid},
+}
+
+func (s WithdrawalStatus) CanTransitionTo(next WithdrawalStatus) bool {
+	for _, status := range withdrawalTransitions[s] {
+		if status == next {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,149 @@
+package document
+
+import (
+	"be-yourmoments/transaction-svc/internal/enum"
+	"be-yourmoments/transaction-svc/internal/model"
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+)
+
+const receiptTimeLayout = "02 Jan 2006 15:04 MST"
+
+// ReceiptPdf renders a receipt as a single A4 page, long orders continue on the next pages.
+func ReceiptPdf(receipt *model.ReceiptResponse) ([]byte, error) {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetTitle("Receipt "+receipt.TransactionId, true)
+	pdf.SetMargins(15, 15, 15)
+	pdf.AddPage()
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	pdf.SetFont("Helvetica", "B", 18)
+	pdf.CellFormat(0, 10, "YourMoments Receipt", "", 1, "L", false, 0, "")
+	if receipt.Status == enum.TransactionStatusRefunded {
+		pdf.SetTextColor(200, 0, 0)
+		pdf.CellFormat(0, 7, "REFUNDED", "", 1, "L", false, 0, "")
+		pdf.SetTextColor(0, 0, 0)
+	}
+	pdf.Ln(3)
+
+	pdf.SetFont("Helvetica", "", 10)
+	details := [][2]string{
+		{"Transaction", receipt.TransactionId},
+		{"Ordered at", receipt.CreatedAt.Format(receiptTimeLayout)},
+		{"Paid at", formatTime(receipt.PaidAt)},
+		{"Payment method", formatPaymentMethod(receipt.PaymentMethod)},
+	}
+	if receipt.RefundedAt != nil {
+		details = append(details, [2]string{"Refunded at", formatTime(receipt.RefundedAt)})
+	}
+	if receipt.PromoCode != "" {
+		details = append(details, [2]string{"Promo code", receipt.PromoCode})
+	}
+	for _, detail := range details {
+		pdf.CellFormat(40, 6, detail[0], "", 0, "L", false, 0, "")
+		pdf.CellFormat(0, 6, tr(detail[1]), "", 1, "L", false, 0, "")
+	}
+	pdf.Ln(5)
+
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.SetFillColor(235, 235, 235)
+	pdf.CellFormat(90, 8, "Photo", "B", 0, "L", true, 0, "")
+	pdf.CellFormat(30, 8, "Price", "B", 0, "R", true, 0, "")
+	pdf.CellFormat(30, 8, "Discount", "B", 0, "R", true, 0, "")
+	pdf.CellFormat(30, 8, "Amount", "B", 1, "R", true, 0, "")
+
+	pdf.SetFont("Helvetica", "", 10)
+	for _, item := range receipt.Items {
+		title := item.Title
+		if title == "" {
+			title = item.PhotoId
+		}
+		pdf.CellFormat(90, 7, tr(truncate(title, 48)), "", 0, "L", false, 0, "")
+		pdf.CellFormat(30, 7, formatRupiah(item.Price), "", 0, "R", false, 0, "")
+		pdf.CellFormat(30, 7, formatDiscount(item.DiscountAmount), "", 0, "R", false, 0, "")
+		pdf.CellFormat(30, 7, formatRupiah(item.Amount), "", 1, "R", false, 0, "")
+	}
+	pdf.CellFormat(180, 2, "", "T", 1, "", false, 0, "")
+
+	totals := [][2]string{
+		{"Subtotal", formatRupiah(receipt.Subtotal)},
+		{"Discount", formatDiscount(receipt.DiscountAmount)},
+		{"Total", formatRupiah(receipt.Total)},
+	}
+	for i, total := range totals {
+		if i == len(totals)-1 {
+			pdf.SetFont("Helvetica", "B", 11)
+		}
+		pdf.CellFormat(150, 7, total[0], "", 0, "R", false, 0, "")
+		pdf.CellFormat(30, 7, total[1], "", 1, "R", false, 0, "")
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// formatRupiah writes 15000 as "Rp 15.000".
+func formatRupiah(amount int64) string {
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	digits := strconv.FormatInt(amount, 10)
+	var grouped strings.Builder
+	for i, digit := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			grouped.WriteByte('.')
+		}
+		grouped.WriteRune(digit)
+	}
+
+	return sign + "Rp " + grouped.String()
+}
+
+func formatDiscount(amount int64) string {
+	if amount == 0 {
+		return "-"
+	}
+	return formatRupiah(-amount)
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.Format(receiptTimeLayout)
+}
+
+func formatPaymentMethod(method *model.PaymentMethodResponse) string {
+	if method == nil {
+		return "-"
+	}
+
+	parts := []string{strings.ReplaceAll(string(method.Type), "_", " ")}
+	if method.Provider != "" {
+		parts = append(parts, strings.ToUpper(method.Provider))
+	}
+	if method.Account != "" {
+		parts = append(parts, method.Account)
+	}
+
+	return strings.Join(parts, " - ")
+}
+
+func truncate(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max-3]) + "..."
+}
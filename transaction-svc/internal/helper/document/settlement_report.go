@@ -0,0 +1,132 @@
+package document
+
+import (
+	"be-yourmoments/transaction-svc/internal/enum"
+	"be-yourmoments/transaction-svc/internal/model"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// settlementTimeLayout is the format Midtrans uses for settlement_time.
+const settlementTimeLayout = "2006-01-02 15:04:05"
+
+type settlementRow struct {
+	OrderId           string      `json:"order_id"`
+	GrossAmount       json.Number `json:"gross_amount"`
+	TransactionStatus string      `json:"transaction_status"`
+	PaymentType       string      `json:"payment_type"`
+	SettlementTime    string      `json:"settlement_time"`
+}
+
+// ParseSettlementReport reads a gateway settlement export. JSON reports are an array of
+// transaction objects, CSV reports need a header row naming at least order_id, gross_amount
+// and transaction_status, other columns are ignored.
+func ParseSettlementReport(r io.Reader, format string) ([]*model.SettlementRecord, error) {
+	var rows []*settlementRow
+	switch strings.ToLower(format) {
+	case "json":
+		if err := json.NewDecoder(r).Decode(&rows); err != nil {
+			return nil, fmt.Errorf("invalid json settlement report: %w", err)
+		}
+	case "csv":
+		var err error
+		if rows, err = readSettlementCsv(r); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported settlement report format %q", format)
+	}
+
+	records := make([]*model.SettlementRecord, 0, len(rows))
+	for i, row := range rows {
+		record, err := row.toRecord()
+		if err != nil {
+			return nil, fmt.Errorf("settlement report row %d: %w", i+1, err)
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func readSettlementCsv(r io.Reader) ([]*settlementRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("invalid csv settlement report: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"order_id", "gross_amount", "transaction_status"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("csv settlement report has no %s column", name)
+		}
+	}
+
+	column := func(line []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(line) {
+			return ""
+		}
+		return strings.TrimSpace(line[i])
+	}
+
+	var rows []*settlementRow
+	for {
+		line, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid csv settlement report: %w", err)
+		}
+
+		rows = append(rows, &settlementRow{
+			OrderId:           column(line, "order_id"),
+			GrossAmount:       json.Number(column(line, "gross_amount")),
+			TransactionStatus: column(line, "transaction_status"),
+			PaymentType:       column(line, "payment_type"),
+			SettlementTime:    column(line, "settlement_time"),
+		})
+	}
+
+	return rows, nil
+}
+
+func (row *settlementRow) toRecord() (*model.SettlementRecord, error) {
+	if row.OrderId == "" {
+		return nil, fmt.Errorf("order_id is empty")
+	}
+
+	grossAmount, err := strconv.ParseFloat(row.GrossAmount.String(), 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gross_amount %q", row.GrossAmount)
+	}
+
+	record := &model.SettlementRecord{
+		OrderId:     row.OrderId,
+		GrossAmount: int64(grossAmount),
+		Status:      enum.MidtransPaymentStatus(strings.ToLower(row.TransactionStatus)),
+		PaymentType: enum.PaymentType(row.PaymentType),
+	}
+
+	if row.SettlementTime != "" {
+		settledAt, err := time.ParseInLocation(settlementTimeLayout, row.SettlementTime, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("invalid settlement_time %q", row.SettlementTime)
+		}
+		record.SettledAt = &settledAt
+	}
+
+	return record, nil
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./adapter/disbursement_adapter.go
+
+// Package mockadapter is a generated GoMock package.
+package mockadapter
+
+import (
+	entity "be-yourmoments/transaction-svc/internal/entity"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDisbursementAdapter is a mock of DisbursementAdapter interface.
+type MockDisbursementAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockDisbursementAdapterMockRecorder
+}
+
+// MockDisbursementAdapterMockRecorder is the mock recorder for MockDisbursementAdapter.
+type MockDisbursementAdapterMockRecorder struct {
+	mock *MockDisbursementAdapter
+}
+
+// NewMockDisbursementAdapter creates a new mock instance.
+func NewMockDisbursementAdapter(ctrl *gomock.Controller) *MockDisbursementAdapter {
+	mock := &MockDisbursementAdapter{ctrl: ctrl}
+	mock.recorder = &MockDisbursementAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDisbursementAdapter) EXPECT() *MockDisbursementAdapterMockRecorder {
+	return m.recorder
+}
+
+// Disburse mocks base method.
+func (m *MockDisbursementAdapter) Disburse(ctx context.Context, idempotencyKey string, withdrawal *entity.Withdrawal) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Disburse", ctx, idempotencyKey, withdrawal)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Disburse indicates an expected call of Disburse.
+func (mr *MockDisbursementAdapterMockRecorder) Disburse(ctx, idempotencyKey, withdrawal interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disburse", reflect.TypeOf((*MockDisbursementAdapter)(nil).Disburse), ctx, idempotencyKey, withdrawal)
+}
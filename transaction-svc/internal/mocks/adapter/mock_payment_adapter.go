@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./adapter/payment_adapter.go
+
+// Package mockadapter is a generated GoMock package.
+package mockadapter
+
+import (
+	entity "be-yourmoments/transaction-svc/internal/entity"
+	model "be-yourmoments/transaction-svc/internal/model"
+	context "context"
+	json "encoding/json"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPaymentAdapter is a mock of PaymentAdapter interface.
+type MockPaymentAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentAdapterMockRecorder
+}
+
+// MockPaymentAdapterMockRecorder is the mock recorder for MockPaymentAdapter.
+type MockPaymentAdapterMockRecorder struct {
+	mock *MockPaymentAdapter
+}
+
+// NewMockPaymentAdapter creates a new mock instance.
+func NewMockPaymentAdapter(ctrl *gomock.Controller) *MockPaymentAdapter {
+	mock := &MockPaymentAdapter{ctrl: ctrl}
+	mock.recorder = &MockPaymentAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentAdapter) EXPECT() *MockPaymentAdapterMockRecorder {
+	return m.recorder
+}
+
+// CreatePayment mocks base method.
+func (m *MockPaymentAdapter) CreatePayment(ctx context.Context, transaction *entity.Transaction) (*model.PaymentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePayment", ctx, transaction)
+	ret0, _ := ret[0].(*model.PaymentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePayment indicates an expected call of CreatePayment.
+func (mr *MockPaymentAdapterMockRecorder) CreatePayment(ctx, transaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayment", reflect.TypeOf((*MockPaymentAdapter)(nil).CreatePayment), ctx, transaction)
+}
+
+// Refund mocks base method.
+func (m *MockPaymentAdapter) Refund(ctx context.Context, transaction *entity.Transaction, reason string) (json.RawMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refund", ctx, transaction, reason)
+	ret0, _ := ret[0].(json.RawMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refund indicates an expected call of Refund.
+func (mr *MockPaymentAdapterMockRecorder) Refund(ctx, transaction, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refund", reflect.TypeOf((*MockPaymentAdapter)(nil).Refund), ctx, transaction, reason)
+}
+
+// VerifySignature mocks base method.
+func (m *MockPaymentAdapter) VerifySignature(notification *model.PaymentNotification) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifySignature", notification)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// VerifySignature indicates an expected call of VerifySignature.
+func (mr *MockPaymentAdapterMockRecorder) VerifySignature(notification interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifySignature", reflect.TypeOf((*MockPaymentAdapter)(nil).VerifySignature), notification)
+}
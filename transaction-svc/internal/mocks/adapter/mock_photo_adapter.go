@@ -0,0 +1,122 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./adapter/photo_adapter.go
+
+// Package mockadapter is a generated GoMock package.
+package mockadapter
+
+import (
+	model "be-yourmoments/transaction-svc/internal/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPhotoAdapter is a mock of PhotoAdapter interface.
+type MockPhotoAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockPhotoAdapterMockRecorder
+}
+
+// MockPhotoAdapterMockRecorder is the mock recorder for MockPhotoAdapter.
+type MockPhotoAdapterMockRecorder struct {
+	mock *MockPhotoAdapter
+}
+
+// NewMockPhotoAdapter creates a new mock instance.
+func NewMockPhotoAdapter(ctrl *gomock.Controller) *MockPhotoAdapter {
+	mock := &MockPhotoAdapter{ctrl: ctrl}
+	mock.recorder = &MockPhotoAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPhotoAdapter) EXPECT() *MockPhotoAdapterMockRecorder {
+	return m.recorder
+}
+
+// CancelPhotoReservations mocks base method.
+func (m *MockPhotoAdapter) CancelPhotoReservations(ctx context.Context, photoIds []string, userId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelPhotoReservations", ctx, photoIds, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelPhotoReservations indicates an expected call of CancelPhotoReservations.
+func (mr *MockPhotoAdapterMockRecorder) CancelPhotoReservations(ctx, photoIds, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPhotoReservations", reflect.TypeOf((*MockPhotoAdapter)(nil).CancelPhotoReservations), ctx, photoIds, userId)
+}
+
+// ClearPhotosOwner mocks base method.
+func (m *MockPhotoAdapter) ClearPhotosOwner(ctx context.Context, photoIds []string, userId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearPhotosOwner", ctx, photoIds, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearPhotosOwner indicates an expected call of ClearPhotosOwner.
+func (mr *MockPhotoAdapterMockRecorder) ClearPhotosOwner(ctx, photoIds, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearPhotosOwner", reflect.TypeOf((*MockPhotoAdapter)(nil).ClearPhotosOwner), ctx, photoIds, userId)
+}
+
+// GetCart mocks base method.
+func (m *MockPhotoAdapter) GetCart(ctx context.Context, userId string) ([]*model.PhotoPrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCart", ctx, userId)
+	ret0, _ := ret[0].([]*model.PhotoPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCart indicates an expected call of GetCart.
+func (mr *MockPhotoAdapterMockRecorder) GetCart(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCart", reflect.TypeOf((*MockPhotoAdapter)(nil).GetCart), ctx, userId)
+}
+
+// GetPhotoPrice mocks base method.
+func (m *MockPhotoAdapter) GetPhotoPrice(ctx context.Context, photoId string) (*model.PhotoPrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPhotoPrice", ctx, photoId)
+	ret0, _ := ret[0].(*model.PhotoPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPhotoPrice indicates an expected call of GetPhotoPrice.
+func (mr *MockPhotoAdapterMockRecorder) GetPhotoPrice(ctx, photoId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPhotoPrice", reflect.TypeOf((*MockPhotoAdapter)(nil).GetPhotoPrice), ctx, photoId)
+}
+
+// ReservePhotos mocks base method.
+func (m *MockPhotoAdapter) ReservePhotos(ctx context.Context, photoIds []string, userId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReservePhotos", ctx, photoIds, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReservePhotos indicates an expected call of ReservePhotos.
+func (mr *MockPhotoAdapterMockRecorder) ReservePhotos(ctx, photoIds, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReservePhotos", reflect.TypeOf((*MockPhotoAdapter)(nil).ReservePhotos), ctx, photoIds, userId)
+}
+
+// UpdatePhotosOwner mocks base method.
+func (m *MockPhotoAdapter) UpdatePhotosOwner(ctx context.Context, photoIds []string, userId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePhotosOwner", ctx, photoIds, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePhotosOwner indicates an expected call of UpdatePhotosOwner.
+func (mr *MockPhotoAdapterMockRecorder) UpdatePhotosOwner(ctx, photoIds, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePhotosOwner", reflect.TypeOf((*MockPhotoAdapter)(nil).UpdatePhotosOwner), ctx, photoIds, userId)
+}
@@ -0,0 +1,67 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./adapter/upload_adapter.go
+
+// Package mockadapter is a generated GoMock package.
+package mockadapter
+
+import (
+	model "be-yourmoments/transaction-svc/internal/model"
+	context "context"
+	multipart "mime/multipart"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockMinio is a mock of Minio interface.
+type MockMinio struct {
+	ctrl     *gomock.Controller
+	recorder *MockMinioMockRecorder
+}
+
+// MockMinioMockRecorder is the mock recorder for MockMinio.
+type MockMinioMockRecorder struct {
+	mock *MockMinio
+}
+
+// NewMockMinio creates a new mock instance.
+func NewMockMinio(ctrl *gomock.Controller) *MockMinio {
+	mock := &MockMinio{ctrl: ctrl}
+	mock.recorder = &MockMinioMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMinio) EXPECT() *MockMinioMockRecorder {
+	return m.recorder
+}
+
+// DeleteFile mocks base method.
+func (m *MockMinio) DeleteFile(ctx context.Context, fileName string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFile", ctx, fileName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFile indicates an expected call of DeleteFile.
+func (mr *MockMinioMockRecorder) DeleteFile(ctx, fileName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFile", reflect.TypeOf((*MockMinio)(nil).DeleteFile), ctx, fileName)
+}
+
+// UploadFile mocks base method.
+func (m *MockMinio) UploadFile(ctx context.Context, file *multipart.FileHeader, path string) (*model.MinioFileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadFile", ctx, file, path)
+	ret0, _ := ret[0].(*model.MinioFileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadFile indicates an expected call of UploadFile.
+func (mr *MockMinioMockRecorder) UploadFile(ctx, file, path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadFile", reflect.TypeOf((*MockMinio)(nil).UploadFile), ctx, file, path)
+}
@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/commission_rule_repository.go
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	entity "be-yourmoments/transaction-svc/internal/entity"
+	repository "be-yourmoments/transaction-svc/internal/repository"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCommissionRuleRepository is a mock of CommissionRuleRepository interface.
+type MockCommissionRuleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommissionRuleRepositoryMockRecorder
+}
+
+// MockCommissionRuleRepositoryMockRecorder is the mock recorder for MockCommissionRuleRepository.
+type MockCommissionRuleRepositoryMockRecorder struct {
+	mock *MockCommissionRuleRepository
+}
+
+// NewMockCommissionRuleRepository creates a new mock instance.
+func NewMockCommissionRuleRepository(ctrl *gomock.Controller) *MockCommissionRuleRepository {
+	mock := &MockCommissionRuleRepository{ctrl: ctrl}
+	mock.recorder = &MockCommissionRuleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommissionRuleRepository) EXPECT() *MockCommissionRuleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteByCreatorId mocks base method.
+func (m *MockCommissionRuleRepository) DeleteByCreatorId(ctx context.Context, db repository.Querier, creatorId string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByCreatorId", ctx, db, creatorId)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteByCreatorId indicates an expected call of DeleteByCreatorId.
+func (mr *MockCommissionRuleRepositoryMockRecorder) DeleteByCreatorId(ctx, db, creatorId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByCreatorId", reflect.TypeOf((*MockCommissionRuleRepository)(nil).DeleteByCreatorId), ctx, db, creatorId)
+}
+
+// FindAll mocks base method.
+func (m *MockCommissionRuleRepository) FindAll(ctx context.Context, db repository.Querier) ([]*entity.CommissionRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, db)
+	ret0, _ := ret[0].([]*entity.CommissionRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockCommissionRuleRepositoryMockRecorder) FindAll(ctx, db interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockCommissionRuleRepository)(nil).FindAll), ctx, db)
+}
+
+// FindForCreator mocks base method.
+func (m *MockCommissionRuleRepository) FindForCreator(ctx context.Context, db repository.Querier, creatorId string) (*entity.CommissionRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindForCreator", ctx, db, creatorId)
+	ret0, _ := ret[0].(*entity.CommissionRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindForCreator indicates an expected call of FindForCreator.
+func (mr *MockCommissionRuleRepositoryMockRecorder) FindForCreator(ctx, db, creatorId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindForCreator", reflect.TypeOf((*MockCommissionRuleRepository)(nil).FindForCreator), ctx, db, creatorId)
+}
+
+// Upsert mocks base method.
+func (m *MockCommissionRuleRepository) Upsert(ctx context.Context, db repository.Querier, rule *entity.CommissionRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, db, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockCommissionRuleRepositoryMockRecorder) Upsert(ctx, db, rule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockCommissionRuleRepository)(nil).Upsert), ctx, db, rule)
+}
@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/idempotency_key_repository.go
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	entity "be-yourmoments/transaction-svc/internal/entity"
+	repository "be-yourmoments/transaction-svc/internal/repository"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIdempotencyKeyRepository is a mock of IdempotencyKeyRepository interface.
+type MockIdempotencyKeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyKeyRepositoryMockRecorder
+}
+
+// MockIdempotencyKeyRepositoryMockRecorder is the mock recorder for MockIdempotencyKeyRepository.
+type MockIdempotencyKeyRepositoryMockRecorder struct {
+	mock *MockIdempotencyKeyRepository
+}
+
+// NewMockIdempotencyKeyRepository creates a new mock instance.
+func NewMockIdempotencyKeyRepository(ctrl *gomock.Controller) *MockIdempotencyKeyRepository {
+	mock := &MockIdempotencyKeyRepository{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyKeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotencyKeyRepository) EXPECT() *MockIdempotencyKeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Complete mocks base method.
+func (m *MockIdempotencyKeyRepository) Complete(ctx context.Context, db repository.Querier, key *entity.IdempotencyKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, db, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockIdempotencyKeyRepositoryMockRecorder) Complete(ctx, db, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockIdempotencyKeyRepository)(nil).Complete), ctx, db, key)
+}
+
+// Delete mocks base method.
+func (m *MockIdempotencyKeyRepository) Delete(ctx context.Context, db repository.Querier, userId, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, db, userId, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIdempotencyKeyRepositoryMockRecorder) Delete(ctx, db, userId, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIdempotencyKeyRepository)(nil).Delete), ctx, db, userId, key)
+}
+
+// FindByKey mocks base method.
+func (m *MockIdempotencyKeyRepository) FindByKey(ctx context.Context, db repository.Querier, userId, key string) (*entity.IdempotencyKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByKey", ctx, db, userId, key)
+	ret0, _ := ret[0].(*entity.IdempotencyKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByKey indicates an expected call of FindByKey.
+func (mr *MockIdempotencyKeyRepositoryMockRecorder) FindByKey(ctx, db, userId, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByKey", reflect.TypeOf((*MockIdempotencyKeyRepository)(nil).FindByKey), ctx, db, userId, key)
+}
+
+// Reserve mocks base method.
+func (m *MockIdempotencyKeyRepository) Reserve(ctx context.Context, db repository.Querier, key *entity.IdempotencyKey, ttl time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reserve", ctx, db, key, ttl)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reserve indicates an expected call of Reserve.
+func (mr *MockIdempotencyKeyRepositoryMockRecorder) Reserve(ctx, db, key, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reserve", reflect.TypeOf((*MockIdempotencyKeyRepository)(nil).Reserve), ctx, db, key, ttl)
+}
@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/ledger_repository.go
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	entity "be-yourmoments/transaction-svc/internal/entity"
+	repository "be-yourmoments/transaction-svc/internal/repository"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLedgerRepository is a mock of LedgerRepository interface.
+type MockLedgerRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLedgerRepositoryMockRecorder
+}
+
+// MockLedgerRepositoryMockRecorder is the mock recorder for MockLedgerRepository.
+type MockLedgerRepositoryMockRecorder struct {
+	mock *MockLedgerRepository
+}
+
+// NewMockLedgerRepository creates a new mock instance.
+func NewMockLedgerRepository(ctrl *gomock.Controller) *MockLedgerRepository {
+	mock := &MockLedgerRepository{ctrl: ctrl}
+	mock.recorder = &MockLedgerRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLedgerRepository) EXPECT() *MockLedgerRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountByWallet mocks base method.
+func (m *MockLedgerRepository) CountByWallet(ctx context.Context, db repository.Querier, walletId string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByWallet", ctx, db, walletId)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByWallet indicates an expected call of CountByWallet.
+func (mr *MockLedgerRepositoryMockRecorder) CountByWallet(ctx, db, walletId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByWallet", reflect.TypeOf((*MockLedgerRepository)(nil).CountByWallet), ctx, db, walletId)
+}
+
+// CreateEntries mocks base method.
+func (m *MockLedgerRepository) CreateEntries(ctx context.Context, db repository.Querier, entries []*entity.LedgerEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEntries", ctx, db, entries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEntries indicates an expected call of CreateEntries.
+func (mr *MockLedgerRepositoryMockRecorder) CreateEntries(ctx, db, entries interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntries", reflect.TypeOf((*MockLedgerRepository)(nil).CreateEntries), ctx, db, entries)
+}
+
+// FindByWallet mocks base method.
+func (m *MockLedgerRepository) FindByWallet(ctx context.Context, db repository.Querier, walletId string, limit, offset int) ([]*entity.LedgerEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByWallet", ctx, db, walletId, limit, offset)
+	ret0, _ := ret[0].([]*entity.LedgerEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByWallet indicates an expected call of FindByWallet.
+func (mr *MockLedgerRepositoryMockRecorder) FindByWallet(ctx, db, walletId, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByWallet", reflect.TypeOf((*MockLedgerRepository)(nil).FindByWallet), ctx, db, walletId, limit, offset)
+}
+
+// SumByWallet mocks base method.
+func (m *MockLedgerRepository) SumByWallet(ctx context.Context, db repository.Querier, walletId string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumByWallet", ctx, db, walletId)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumByWallet indicates an expected call of SumByWallet.
+func (mr *MockLedgerRepositoryMockRecorder) SumByWallet(ctx, db, walletId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumByWallet", reflect.TypeOf((*MockLedgerRepository)(nil).SumByWallet), ctx, db, walletId)
+}
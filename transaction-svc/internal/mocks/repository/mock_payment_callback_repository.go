@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/payment_callback_repository.go
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	entity "be-yourmoments/transaction-svc/internal/entity"
+	repository "be-yourmoments/transaction-svc/internal/repository"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPaymentCallbackRepository is a mock of PaymentCallbackRepository interface.
+type MockPaymentCallbackRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentCallbackRepositoryMockRecorder
+}
+
+// MockPaymentCallbackRepositoryMockRecorder is the mock recorder for MockPaymentCallbackRepository.
+type MockPaymentCallbackRepositoryMockRecorder struct {
+	mock *MockPaymentCallbackRepository
+}
+
+// NewMockPaymentCallbackRepository creates a new mock instance.
+func NewMockPaymentCallbackRepository(ctrl *gomock.Controller) *MockPaymentCallbackRepository {
+	mock := &MockPaymentCallbackRepository{ctrl: ctrl}
+	mock.recorder = &MockPaymentCallbackRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentCallbackRepository) EXPECT() *MockPaymentCallbackRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPaymentCallbackRepository) Create(ctx context.Context, db repository.Querier, callback *entity.PaymentCallback) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, db, callback)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPaymentCallbackRepositoryMockRecorder) Create(ctx, db, callback interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPaymentCallbackRepository)(nil).Create), ctx, db, callback)
+}
@@ -0,0 +1,153 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/promo_code_repository.go
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	entity "be-yourmoments/transaction-svc/internal/entity"
+	repository "be-yourmoments/transaction-svc/internal/repository"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPromoCodeRepository is a mock of PromoCodeRepository interface.
+type MockPromoCodeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPromoCodeRepositoryMockRecorder
+}
+
+// MockPromoCodeRepositoryMockRecorder is the mock recorder for MockPromoCodeRepository.
+type MockPromoCodeRepositoryMockRecorder struct {
+	mock *MockPromoCodeRepository
+}
+
+// NewMockPromoCodeRepository creates a new mock instance.
+func NewMockPromoCodeRepository(ctrl *gomock.Controller) *MockPromoCodeRepository {
+	mock := &MockPromoCodeRepository{ctrl: ctrl}
+	mock.recorder = &MockPromoCodeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPromoCodeRepository) EXPECT() *MockPromoCodeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountUserRedemptions mocks base method.
+func (m *MockPromoCodeRepository) CountUserRedemptions(ctx context.Context, db repository.Querier, promoCodeId, userId string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUserRedemptions", ctx, db, promoCodeId, userId)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUserRedemptions indicates an expected call of CountUserRedemptions.
+func (mr *MockPromoCodeRepositoryMockRecorder) CountUserRedemptions(ctx, db, promoCodeId, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUserRedemptions", reflect.TypeOf((*MockPromoCodeRepository)(nil).CountUserRedemptions), ctx, db, promoCodeId, userId)
+}
+
+// Create mocks base method.
+func (m *MockPromoCodeRepository) Create(ctx context.Context, db repository.Querier, promoCode *entity.PromoCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, db, promoCode)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPromoCodeRepositoryMockRecorder) Create(ctx, db, promoCode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPromoCodeRepository)(nil).Create), ctx, db, promoCode)
+}
+
+// CreateRedemption mocks base method.
+func (m *MockPromoCodeRepository) CreateRedemption(ctx context.Context, db repository.Querier, redemption *entity.PromoRedemption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRedemption", ctx, db, redemption)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRedemption indicates an expected call of CreateRedemption.
+func (mr *MockPromoCodeRepositoryMockRecorder) CreateRedemption(ctx, db, redemption interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRedemption", reflect.TypeOf((*MockPromoCodeRepository)(nil).CreateRedemption), ctx, db, redemption)
+}
+
+// FindAll mocks base method.
+func (m *MockPromoCodeRepository) FindAll(ctx context.Context, db repository.Querier) ([]*entity.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, db)
+	ret0, _ := ret[0].([]*entity.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockPromoCodeRepositoryMockRecorder) FindAll(ctx, db interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockPromoCodeRepository)(nil).FindAll), ctx, db)
+}
+
+// FindByCodeForUpdate mocks base method.
+func (m *MockPromoCodeRepository) FindByCodeForUpdate(ctx context.Context, db repository.Querier, code string) (*entity.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCodeForUpdate", ctx, db, code)
+	ret0, _ := ret[0].(*entity.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCodeForUpdate indicates an expected call of FindByCodeForUpdate.
+func (mr *MockPromoCodeRepositoryMockRecorder) FindByCodeForUpdate(ctx, db, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCodeForUpdate", reflect.TypeOf((*MockPromoCodeRepository)(nil).FindByCodeForUpdate), ctx, db, code)
+}
+
+// FindById mocks base method.
+func (m *MockPromoCodeRepository) FindById(ctx context.Context, db repository.Querier, id string) (*entity.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, db, id)
+	ret0, _ := ret[0].(*entity.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockPromoCodeRepositoryMockRecorder) FindById(ctx, db, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockPromoCodeRepository)(nil).FindById), ctx, db, id)
+}
+
+// Update mocks base method.
+func (m *MockPromoCodeRepository) Update(ctx context.Context, db repository.Querier, promoCode *entity.PromoCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, db, promoCode)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPromoCodeRepositoryMockRecorder) Update(ctx, db, promoCode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPromoCodeRepository)(nil).Update), ctx, db, promoCode)
+}
+
+// VoidRedemption mocks base method.
+func (m *MockPromoCodeRepository) VoidRedemption(ctx context.Context, db repository.Querier, transactionId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoidRedemption", ctx, db, transactionId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VoidRedemption indicates an expected call of VoidRedemption.
+func (mr *MockPromoCodeRepositoryMockRecorder) VoidRedemption(ctx, db, transactionId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoidRedemption", reflect.TypeOf((*MockPromoCodeRepository)(nil).VoidRedemption), ctx, db, transactionId)
+}
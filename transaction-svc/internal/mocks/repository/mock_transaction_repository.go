@@ -0,0 +1,229 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/transaction_repository.go
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	entity "be-yourmoments/transaction-svc/internal/entity"
+	enum "be-yourmoments/transaction-svc/internal/enum"
+	repository "be-yourmoments/transaction-svc/internal/repository"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTransactionRepository is a mock of TransactionRepository interface.
+type MockTransactionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionRepositoryMockRecorder
+}
+
+// MockTransactionRepositoryMockRecorder is the mock recorder for MockTransactionRepository.
+type MockTransactionRepositoryMockRecorder struct {
+	mock *MockTransactionRepository
+}
+
+// NewMockTransactionRepository creates a new mock instance.
+func NewMockTransactionRepository(ctrl *gomock.Controller) *MockTransactionRepository {
+	mock := &MockTransactionRepository{ctrl: ctrl}
+	mock.recorder = &MockTransactionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionRepository) EXPECT() *MockTransactionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimRefund mocks base method.
+func (m *MockTransactionRepository) ClaimRefund(ctx context.Context, db repository.Querier, id string, claimedAt, staleBefore time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimRefund", ctx, db, id, claimedAt, staleBefore)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimRefund indicates an expected call of ClaimRefund.
+func (mr *MockTransactionRepositoryMockRecorder) ClaimRefund(ctx, db, id, claimedAt, staleBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimRefund", reflect.TypeOf((*MockTransactionRepository)(nil).ClaimRefund), ctx, db, id, claimedAt, staleBefore)
+}
+
+// CountByFilter mocks base method.
+func (m *MockTransactionRepository) CountByFilter(ctx context.Context, db repository.Querier, filter *repository.TransactionFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByFilter", ctx, db, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByFilter indicates an expected call of CountByFilter.
+func (mr *MockTransactionRepositoryMockRecorder) CountByFilter(ctx, db, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByFilter", reflect.TypeOf((*MockTransactionRepository)(nil).CountByFilter), ctx, db, filter)
+}
+
+// Create mocks base method.
+func (m *MockTransactionRepository) Create(ctx context.Context, db repository.Querier, transaction *entity.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, db, transaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTransactionRepositoryMockRecorder) Create(ctx, db, transaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTransactionRepository)(nil).Create), ctx, db, transaction)
+}
+
+// FindByFilter mocks base method.
+func (m *MockTransactionRepository) FindByFilter(ctx context.Context, db repository.Querier, filter *repository.TransactionFilter, limit, offset int) ([]*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByFilter", ctx, db, filter, limit, offset)
+	ret0, _ := ret[0].([]*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByFilter indicates an expected call of FindByFilter.
+func (mr *MockTransactionRepositoryMockRecorder) FindByFilter(ctx, db, filter, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByFilter", reflect.TypeOf((*MockTransactionRepository)(nil).FindByFilter), ctx, db, filter, limit, offset)
+}
+
+// FindById mocks base method.
+func (m *MockTransactionRepository) FindById(ctx context.Context, db repository.Querier, id string) (*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, db, id)
+	ret0, _ := ret[0].(*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockTransactionRepositoryMockRecorder) FindById(ctx, db, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockTransactionRepository)(nil).FindById), ctx, db, id)
+}
+
+// FindByIdForUpdate mocks base method.
+func (m *MockTransactionRepository) FindByIdForUpdate(ctx context.Context, db repository.Querier, id string) (*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIdForUpdate", ctx, db, id)
+	ret0, _ := ret[0].(*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIdForUpdate indicates an expected call of FindByIdForUpdate.
+func (mr *MockTransactionRepositoryMockRecorder) FindByIdForUpdate(ctx, db, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIdForUpdate", reflect.TypeOf((*MockTransactionRepository)(nil).FindByIdForUpdate), ctx, db, id)
+}
+
+// FindPaidBetween mocks base method.
+func (m *MockTransactionRepository) FindPaidBetween(ctx context.Context, db repository.Querier, from, to time.Time) ([]*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPaidBetween", ctx, db, from, to)
+	ret0, _ := ret[0].([]*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPaidBetween indicates an expected call of FindPaidBetween.
+func (mr *MockTransactionRepositoryMockRecorder) FindPaidBetween(ctx, db, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPaidBetween", reflect.TypeOf((*MockTransactionRepository)(nil).FindPaidBetween), ctx, db, from, to)
+}
+
+// FindPendingBefore mocks base method.
+func (m *MockTransactionRepository) FindPendingBefore(ctx context.Context, db repository.Querier, before time.Time, limit int) ([]*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPendingBefore", ctx, db, before, limit)
+	ret0, _ := ret[0].([]*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPendingBefore indicates an expected call of FindPendingBefore.
+func (mr *MockTransactionRepositoryMockRecorder) FindPendingBefore(ctx, db, before, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPendingBefore", reflect.TypeOf((*MockTransactionRepository)(nil).FindPendingBefore), ctx, db, before, limit)
+}
+
+// FindPhotoUnsynced mocks base method.
+func (m *MockTransactionRepository) FindPhotoUnsynced(ctx context.Context, db repository.Querier, limit int) ([]*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPhotoUnsynced", ctx, db, limit)
+	ret0, _ := ret[0].([]*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPhotoUnsynced indicates an expected call of FindPhotoUnsynced.
+func (mr *MockTransactionRepositoryMockRecorder) FindPhotoUnsynced(ctx, db, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPhotoUnsynced", reflect.TypeOf((*MockTransactionRepository)(nil).FindPhotoUnsynced), ctx, db, limit)
+}
+
+// MarkPhotoSynced mocks base method.
+func (m *MockTransactionRepository) MarkPhotoSynced(ctx context.Context, db repository.Querier, id string, status enum.TransactionStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkPhotoSynced", ctx, db, id, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkPhotoSynced indicates an expected call of MarkPhotoSynced.
+func (mr *MockTransactionRepositoryMockRecorder) MarkPhotoSynced(ctx, db, id, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPhotoSynced", reflect.TypeOf((*MockTransactionRepository)(nil).MarkPhotoSynced), ctx, db, id, status)
+}
+
+// ReleaseRefundClaim mocks base method.
+func (m *MockTransactionRepository) ReleaseRefundClaim(ctx context.Context, db repository.Querier, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseRefundClaim", ctx, db, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseRefundClaim indicates an expected call of ReleaseRefundClaim.
+func (mr *MockTransactionRepositoryMockRecorder) ReleaseRefundClaim(ctx, db, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseRefundClaim", reflect.TypeOf((*MockTransactionRepository)(nil).ReleaseRefundClaim), ctx, db, id)
+}
+
+// UpdateItemCommissions mocks base method.
+func (m *MockTransactionRepository) UpdateItemCommissions(ctx context.Context, db repository.Querier, items []*entity.TransactionItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateItemCommissions", ctx, db, items)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateItemCommissions indicates an expected call of UpdateItemCommissions.
+func (mr *MockTransactionRepositoryMockRecorder) UpdateItemCommissions(ctx, db, items interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItemCommissions", reflect.TypeOf((*MockTransactionRepository)(nil).UpdateItemCommissions), ctx, db, items)
+}
+
+// UpdatePayment mocks base method.
+func (m *MockTransactionRepository) UpdatePayment(ctx context.Context, db repository.Querier, transaction *entity.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePayment", ctx, db, transaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePayment indicates an expected call of UpdatePayment.
+func (mr *MockTransactionRepositoryMockRecorder) UpdatePayment(ctx, db, transaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePayment", reflect.TypeOf((*MockTransactionRepository)(nil).UpdatePayment), ctx, db, transaction)
+}
@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/wallet_repository.go
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	entity "be-yourmoments/transaction-svc/internal/entity"
+	enum "be-yourmoments/transaction-svc/internal/enum"
+	repository "be-yourmoments/transaction-svc/internal/repository"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockWalletRepository is a mock of WalletRepository interface.
+type MockWalletRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWalletRepositoryMockRecorder
+}
+
+// MockWalletRepositoryMockRecorder is the mock recorder for MockWalletRepository.
+type MockWalletRepositoryMockRecorder struct {
+	mock *MockWalletRepository
+}
+
+// NewMockWalletRepository creates a new mock instance.
+func NewMockWalletRepository(ctrl *gomock.Controller) *MockWalletRepository {
+	mock := &MockWalletRepository{ctrl: ctrl}
+	mock.recorder = &MockWalletRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWalletRepository) EXPECT() *MockWalletRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByOwner mocks base method.
+func (m *MockWalletRepository) FindByOwner(ctx context.Context, db repository.Querier, ownerId string, walletType enum.WalletType) (*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByOwner", ctx, db, ownerId, walletType)
+	ret0, _ := ret[0].(*entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByOwner indicates an expected call of FindByOwner.
+func (mr *MockWalletRepositoryMockRecorder) FindByOwner(ctx, db, ownerId, walletType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByOwner", reflect.TypeOf((*MockWalletRepository)(nil).FindByOwner), ctx, db, ownerId, walletType)
+}
+
+// FindOrCreate mocks base method.
+func (m *MockWalletRepository) FindOrCreate(ctx context.Context, db repository.Querier, ownerId string, walletType enum.WalletType) (*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOrCreate", ctx, db, ownerId, walletType)
+	ret0, _ := ret[0].(*entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOrCreate indicates an expected call of FindOrCreate.
+func (mr *MockWalletRepositoryMockRecorder) FindOrCreate(ctx, db, ownerId, walletType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrCreate", reflect.TypeOf((*MockWalletRepository)(nil).FindOrCreate), ctx, db, ownerId, walletType)
+}
+
+// LockByIds mocks base method.
+func (m *MockWalletRepository) LockByIds(ctx context.Context, db repository.Querier, walletIds []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockByIds", ctx, db, walletIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockByIds indicates an expected call of LockByIds.
+func (mr *MockWalletRepositoryMockRecorder) LockByIds(ctx, db, walletIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockByIds", reflect.TypeOf((*MockWalletRepository)(nil).LockByIds), ctx, db, walletIds)
+}
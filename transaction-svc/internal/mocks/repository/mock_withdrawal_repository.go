@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/withdrawal_repository.go
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	entity "be-yourmoments/transaction-svc/internal/entity"
+	enum "be-yourmoments/transaction-svc/internal/enum"
+	repository "be-yourmoments/transaction-svc/internal/repository"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockWithdrawalRepository is a mock of WithdrawalRepository interface.
+type MockWithdrawalRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWithdrawalRepositoryMockRecorder
+}
+
+// MockWithdrawalRepositoryMockRecorder is the mock recorder for MockWithdrawalRepository.
+type MockWithdrawalRepositoryMockRecorder struct {
+	mock *MockWithdrawalRepository
+}
+
+// NewMockWithdrawalRepository creates a new mock instance.
+func NewMockWithdrawalRepository(ctrl *gomock.Controller) *MockWithdrawalRepository {
+	mock := &MockWithdrawalRepository{ctrl: ctrl}
+	mock.recorder = &MockWithdrawalRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWithdrawalRepository) EXPECT() *MockWithdrawalRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWithdrawalRepository) Create(ctx context.Context, db repository.Querier, withdrawal *entity.Withdrawal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, db, withdrawal)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWithdrawalRepositoryMockRecorder) Create(ctx, db, withdrawal interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWithdrawalRepository)(nil).Create), ctx, db, withdrawal)
+}
+
+// FindByIdForUpdate mocks base method.
+func (m *MockWithdrawalRepository) FindByIdForUpdate(ctx context.Context, db repository.Querier, id string) (*entity.Withdrawal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIdForUpdate", ctx, db, id)
+	ret0, _ := ret[0].(*entity.Withdrawal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIdForUpdate indicates an expected call of FindByIdForUpdate.
+func (mr *MockWithdrawalRepositoryMockRecorder) FindByIdForUpdate(ctx, db, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIdForUpdate", reflect.TypeOf((*MockWithdrawalRepository)(nil).FindByIdForUpdate), ctx, db, id)
+}
+
+// FindByStatus mocks base method.
+func (m *MockWithdrawalRepository) FindByStatus(ctx context.Context, db repository.Querier, status enum.WithdrawalStatus) ([]*entity.Withdrawal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByStatus", ctx, db, status)
+	ret0, _ := ret[0].([]*entity.Withdrawal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByStatus indicates an expected call of FindByStatus.
+func (mr *MockWithdrawalRepositoryMockRecorder) FindByStatus(ctx, db, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByStatus", reflect.TypeOf((*MockWithdrawalRepository)(nil).FindByStatus), ctx, db, status)
+}
+
+// FindByUserId mocks base method.
+func (m *MockWithdrawalRepository) FindByUserId(ctx context.Context, db repository.Querier, userId string) ([]*entity.Withdrawal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserId", ctx, db, userId)
+	ret0, _ := ret[0].([]*entity.Withdrawal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserId indicates an expected call of FindByUserId.
+func (mr *MockWithdrawalRepositoryMockRecorder) FindByUserId(ctx, db, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserId", reflect.TypeOf((*MockWithdrawalRepository)(nil).FindByUserId), ctx, db, userId)
+}
+
+// Update mocks base method.
+func (m *MockWithdrawalRepository) Update(ctx context.Context, db repository.Querier, withdrawal *entity.Withdrawal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, db, withdrawal)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWithdrawalRepositoryMockRecorder) Update(ctx, db, withdrawal interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWithdrawalRepository)(nil).Update), ctx, db, withdrawal)
+}
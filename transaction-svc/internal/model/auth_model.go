@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// AuthResponse is the caller identity user-svc returns for a valid access token.
+type AuthResponse struct {
+	UserId    string
+	Username  string
+	Email     string
+	Token     string
+	ExpiresAt time.Time
+}
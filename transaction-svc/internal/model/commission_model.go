@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+type UpsertCommissionRuleRequest struct {
+	CreatorId     string `json:"-"`
+	PercentageBps int    `json:"percentage_bps"`
+	FlatFee       int64  `json:"flat_fee"`
+}
+
+type CommissionRuleResponse struct {
+	Id            string    `json:"id"`
+	CreatorId     string    `json:"creator_id,omitempty"`
+	PercentageBps int       `json:"percentage_bps"`
+	FlatFee       int64     `json:"flat_fee"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
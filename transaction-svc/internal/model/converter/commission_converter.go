@@ -0,0 +1,25 @@
+package converter
+
+import (
+	"be-yourmoments/transaction-svc/internal/entity"
+	"be-yourmoments/transaction-svc/internal/model"
+)
+
+func CommissionRuleToResponse(rule *entity.CommissionRule) *model.CommissionRuleResponse {
+	return &model.CommissionRuleResponse{
+		Id:            rule.Id,
+		CreatorId:     rule.CreatorId,
+		PercentageBps: rule.PercentageBps,
+		FlatFee:       rule.FlatFee,
+		CreatedAt:     rule.CreatedAt,
+		UpdatedAt:     rule.UpdatedAt,
+	}
+}
+
+func CommissionRulesToResponse(rules []*entity.CommissionRule) []*model.CommissionRuleResponse {
+	responses := make([]*model.CommissionRuleResponse, 0, len(rules))
+	for _, rule := range rules {
+		responses = append(responses, CommissionRuleToResponse(rule))
+	}
+	return responses
+}
@@ -0,0 +1,32 @@
+package converter
+
+import (
+	"be-yourmoments/transaction-svc/internal/entity"
+	"be-yourmoments/transaction-svc/internal/model"
+)
+
+func PromoCodeToResponse(promoCode *entity.PromoCode) *model.PromoCodeResponse {
+	return &model.PromoCodeResponse{
+		Id:                    promoCode.Id,
+		Code:                  promoCode.Code,
+		Type:                  promoCode.Type,
+		Value:                 promoCode.Value,
+		MaxRedemptions:        promoCode.MaxRedemptions,
+		MaxRedemptionsPerUser: promoCode.MaxRedemptionsPerUser,
+		CreatorId:             promoCode.CreatorId,
+		StartsAt:              promoCode.StartsAt,
+		ExpiresAt:             promoCode.ExpiresAt,
+		IsActive:              promoCode.IsActive,
+		RedemptionCount:       promoCode.RedemptionCount,
+		CreatedAt:             promoCode.CreatedAt,
+		UpdatedAt:             promoCode.UpdatedAt,
+	}
+}
+
+func PromoCodesToResponse(promoCodes []*entity.PromoCode) []*model.PromoCodeResponse {
+	responses := make([]*model.PromoCodeResponse, 0, len(promoCodes))
+	for _, promoCode := range promoCodes {
+		responses = append(responses, PromoCodeToResponse(promoCode))
+	}
+	return responses
+}
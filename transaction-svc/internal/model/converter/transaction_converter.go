@@ -0,0 +1,112 @@
+package converter
+
+import (
+	"be-yourmoments/transaction-svc/internal/entity"
+	"be-yourmoments/transaction-svc/internal/model"
+)
+
+func TransactionToResponse(transaction *entity.Transaction) *model.TransactionResponse {
+	items := make([]*model.TransactionItemResponse, 0, len(transaction.Items))
+	for _, item := range transaction.Items {
+		items = append(items, &model.TransactionItemResponse{
+			Id:             item.Id,
+			PhotoId:        item.PhotoId,
+			Title:          item.PhotoTitle,
+			CreatorId:      item.CreatorId,
+			Amount:         item.Amount,
+			DiscountAmount: item.DiscountAmount,
+			FeeAmount:      item.FeeAmount,
+			NetAmount:      item.NetAmount,
+		})
+	}
+
+	return &model.TransactionResponse{
+		Id:             transaction.Id,
+		UserId:         transaction.UserId,
+		Amount:         transaction.Amount,
+		DiscountAmount: transaction.DiscountAmount,
+		FeeAmount:      transaction.FeeAmount,
+		NetAmount:      transaction.NetAmount,
+		Status:         transaction.Status,
+		SnapToken:      transaction.SnapToken,
+		PaidAt:         transaction.PaidAt,
+		RefundedAt:     transaction.RefundedAt,
+		CreatedAt:      transaction.CreatedAt,
+		UpdatedAt:      transaction.UpdatedAt,
+		Items:          items,
+	}
+}
+
+// TransactionToReceipt shows every item at its listed price, the discount and what was actually paid.
+func TransactionToReceipt(transaction *entity.Transaction, promoCode string) *model.ReceiptResponse {
+	items := make([]*model.ReceiptItemResponse, 0, len(transaction.Items))
+	for _, item := range transaction.Items {
+		items = append(items, &model.ReceiptItemResponse{
+			PhotoId:        item.PhotoId,
+			Title:          item.PhotoTitle,
+			Price:          item.Amount + item.DiscountAmount,
+			DiscountAmount: item.DiscountAmount,
+			Amount:         item.Amount,
+		})
+	}
+
+	var paymentMethod *model.PaymentMethodResponse
+	if transaction.PaymentMethod.Type != "" {
+		paymentMethod = &model.PaymentMethodResponse{
+			Type:     transaction.PaymentMethod.Type,
+			Provider: transaction.PaymentMethod.Provider,
+			Account:  transaction.PaymentMethod.Account,
+		}
+	}
+
+	return &model.ReceiptResponse{
+		TransactionId:  transaction.Id,
+		UserId:         transaction.UserId,
+		Status:         transaction.Status,
+		PaymentMethod:  paymentMethod,
+		PromoCode:      promoCode,
+		Subtotal:       transaction.Amount + transaction.DiscountAmount,
+		DiscountAmount: transaction.DiscountAmount,
+		Total:          transaction.Amount,
+		Items:          items,
+		CreatedAt:      transaction.CreatedAt,
+		PaidAt:         transaction.PaidAt,
+		RefundedAt:     transaction.RefundedAt,
+	}
+}
+
+func TransactionsToResponse(transactions []*entity.Transaction) []*model.TransactionResponse {
+	responses := make([]*model.TransactionResponse, 0, len(transactions))
+	for _, transaction := range transactions {
+		responses = append(responses, TransactionToResponse(transaction))
+	}
+	return responses
+}
+
+// PaymentNotificationToPaymentMethod picks the provider and account out of the fields Midtrans
+// fills for each payment type.
+func PaymentNotificationToPaymentMethod(notification *model.PaymentNotification) entity.PaymentMethod {
+	method := entity.PaymentMethod{
+		Type:     notification.PaymentType,
+		Provider: notification.Issuer,
+	}
+
+	switch {
+	case len(notification.VaNumbers) > 0:
+		method.Provider = notification.VaNumbers[0].Bank
+		method.Account = notification.VaNumbers[0].VaNumber
+	case notification.PermataVaNumber != "":
+		method.Provider = "permata"
+		method.Account = notification.PermataVaNumber
+	case notification.BillKey != "":
+		method.Provider = "mandiri"
+		method.Account = notification.BillKey
+	case notification.MaskedCard != "":
+		method.Provider = notification.Bank
+		method.Account = notification.MaskedCard
+	case notification.Store != "":
+		method.Provider = notification.Store
+	}
+
+	return method
+}
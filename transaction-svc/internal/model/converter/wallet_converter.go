@@ -0,0 +1,50 @@
+package converter
+
+import (
+	"be-yourmoments/transaction-svc/internal/entity"
+	"be-yourmoments/transaction-svc/internal/model"
+)
+
+func LedgerEntriesToResponse(entries []*entity.LedgerEntry) []*model.LedgerEntryResponse {
+	responses := make([]*model.LedgerEntryResponse, 0, len(entries))
+	for _, entry := range entries {
+		responses = append(responses, &model.LedgerEntryResponse{
+			Id:            entry.Id,
+			JournalId:     entry.JournalId,
+			TransactionId: entry.TransactionId,
+			WithdrawalId:  entry.WithdrawalId,
+			Type:          entry.Type,
+			Amount:        entry.Amount,
+			Description:   entry.Description,
+			CreatedAt:     entry.CreatedAt,
+		})
+	}
+	return responses
+}
+
+func WithdrawalToResponse(withdrawal *entity.Withdrawal) *model.WithdrawalResponse {
+	return &model.WithdrawalResponse{
+		Id:                    withdrawal.Id,
+		UserId:                withdrawal.UserId,
+		Amount:                withdrawal.Amount,
+		Status:                withdrawal.Status,
+		BankName:              withdrawal.BankName,
+		BankAccountNumber:     withdrawal.BankAccountNumber,
+		BankAccountName:       withdrawal.BankAccountName,
+		RejectionReason:       withdrawal.RejectionReason,
+		DisbursementReference: withdrawal.DisbursementReference,
+		ApprovedAt:            withdrawal.ApprovedAt,
+		PaidAt:                withdrawal.PaidAt,
+		RejectedAt:            withdrawal.RejectedAt,
+		CreatedAt:             withdrawal.CreatedAt,
+		UpdatedAt:             withdrawal.UpdatedAt,
+	}
+}
+
+func WithdrawalsToResponse(withdrawals []*entity.Withdrawal) []*model.WithdrawalResponse {
+	responses := make([]*model.WithdrawalResponse, 0, len(withdrawals))
+	for _, withdrawal := range withdrawals {
+		responses = append(responses, WithdrawalToResponse(withdrawal))
+	}
+	return responses
+}
@@ -0,0 +1,8 @@
+package model
+
+// IdempotentResponse is the stored response replayed for a repeated Idempotency-Key.
+type IdempotentResponse struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
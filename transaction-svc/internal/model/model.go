@@ -0,0 +1,28 @@
+package model
+
+type WebResponse[T any] struct {
+	Success      bool          `json:"success"`
+	Data         T             `json:"data,omitempty"`
+	PageMetadata *PageMetadata `json:"pagination,omitempty"`
+}
+
+type PageMetadata struct {
+	Page        int   `json:"page"`
+	Size        int   `json:"size"`
+	TotalItem   int64 `json:"total_item"`
+	TotalPage   int64 `json:"total_page"`
+	HasNext     bool  `json:"has_next"`
+	HasPrevious bool  `json:"has_previous"`
+}
+
+func NewPageMetadata(page, size int, totalItem int64) *PageMetadata {
+	totalPage := (totalItem + int64(size) - 1) / int64(size)
+	return &PageMetadata{
+		Page:        page,
+		Size:        size,
+		TotalItem:   totalItem,
+		TotalPage:   totalPage,
+		HasNext:     int64(page) < totalPage,
+		HasPrevious: page > 1,
+	}
+}
@@ -12,3 +12,12 @@ type RequestClaimPhoto struct {
 	Id     string
 	UserId string
 }
+
+type PhotoPrice struct {
+	Id            string
+	CreatorId     string
+	OwnedByUserId string
+	Title         string
+	Price         int64
+	PriceStr      string
+}
@@ -0,0 +1,37 @@
+package model
+
+import (
+	"be-yourmoments/transaction-svc/internal/enum"
+	"time"
+)
+
+// PromoCodeRequest creates a promo code, on update Code is ignored since it may already be printed.
+// Value is in basis points for PERCENTAGE codes and in rupiah for FIXED codes.
+type PromoCodeRequest struct {
+	Id                    string         `json:"-"`
+	Code                  string         `json:"code"`
+	Type                  enum.PromoType `json:"type"`
+	Value                 int64          `json:"value"`
+	MaxRedemptions        int            `json:"max_redemptions"`
+	MaxRedemptionsPerUser int            `json:"max_redemptions_per_user"`
+	CreatorId             string         `json:"creator_id"`
+	StartsAt              *time.Time     `json:"starts_at"`
+	ExpiresAt             *time.Time     `json:"expires_at"`
+	IsActive              *bool          `json:"is_active"`
+}
+
+type PromoCodeResponse struct {
+	Id                    string         `json:"id"`
+	Code                  string         `json:"code"`
+	Type                  enum.PromoType `json:"type"`
+	Value                 int64          `json:"value"`
+	MaxRedemptions        int            `json:"max_redemptions"`
+	MaxRedemptionsPerUser int            `json:"max_redemptions_per_user"`
+	CreatorId             string         `json:"creator_id,omitempty"`
+	StartsAt              *time.Time     `json:"starts_at,omitempty"`
+	ExpiresAt             *time.Time     `json:"expires_at,omitempty"`
+	IsActive              bool           `json:"is_active"`
+	RedemptionCount       int            `json:"redemption_count"`
+	CreatedAt             time.Time      `json:"created_at"`
+	UpdatedAt             time.Time      `json:"updated_at"`
+}
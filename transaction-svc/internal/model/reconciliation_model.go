@@ -0,0 +1,44 @@
+package model
+
+import (
+	"be-yourmoments/transaction-svc/internal/enum"
+	"time"
+)
+
+// SettlementRecord is one row of a gateway settlement report.
+type SettlementRecord struct {
+	OrderId     string
+	GrossAmount int64
+	Status      enum.MidtransPaymentStatus
+	PaymentType enum.PaymentType
+	SettledAt   *time.Time
+}
+
+// ReconciliationRequest matches Records against the local transactions paid between From and To.
+// Pending transactions older than PendingTimeout are flagged and, with ExpirePending, expired
+// unless the report shows them paid.
+type ReconciliationRequest struct {
+	From           time.Time
+	To             time.Time
+	Records        []*SettlementRecord
+	PendingTimeout time.Duration
+	ExpirePending  bool
+}
+
+type ReconciliationResponse struct {
+	From         time.Time              `json:"from"`
+	To           time.Time              `json:"to"`
+	RecordCount  int                    `json:"record_count"`
+	MatchedCount int                    `json:"matched_count"`
+	Issues       []*ReconciliationIssue `json:"issues"`
+	ExpiredIds   []string               `json:"expired_ids"`
+}
+
+type ReconciliationIssue struct {
+	TransactionId string                       `json:"transaction_id"`
+	Type          enum.ReconciliationIssueType `json:"type"`
+	LocalStatus   enum.TransactionStatus       `json:"local_status,omitempty"`
+	GatewayStatus enum.MidtransPaymentStatus   `json:"gateway_status,omitempty"`
+	LocalAmount   int64                        `json:"local_amount"`
+	GatewayAmount int64                        `json:"gateway_amount"`
+}
@@ -0,0 +1,121 @@
+package model
+
+import (
+	"be-yourmoments/transaction-svc/internal/enum"
+	"encoding/json"
+	"time"
+)
+
+type BuyPhotoRequest struct {
+	UserId    string `json:"-"`
+	PhotoId   string `json:"photo_id"`
+	PromoCode string `json:"promo_code"`
+}
+
+type CheckoutRequest struct {
+	UserId    string `json:"-"`
+	PromoCode string `json:"promo_code"`
+}
+
+// TransactionHistoryRequest lists a buyer's transactions, From is inclusive and To exclusive.
+type TransactionHistoryRequest struct {
+	UserId string                 `json:"-"`
+	Status enum.TransactionStatus `json:"status"`
+	From   *time.Time             `json:"from"`
+	To     *time.Time             `json:"to"`
+	Page   int                    `json:"page"`
+	Size   int                    `json:"size"`
+}
+
+type RefundRequest struct {
+	TransactionId string `json:"-"`
+	Reason        string `json:"reason"`
+}
+
+type TransactionResponse struct {
+	Id             string                     `json:"id"`
+	UserId         string                     `json:"user_id"`
+	Amount         int64                      `json:"amount"`
+	DiscountAmount int64                      `json:"discount_amount"`
+	FeeAmount      int64                      `json:"fee_amount"`
+	NetAmount      int64                      `json:"net_amount"`
+	Status         enum.TransactionStatus     `json:"status"`
+	SnapToken      string                     `json:"snap_token,omitempty"`
+	PaidAt         *time.Time                 `json:"paid_at,omitempty"`
+	RefundedAt     *time.Time                 `json:"refunded_at,omitempty"`
+	CreatedAt      time.Time                  `json:"created_at"`
+	UpdatedAt      time.Time                  `json:"updated_at"`
+	Items          []*TransactionItemResponse `json:"items"`
+}
+
+type TransactionItemResponse struct {
+	Id             string `json:"id"`
+	PhotoId        string `json:"photo_id"`
+	Title          string `json:"title,omitempty"`
+	CreatorId      string `json:"creator_id"`
+	Amount         int64  `json:"amount"`
+	DiscountAmount int64  `json:"discount_amount"`
+	FeeAmount      int64  `json:"fee_amount"`
+	NetAmount      int64  `json:"net_amount"`
+}
+
+// PaymentResponse is what a payment gateway returns when a charge is created.
+// Status is already mapped to our own transaction status.
+type PaymentResponse struct {
+	Token       string
+	Status      enum.TransactionStatus
+	RawResponse json.RawMessage
+}
+
+// PaymentNotification is the callback body sent by the payment gateway (Midtrans format).
+type PaymentNotification struct {
+	OrderId           string                     `json:"order_id"`
+	StatusCode        string                     `json:"status_code"`
+	GrossAmount       string                     `json:"gross_amount"`
+	SignatureKey      string                     `json:"signature_key"`
+	TransactionStatus enum.MidtransPaymentStatus `json:"transaction_status"`
+	FraudStatus       string                     `json:"fraud_status"`
+	PaymentType       enum.PaymentType           `json:"payment_type"`
+	VaNumbers         []*VaNumber                `json:"va_numbers"`
+	PermataVaNumber   string                     `json:"permata_va_number"`
+	BillKey           string                     `json:"bill_key"`
+	MaskedCard        string                     `json:"masked_card"`
+	Bank              string                     `json:"bank"`
+	Store             string                     `json:"store"`
+	Issuer            string                     `json:"issuer"`
+	Raw               json.RawMessage            `json:"-"`
+}
+
+type VaNumber struct {
+	Bank     string `json:"bank"`
+	VaNumber string `json:"va_number"`
+}
+
+type ReceiptResponse struct {
+	TransactionId  string                 `json:"transaction_id"`
+	UserId         string                 `json:"user_id"`
+	Status         enum.TransactionStatus `json:"status"`
+	PaymentMethod  *PaymentMethodResponse `json:"payment_method,omitempty"`
+	PromoCode      string                 `json:"promo_code,omitempty"`
+	Subtotal       int64                  `json:"subtotal"`
+	DiscountAmount int64                  `json:"discount_amount"`
+	Total          int64                  `json:"total"`
+	Items          []*ReceiptItemResponse `json:"items"`
+	CreatedAt      time.Time              `json:"created_at"`
+	PaidAt         *time.Time             `json:"paid_at,omitempty"`
+	RefundedAt     *time.Time             `json:"refunded_at,omitempty"`
+}
+
+type ReceiptItemResponse struct {
+	PhotoId        string `json:"photo_id"`
+	Title          string `json:"title"`
+	Price          int64  `json:"price"`
+	DiscountAmount int64  `json:"discount_amount"`
+	Amount         int64  `json:"amount"`
+}
+
+type PaymentMethodResponse struct {
+	Type     enum.PaymentType `json:"type"`
+	Provider string           `json:"provider,omitempty"`
+	Account  string           `json:"account,omitempty"`
+}
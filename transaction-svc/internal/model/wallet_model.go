@@ -0,0 +1,59 @@
+package model
+
+import (
+	"be-yourmoments/transaction-svc/internal/enum"
+	"time"
+)
+
+type WalletHistoryRequest struct {
+	UserId string `json:"user_id"`
+	Page   int    `json:"page"`
+	Size   int    `json:"size"`
+}
+
+type WalletBalanceResponse struct {
+	UserId  string `json:"user_id"`
+	Balance int64  `json:"balance"`
+	Held    int64  `json:"held"`
+}
+
+type LedgerEntryResponse struct {
+	Id            string               `json:"id"`
+	JournalId     string               `json:"journal_id"`
+	TransactionId string               `json:"transaction_id,omitempty"`
+	WithdrawalId  string               `json:"withdrawal_id,omitempty"`
+	Type          enum.LedgerEntryType `json:"type"`
+	Amount        int64                `json:"amount"`
+	Description   string               `json:"description"`
+	CreatedAt     time.Time            `json:"created_at"`
+}
+
+type CreateWithdrawalRequest struct {
+	UserId            string `json:"-"`
+	Amount            int64  `json:"amount"`
+	BankName          string `json:"bank_name"`
+	BankAccountNumber string `json:"bank_account_number"`
+	BankAccountName   string `json:"bank_account_name"`
+}
+
+type RejectWithdrawalRequest struct {
+	Id     string `json:"-"`
+	Reason string `json:"reason"`
+}
+
+type WithdrawalResponse struct {
+	Id                    string                `json:"id"`
+	UserId                string                `json:"user_id"`
+	Amount                int64                 `json:"amount"`
+	Status                enum.WithdrawalStatus `json:"status"`
+	BankName              string                `json:"bank_name"`
+	BankAccountNumber     string                `json:"bank_account_number"`
+	BankAccountName       string                `json:"bank_account_name"`
+	RejectionReason       string                `json:"rejection_reason,omitempty"`
+	DisbursementReference string                `json:"disbursement_reference,omitempty"`
+	ApprovedAt            *time.Time            `json:"approved_at,omitempty"`
+	PaidAt                *time.Time            `json:"paid_at,omitempty"`
+	RejectedAt            *time.Time            `json:"rejected_at,omitempty"`
+	CreatedAt             time.Time             `json:"created_at"`
+	UpdatedAt             time.Time             `json:"updated_at"`
+}
@@ -19,8 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PhotoService_UpdatePhotographerPhoto_FullMethodName = "/photo.PhotoService/UpdatePhotographerPhoto"
-	PhotoService_UpdateFaceRecogPhoto_FullMethodName    = "/photo.PhotoService/UpdateFaceRecogPhoto"
+	PhotoService_UpdatePhotographerPhoto_FullMethodName  = "/photo.PhotoService/UpdatePhotographerPhoto"
+	PhotoService_UpdateFaceRecogPhoto_FullMethodName     = "/photo.PhotoService/UpdateFaceRecogPhoto"
+	PhotoService_CreatePhoto_FullMethodName              = "/photo.PhotoService/CreatePhoto"
+	PhotoService_CreateUserSimilarFacecam_FullMethodName = "/photo.PhotoService/CreateUserSimilarFacecam"
+	PhotoService_CreateFacecam_FullMethodName            = "/photo.PhotoService/CreateFacecam"
+	PhotoService_UpdatePhotoDetail_FullMethodName        = "/photo.PhotoService/UpdatePhotoDetail"
+	PhotoService_CreateUserSimilar_FullMethodName        = "/photo.PhotoService/CreateUserSimilar"
+	PhotoService_GetPhotoPrice_FullMethodName            = "/photo.PhotoService/GetPhotoPrice"
+	PhotoService_UpdatePhotosOwner_FullMethodName        = "/photo.PhotoService/UpdatePhotosOwner"
+	PhotoService_ClearPhotosOwner_FullMethodName         = "/photo.PhotoService/ClearPhotosOwner"
+	PhotoService_GetCart_FullMethodName                  = "/photo.PhotoService/GetCart"
+	PhotoService_ReservePhotos_FullMethodName            = "/photo.PhotoService/ReservePhotos"
+	PhotoService_CancelPhotoReservations_FullMethodName  = "/photo.PhotoService/CancelPhotoReservations"
+	PhotoService_GetMatchedPhotos_FullMethodName         = "/photo.PhotoService/GetMatchedPhotos"
+	PhotoService_UpdateUserSimilarity_FullMethodName     = "/photo.PhotoService/UpdateUserSimilarity"
+	PhotoService_DeletePhoto_FullMethodName              = "/photo.PhotoService/DeletePhoto"
 )
 
 // PhotoServiceClient is the client API for PhotoService service.
@@ -29,6 +43,20 @@ const (
 type PhotoServiceClient interface {
 	UpdatePhotographerPhoto(ctx context.Context, in *UpdatePhotographerPhotoRequest, opts ...grpc.CallOption) (*UpdatePhotographerPhotoResponse, error)
 	UpdateFaceRecogPhoto(ctx context.Context, in *UpdateFaceRecogPhotoRequest, opts ...grpc.CallOption) (*UpdateFaceRecogPhotoResponse, error)
+	CreatePhoto(ctx context.Context, in *CreatePhotoRequest, opts ...grpc.CallOption) (*CreatePhotoResponse, error)
+	CreateUserSimilarFacecam(ctx context.Context, in *CreateUserSimilarFacecamRequest, opts ...grpc.CallOption) (*CreateUserSimilarFacecamResponse, error)
+	CreateFacecam(ctx context.Context, in *CreateFacecamRequest, opts ...grpc.CallOption) (*CreateFacecamResponse, error)
+	UpdatePhotoDetail(ctx context.Context, in *UpdatePhotoDetailRequest, opts ...grpc.CallOption) (*UpdatePhotoDetailResponse, error)
+	CreateUserSimilar(ctx context.Context, in *CreateUserSimilarPhotoRequest, opts ...grpc.CallOption) (*CreateUserSimilarPhotoResponse, error)
+	GetPhotoPrice(ctx context.Context, in *GetPhotoPriceRequest, opts ...grpc.CallOption) (*GetPhotoPriceResponse, error)
+	UpdatePhotosOwner(ctx context.Context, in *UpdatePhotosOwnerRequest, opts ...grpc.CallOption) (*UpdatePhotosOwnerResponse, error)
+	ClearPhotosOwner(ctx context.Context, in *ClearPhotosOwnerRequest, opts ...grpc.CallOption) (*ClearPhotosOwnerResponse, error)
+	GetCart(ctx context.Context, in *GetCartRequest, opts ...grpc.CallOption) (*GetCartResponse, error)
+	ReservePhotos(ctx context.Context, in *ReservePhotosRequest, opts ...grpc.CallOption) (*ReservePhotosResponse, error)
+	CancelPhotoReservations(ctx context.Context, in *CancelPhotoReservationsRequest, opts ...grpc.CallOption) (*CancelPhotoReservationsResponse, error)
+	GetMatchedPhotos(ctx context.Context, in *GetMatchedPhotosRequest, opts ...grpc.CallOption) (*GetMatchedPhotosResponse, error)
+	UpdateUserSimilarity(ctx context.Context, in *UpdateUserSimilarityRequest, opts ...grpc.CallOption) (*UpdateUserSimilarityResponse, error)
+	DeletePhoto(ctx context.Context, in *DeletePhotoRequest, opts ...grpc.CallOption) (*DeletePhotoResponse, error)
 }
 
 type photoServiceClient struct {
@@ -59,12 +87,166 @@ func (c *photoServiceClient) UpdateFaceRecogPhoto(ctx context.Context, in *Updat
 	return out, nil
 }
 
+func (c *photoServiceClient) CreatePhoto(ctx context.Context, in *CreatePhotoRequest, opts ...grpc.CallOption) (*CreatePhotoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePhotoResponse)
+	err := c.cc.Invoke(ctx, PhotoService_CreatePhoto_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *photoServiceClient) CreateUserSimilarFacecam(ctx context.Context, in *CreateUserSimilarFacecamRequest, opts ...grpc.CallOption) (*CreateUserSimilarFacecamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateUserSimilarFacecamResponse)
+	err := c.cc.Invoke(ctx, PhotoService_CreateUserSimilarFacecam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *photoServiceClient) CreateFacecam(ctx context.Context, in *CreateFacecamRequest, opts ...grpc.CallOption) (*CreateFacecamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateFacecamResponse)
+	err := c.cc.Invoke(ctx, PhotoService_CreateFacecam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *photoServiceClient) UpdatePhotoDetail(ctx context.Context, in *UpdatePhotoDetailRequest, opts ...grpc.CallOption) (*UpdatePhotoDetailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePhotoDetailResponse)
+	err := c.cc.Invoke(ctx, PhotoService_UpdatePhotoDetail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *photoServiceClient) CreateUserSimilar(ctx context.Context, in *CreateUserSimilarPhotoRequest, opts ...grpc.CallOption) (*CreateUserSimilarPhotoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateUserSimilarPhotoResponse)
+	err := c.cc.Invoke(ctx, PhotoService_CreateUserSimilar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *photoServiceClient) GetPhotoPrice(ctx context.Context, in *GetPhotoPriceRequest, opts ...grpc.CallOption) (*GetPhotoPriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPhotoPriceResponse)
+	err := c.cc.Invoke(ctx, PhotoService_GetPhotoPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *photoServiceClient) UpdatePhotosOwner(ctx context.Context, in *UpdatePhotosOwnerRequest, opts ...grpc.CallOption) (*UpdatePhotosOwnerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePhotosOwnerResponse)
+	err := c.cc.Invoke(ctx, PhotoService_UpdatePhotosOwner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *photoServiceClient) ClearPhotosOwner(ctx context.Context, in *ClearPhotosOwnerRequest, opts ...grpc.CallOption) (*ClearPhotosOwnerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearPhotosOwnerResponse)
+	err := c.cc.Invoke(ctx, PhotoService_ClearPhotosOwner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *photoServiceClient) GetCart(ctx context.Context, in *GetCartRequest, opts ...grpc.CallOption) (*GetCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCartResponse)
+	err := c.cc.Invoke(ctx, PhotoService_GetCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *photoServiceClient) ReservePhotos(ctx context.Context, in *ReservePhotosRequest, opts ...grpc.CallOption) (*ReservePhotosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReservePhotosResponse)
+	err := c.cc.Invoke(ctx, PhotoService_ReservePhotos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *photoServiceClient) CancelPhotoReservations(ctx context.Context, in *CancelPhotoReservationsRequest, opts ...grpc.CallOption) (*CancelPhotoReservationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelPhotoReservationsResponse)
+	err := c.cc.Invoke(ctx, PhotoService_CancelPhotoReservations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *photoServiceClient) GetMatchedPhotos(ctx context.Context, in *GetMatchedPhotosRequest, opts ...grpc.CallOption) (*GetMatchedPhotosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMatchedPhotosResponse)
+	err := c.cc.Invoke(ctx, PhotoService_GetMatchedPhotos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *photoServiceClient) UpdateUserSimilarity(ctx context.Context, in *UpdateUserSimilarityRequest, opts ...grpc.CallOption) (*UpdateUserSimilarityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateUserSimilarityResponse)
+	err := c.cc.Invoke(ctx, PhotoService_UpdateUserSimilarity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *photoServiceClient) DeletePhoto(ctx context.Context, in *DeletePhotoRequest, opts ...grpc.CallOption) (*DeletePhotoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePhotoResponse)
+	err := c.cc.Invoke(ctx, PhotoService_DeletePhoto_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PhotoServiceServer is the server API for PhotoService service.
 // All implementations must embed UnimplementedPhotoServiceServer
 // for forward compatibility.
 type PhotoServiceServer interface {
 	UpdatePhotographerPhoto(context.Context, *UpdatePhotographerPhotoRequest) (*UpdatePhotographerPhotoResponse, error)
 	UpdateFaceRecogPhoto(context.Context, *UpdateFaceRecogPhotoRequest) (*UpdateFaceRecogPhotoResponse, error)
+	CreatePhoto(context.Context, *CreatePhotoRequest) (*CreatePhotoResponse, error)
+	CreateUserSimilarFacecam(context.Context, *CreateUserSimilarFacecamRequest) (*CreateUserSimilarFacecamResponse, error)
+	CreateFacecam(context.Context, *CreateFacecamRequest) (*CreateFacecamResponse, error)
+	UpdatePhotoDetail(context.Context, *UpdatePhotoDetailRequest) (*UpdatePhotoDetailResponse, error)
+	CreateUserSimilar(context.Context, *CreateUserSimilarPhotoRequest) (*CreateUserSimilarPhotoResponse, error)
+	GetPhotoPrice(context.Context, *GetPhotoPriceRequest) (*GetPhotoPriceResponse, error)
+	UpdatePhotosOwner(context.Context, *UpdatePhotosOwnerRequest) (*UpdatePhotosOwnerResponse, error)
+	ClearPhotosOwner(context.Context, *ClearPhotosOwnerRequest) (*ClearPhotosOwnerResponse, error)
+	GetCart(context.Context, *GetCartRequest) (*GetCartResponse, error)
+	ReservePhotos(context.Context, *ReservePhotosRequest) (*ReservePhotosResponse, error)
+	CancelPhotoReservations(context.Context, *CancelPhotoReservationsRequest) (*CancelPhotoReservationsResponse, error)
+	GetMatchedPhotos(context.Context, *GetMatchedPhotosRequest) (*GetMatchedPhotosResponse, error)
+	UpdateUserSimilarity(context.Context, *UpdateUserSimilarityRequest) (*UpdateUserSimilarityResponse, error)
+	DeletePhoto(context.Context, *DeletePhotoRequest) (*DeletePhotoResponse, error)
 	mustEmbedUnimplementedPhotoServiceServer()
 }
 
@@ -81,6 +263,48 @@ func (UnimplementedPhotoServiceServer) UpdatePhotographerPhoto(context.Context,
 func (UnimplementedPhotoServiceServer) UpdateFaceRecogPhoto(context.Context, *UpdateFaceRecogPhotoRequest) (*UpdateFaceRecogPhotoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateFaceRecogPhoto not implemented")
 }
+func (UnimplementedPhotoServiceServer) CreatePhoto(context.Context, *CreatePhotoRequest) (*CreatePhotoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePhoto not implemented")
+}
+func (UnimplementedPhotoServiceServer) CreateUserSimilarFacecam(context.Context, *CreateUserSimilarFacecamRequest) (*CreateUserSimilarFacecamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUserSimilarFacecam not implemented")
+}
+func (UnimplementedPhotoServiceServer) CreateFacecam(context.Context, *CreateFacecamRequest) (*CreateFacecamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFacecam not implemented")
+}
+func (UnimplementedPhotoServiceServer) UpdatePhotoDetail(context.Context, *UpdatePhotoDetailRequest) (*UpdatePhotoDetailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePhotoDetail not implemented")
+}
+func (UnimplementedPhotoServiceServer) CreateUserSimilar(context.Context, *CreateUserSimilarPhotoRequest) (*CreateUserSimilarPhotoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUserSimilar not implemented")
+}
+func (UnimplementedPhotoServiceServer) GetPhotoPrice(context.Context, *GetPhotoPriceRequest) (*GetPhotoPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPhotoPrice not implemented")
+}
+func (UnimplementedPhotoServiceServer) UpdatePhotosOwner(context.Context, *UpdatePhotosOwnerRequest) (*UpdatePhotosOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePhotosOwner not implemented")
+}
+func (UnimplementedPhotoServiceServer) ClearPhotosOwner(context.Context, *ClearPhotosOwnerRequest) (*ClearPhotosOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearPhotosOwner not implemented")
+}
+func (UnimplementedPhotoServiceServer) GetCart(context.Context, *GetCartRequest) (*GetCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCart not implemented")
+}
+func (UnimplementedPhotoServiceServer) ReservePhotos(context.Context, *ReservePhotosRequest) (*ReservePhotosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReservePhotos not implemented")
+}
+func (UnimplementedPhotoServiceServer) CancelPhotoReservations(context.Context, *CancelPhotoReservationsRequest) (*CancelPhotoReservationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPhotoReservations not implemented")
+}
+func (UnimplementedPhotoServiceServer) GetMatchedPhotos(context.Context, *GetMatchedPhotosRequest) (*GetMatchedPhotosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMatchedPhotos not implemented")
+}
+func (UnimplementedPhotoServiceServer) UpdateUserSimilarity(context.Context, *UpdateUserSimilarityRequest) (*UpdateUserSimilarityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserSimilarity not implemented")
+}
+func (UnimplementedPhotoServiceServer) DeletePhoto(context.Context, *DeletePhotoRequest) (*DeletePhotoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePhoto not implemented")
+}
 func (UnimplementedPhotoServiceServer) mustEmbedUnimplementedPhotoServiceServer() {}
 func (UnimplementedPhotoServiceServer) testEmbeddedByValue()                      {}
 
@@ -138,6 +362,258 @@ func _PhotoService_UpdateFaceRecogPhoto_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PhotoService_CreatePhoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePhotoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoServiceServer).CreatePhoto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotoService_CreatePhoto_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoServiceServer).CreatePhoto(ctx, req.(*CreatePhotoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PhotoService_CreateUserSimilarFacecam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserSimilarFacecamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoServiceServer).CreateUserSimilarFacecam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotoService_CreateUserSimilarFacecam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoServiceServer).CreateUserSimilarFacecam(ctx, req.(*CreateUserSimilarFacecamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PhotoService_CreateFacecam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFacecamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoServiceServer).CreateFacecam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotoService_CreateFacecam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoServiceServer).CreateFacecam(ctx, req.(*CreateFacecamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PhotoService_UpdatePhotoDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePhotoDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoServiceServer).UpdatePhotoDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotoService_UpdatePhotoDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoServiceServer).UpdatePhotoDetail(ctx, req.(*UpdatePhotoDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PhotoService_CreateUserSimilar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserSimilarPhotoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoServiceServer).CreateUserSimilar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotoService_CreateUserSimilar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoServiceServer).CreateUserSimilar(ctx, req.(*CreateUserSimilarPhotoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PhotoService_GetPhotoPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPhotoPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoServiceServer).GetPhotoPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotoService_GetPhotoPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoServiceServer).GetPhotoPrice(ctx, req.(*GetPhotoPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PhotoService_UpdatePhotosOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePhotosOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoServiceServer).UpdatePhotosOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotoService_UpdatePhotosOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoServiceServer).UpdatePhotosOwner(ctx, req.(*UpdatePhotosOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PhotoService_ClearPhotosOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearPhotosOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoServiceServer).ClearPhotosOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotoService_ClearPhotosOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoServiceServer).ClearPhotosOwner(ctx, req.(*ClearPhotosOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PhotoService_GetCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoServiceServer).GetCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotoService_GetCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoServiceServer).GetCart(ctx, req.(*GetCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PhotoService_ReservePhotos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReservePhotosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoServiceServer).ReservePhotos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotoService_ReservePhotos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoServiceServer).ReservePhotos(ctx, req.(*ReservePhotosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PhotoService_CancelPhotoReservations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPhotoReservationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoServiceServer).CancelPhotoReservations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotoService_CancelPhotoReservations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoServiceServer).CancelPhotoReservations(ctx, req.(*CancelPhotoReservationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PhotoService_GetMatchedPhotos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMatchedPhotosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoServiceServer).GetMatchedPhotos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotoService_GetMatchedPhotos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoServiceServer).GetMatchedPhotos(ctx, req.(*GetMatchedPhotosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PhotoService_UpdateUserSimilarity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserSimilarityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoServiceServer).UpdateUserSimilarity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotoService_UpdateUserSimilarity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoServiceServer).UpdateUserSimilarity(ctx, req.(*UpdateUserSimilarityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PhotoService_DeletePhoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePhotoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoServiceServer).DeletePhoto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotoService_DeletePhoto_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoServiceServer).DeletePhoto(ctx, req.(*DeletePhotoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PhotoService_ServiceDesc is the grpc.ServiceDesc for PhotoService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +629,62 @@ var PhotoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateFaceRecogPhoto",
 			Handler:    _PhotoService_UpdateFaceRecogPhoto_Handler,
 		},
+		{
+			MethodName: "CreatePhoto",
+			Handler:    _PhotoService_CreatePhoto_Handler,
+		},
+		{
+			MethodName: "CreateUserSimilarFacecam",
+			Handler:    _PhotoService_CreateUserSimilarFacecam_Handler,
+		},
+		{
+			MethodName: "CreateFacecam",
+			Handler:    _PhotoService_CreateFacecam_Handler,
+		},
+		{
+			MethodName: "UpdatePhotoDetail",
+			Handler:    _PhotoService_UpdatePhotoDetail_Handler,
+		},
+		{
+			MethodName: "CreateUserSimilar",
+			Handler:    _PhotoService_CreateUserSimilar_Handler,
+		},
+		{
+			MethodName: "GetPhotoPrice",
+			Handler:    _PhotoService_GetPhotoPrice_Handler,
+		},
+		{
+			MethodName: "UpdatePhotosOwner",
+			Handler:    _PhotoService_UpdatePhotosOwner_Handler,
+		},
+		{
+			MethodName: "ClearPhotosOwner",
+			Handler:    _PhotoService_ClearPhotosOwner_Handler,
+		},
+		{
+			MethodName: "GetCart",
+			Handler:    _PhotoService_GetCart_Handler,
+		},
+		{
+			MethodName: "ReservePhotos",
+			Handler:    _PhotoService_ReservePhotos_Handler,
+		},
+		{
+			MethodName: "CancelPhotoReservations",
+			Handler:    _PhotoService_CancelPhotoReservations_Handler,
+		},
+		{
+			MethodName: "GetMatchedPhotos",
+			Handler:    _PhotoService_GetMatchedPhotos_Handler,
+		},
+		{
+			MethodName: "UpdateUserSimilarity",
+			Handler:    _PhotoService_UpdateUserSimilarity_Handler,
+		},
+		{
+			MethodName: "DeletePhoto",
+			Handler:    _PhotoService_DeletePhoto_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "photo.proto",
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"be-yourmoments/transaction-svc/internal/entity"
+	"context"
+	"fmt"
+)
+
+type CommissionRuleRepository interface {
+	Upsert(ctx context.Context, db Querier, rule *entity.CommissionRule) error
+	FindAll(ctx context.Context, db Querier) ([]*entity.CommissionRule, error)
+	FindForCreator(ctx context.Context, db Querier, creatorId string) (*entity.CommissionRule, error)
+	DeleteByCreatorId(ctx context.Context, db Querier, creatorId string) (int64, error)
+}
+
+type commissionRuleRepository struct {
+}
+
+func NewCommissionRuleRepository() CommissionRuleRepository {
+	return &commissionRuleRepository{}
+}
+
+func (r *commissionRuleRepository) Upsert(ctx context.Context, db Querier, rule *entity.CommissionRule) error {
+	query := `INSERT INTO commission_rules (id, creator_id, percentage_bps, flat_fee, created_at, updated_at)
+			  VALUES ($1, $2, $3, $4, $5, $6)
+			  ON CONFLICT (creator_id) DO UPDATE
+			  SET percentage_bps = EXCLUDED.percentage_bps, flat_fee = EXCLUDED.flat_fee, updated_at = EXCLUDED.updated_at
+			  RETURNING id, created_at`
+
+	err := db.QueryRow(ctx, query, rule.Id, rule.CreatorId, rule.PercentageBps, rule.FlatFee,
+		rule.CreatedAt, rule.UpdatedAt).Scan(&rule.Id, &rule.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to upsert commission rule: %w", err)
+	}
+
+	return nil
+}
+
+func (r *commissionRuleRepository) FindAll(ctx context.Context, db Querier) ([]*entity.CommissionRule, error) {
+	query := `SELECT id, creator_id, percentage_bps, flat_fee, created_at, updated_at
+			  FROM commission_rules ORDER BY creator_id`
+
+	rows, err := db.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query commission rules: %w", err)
+	}
+	defer rows.Close()
+
+	var rules []*entity.CommissionRule
+	for rows.Next() {
+		rule := new(entity.CommissionRule)
+		if err := rows.Scan(&rule.Id, &rule.CreatorId, &rule.PercentageBps, &rule.FlatFee,
+			&rule.CreatedAt, &rule.UpdatedAt); err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, rows.Err()
+}
+
+// FindForCreator returns the creator override if there is one, otherwise the platform default.
+func (r *commissionRuleRepository) FindForCreator(ctx context.Context, db Querier, creatorId string) (*entity.CommissionRule, error) {
+	query := `SELECT id, creator_id, percentage_bps, flat_fee, created_at, updated_at
+			  FROM commission_rules WHERE creator_id = $1 OR creator_id = ''
+			  ORDER BY creator_id DESC LIMIT 1`
+
+	rule := new(entity.CommissionRule)
+	err := db.QueryRow(ctx, query, creatorId).Scan(&rule.Id, &rule.CreatorId, &rule.PercentageBps, &rule.FlatFee,
+		&rule.CreatedAt, &rule.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+func (r *commissionRuleRepository) DeleteByCreatorId(ctx context.Context, db Querier, creatorId string) (int64, error) {
+	query := `DELETE FROM commission_rules WHERE creator_id = $1`
+
+	tag, err := db.Exec(ctx, query, creatorId)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete commission rule: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"be-yourmoments/transaction-svc/internal/entity"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type IdempotencyKeyRepository interface {
+	Reserve(ctx context.Context, db Querier, key *entity.IdempotencyKey, ttl time.Duration) (bool, error)
+	FindByKey(ctx context.Context, db Querier, userId string, key string) (*entity.IdempotencyKey, error)
+	Complete(ctx context.Context, db Querier, key *entity.IdempotencyKey) error
+	Delete(ctx context.Context, db Querier, userId string, key string) error
+}
+
+type idempotencyKeyRepository struct {
+}
+
+func NewIdempotencyKeyRepository() IdempotencyKeyRepository {
+	return &idempotencyKeyRepository{}
+}
+
+// Reserve claims the key of the user for a new request. Keys older than ttl are expired and can be claimed again.
+func (r *idempotencyKeyRepository) Reserve(ctx context.Context, db Querier, key *entity.IdempotencyKey, ttl time.Duration) (bool, error) {
+	query := `INSERT INTO idempotency_keys (user_id, key, fingerprint, created_at) VALUES ($1, $2, $3, $4)
+			  ON CONFLICT (user_id, key) DO UPDATE
+			  SET fingerprint = EXCLUDED.fingerprint, status_code = NULL, content_type = '', response_body = NULL,
+			  created_at = EXCLUDED.created_at, completed_at = NULL
+			  WHERE idempotency_keys.created_at < $5
+			  RETURNING key`
+
+	var reserved string
+	err := db.QueryRow(ctx, query, key.UserId, key.Key, key.Fingerprint, key.CreatedAt, key.CreatedAt.Add(-ttl)).Scan(&reserved)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to reserve idempotency key: %w", err)
+	}
+
+	return true, nil
+}
+
+func (r *idempotencyKeyRepository) FindByKey(ctx context.Context, db Querier, userId string, key string) (*entity.IdempotencyKey, error) {
+	query := `SELECT user_id, key, fingerprint, COALESCE(status_code, 0), content_type, response_body, created_at, completed_at
+			  FROM idempotency_keys WHERE user_id = $1 AND key = $2`
+
+	idempotencyKey := new(entity.IdempotencyKey)
+	err := db.QueryRow(ctx, query, userId, key).Scan(&idempotencyKey.UserId, &idempotencyKey.Key, &idempotencyKey.Fingerprint, &idempotencyKey.StatusCode,
+		&idempotencyKey.ContentType, &idempotencyKey.ResponseBody, &idempotencyKey.CreatedAt, &idempotencyKey.CompletedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return idempotencyKey, nil
+}
+
+func (r *idempotencyKeyRepository) Complete(ctx context.Context, db Querier, key *entity.IdempotencyKey) error {
+	query := `UPDATE idempotency_keys
+			  SET status_code = $1, content_type = $2, response_body = $3, completed_at = $4
+			  WHERE user_id = $5 AND key = $6`
+
+	_, err := db.Exec(ctx, query, key.StatusCode, key.ContentType, key.ResponseBody, key.CompletedAt, key.UserId, key.Key)
+	if err != nil {
+		return fmt.Errorf("failed to complete idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+func (r *idempotencyKeyRepository) Delete(ctx context.Context, db Querier, userId string, key string) error {
+	if _, err := db.Exec(ctx, `DELETE FROM idempotency_keys WHERE user_id = $1 AND key = $2`, userId, key); err != nil {
+		return fmt.Errorf("failed to delete idempotency key: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"be-yourmoments/transaction-svc/internal/entity"
+	"context"
+	"fmt"
+)
+
+type LedgerRepository interface {
+	CreateEntries(ctx context.Context, db Querier, entries []*entity.LedgerEntry) error
+	SumByWallet(ctx context.Context, db Querier, walletId string) (int64, error)
+	FindByWallet(ctx context.Context, db Querier, walletId string, limit, offset int) ([]*entity.LedgerEntry, error)
+	CountByWallet(ctx context.Context, db Querier, walletId string) (int64, error)
+}
+
+type ledgerRepository struct {
+}
+
+func NewLedgerRepository() LedgerRepository {
+	return &ledgerRepository{}
+}
+
+func (r *ledgerRepository) CreateEntries(ctx context.Context, db Querier, entries []*entity.LedgerEntry) error {
+	query := `INSERT INTO ledger_entries
+			  (id, journal_id, wallet_id, transaction_id, withdrawal_id, type, amount, description, created_at)
+			  VALUES ($1, $2, $3, NULLIF($4, ''), NULLIF($5, ''), $6, $7, $8, $9)`
+
+	for _, entry := range entries {
+		_, err := db.Exec(ctx, query, entry.Id, entry.JournalId, entry.WalletId, entry.TransactionId,
+			entry.WithdrawalId, entry.Type, entry.Amount, entry.Description, entry.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to insert ledger entry: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *ledgerRepository) SumByWallet(ctx context.Context, db Querier, walletId string) (int64, error) {
+	query := `SELECT COALESCE(SUM(amount), 0) FROM ledger_entries WHERE wallet_id = $1`
+
+	var balance int64
+	if err := db.QueryRow(ctx, query, walletId).Scan(&balance); err != nil {
+		return 0, fmt.Errorf("failed to sum ledger entries: %w", err)
+	}
+
+	return balance, nil
+}
+
+func (r *ledgerRepository) FindByWallet(ctx context.Context, db Querier, walletId string, limit, offset int) ([]*entity.LedgerEntry, error) {
+	query := `SELECT id, journal_id, wallet_id, COALESCE(transaction_id, ''),
+			  COALESCE(withdrawal_id, ''), type, amount, description, created_at
+			  FROM ledger_entries WHERE wallet_id = $1
+			  ORDER BY created_at DESC, id DESC LIMIT $2 OFFSET $3`
+
+	rows, err := db.Query(ctx, query, walletId, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ledger entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*entity.LedgerEntry
+	for rows.Next() {
+		entry := new(entity.LedgerEntry)
+		if err := rows.Scan(&entry.Id, &entry.JournalId, &entry.WalletId, &entry.TransactionId, &entry.WithdrawalId, &entry.Type,
+			&entry.Amount, &entry.Description, &entry.CreatedAt); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
+
+func (r *ledgerRepository) CountByWallet(ctx context.Context, db Querier, walletId string) (int64, error) {
+	query := `SELECT COUNT(*) FROM ledger_entries WHERE wallet_id = $1`
+
+	var total int64
+	if err := db.QueryRow(ctx, query, walletId).Scan(&total); err != nil {
+		return 0, fmt.Errorf("failed to count ledger entries: %w", err)
+	}
+
+	return total, nil
+}
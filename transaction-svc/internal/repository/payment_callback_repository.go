@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"be-yourmoments/transaction-svc/internal/entity"
+	"context"
+	"fmt"
+)
+
+type PaymentCallbackRepository interface {
+	Create(ctx context.Context, db Querier, callback *entity.PaymentCallback) error
+}
+
+type paymentCallbackRepository struct {
+}
+
+func NewPaymentCallbackRepository() PaymentCallbackRepository {
+	return &paymentCallbackRepository{}
+}
+
+func (r *paymentCallbackRepository) Create(ctx context.Context, db Querier, callback *entity.PaymentCallback) error {
+	query := `INSERT INTO payment_callbacks
+			  (id, transaction_id, external_status, payload, applied, created_at)
+			  VALUES ($1, $2, $3, $4, $5, $6)`
+
+	_, err := db.Exec(ctx, query, callback.Id, callback.TransactionId, callback.ExternalStatus,
+		callback.Payload, callback.Applied, callback.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert payment callback: %w", err)
+	}
+
+	return nil
+}
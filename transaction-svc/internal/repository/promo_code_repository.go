@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"be-yourmoments/transaction-svc/internal/entity"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var ErrPromoCodeExists = errors.New("promo code already exists")
+
+type PromoCodeRepository interface {
+	Create(ctx context.Context, db Querier, promoCode *entity.PromoCode) error
+	FindAll(ctx context.Context, db Querier) ([]*entity.PromoCode, error)
+	FindById(ctx context.Context, db Querier, id string) (*entity.PromoCode, error)
+	FindByCodeForUpdate(ctx context.Context, db Querier, code string) (*entity.PromoCode, error)
+	Update(ctx context.Context, db Querier, promoCode *entity.PromoCode) error
+	CountUserRedemptions(ctx context.Context, db Querier, promoCodeId, userId string) (int, error)
+	CreateRedemption(ctx context.Context, db Querier, redemption *entity.PromoRedemption) error
+	VoidRedemption(ctx context.Context, db Querier, transactionId string) error
+}
+
+// promoCodeColumns counts redemptions of orders that were not denied or expired.
+const promoCodeColumns = `p.id, p.code, p.type, p.value, p.max_redemptions, p.max_redemptions_per_user, p.creator_id,
+	p.starts_at, p.expires_at, p.is_active,
+	(SELECT COUNT(*) FROM promo_redemptions r WHERE r.promo_code_id = p.id AND r.voided_at IS NULL),
+	p.created_at, p.updated_at`
+
+type promoCodeRepository struct {
+}
+
+func NewPromoCodeRepository() PromoCodeRepository {
+	return &promoCodeRepository{}
+}
+
+func (r *promoCodeRepository) Create(ctx context.Context, db Querier, promoCode *entity.PromoCode) error {
+	query := `INSERT INTO promo_codes
+			  (id, code, type, value, max_redemptions, max_redemptions_per_user, creator_id, starts_at, expires_at,
+			  is_active, created_at, updated_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+			  ON CONFLICT (code) DO NOTHING`
+
+	tag, err := db.Exec(ctx, query, promoCode.Id, promoCode.Code, promoCode.Type, promoCode.Value,
+		promoCode.MaxRedemptions, promoCode.MaxRedemptionsPerUser, promoCode.CreatorId, promoCode.StartsAt,
+		promoCode.ExpiresAt, promoCode.IsActive, promoCode.CreatedAt, promoCode.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert promo code: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrPromoCodeExists
+	}
+
+	return nil
+}
+
+func (r *promoCodeRepository) FindAll(ctx context.Context, db Querier) ([]*entity.PromoCode, error) {
+	query := `SELECT ` + promoCodeColumns + ` FROM promo_codes p ORDER BY p.created_at DESC`
+
+	rows, err := db.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query promo codes: %w", err)
+	}
+	defer rows.Close()
+
+	var promoCodes []*entity.PromoCode
+	for rows.Next() {
+		promoCode, err := scanPromoCode(rows)
+		if err != nil {
+			return nil, err
+		}
+		promoCodes = append(promoCodes, promoCode)
+	}
+
+	return promoCodes, rows.Err()
+}
+
+func (r *promoCodeRepository) FindById(ctx context.Context, db Querier, id string) (*entity.PromoCode, error) {
+	query := `SELECT ` + promoCodeColumns + ` FROM promo_codes p WHERE p.id = $1`
+
+	return scanPromoCode(db.QueryRow(ctx, query, id))
+}
+
+// FindByCodeForUpdate locks the promo code so concurrent orders can not redeem past its limits.
+func (r *promoCodeRepository) FindByCodeForUpdate(ctx context.Context, db Querier, code string) (*entity.PromoCode, error) {
+	query := `SELECT ` + promoCodeColumns + ` FROM promo_codes p WHERE p.code = $1 FOR UPDATE`
+
+	return scanPromoCode(db.QueryRow(ctx, query, code))
+}
+
+func (r *promoCodeRepository) Update(ctx context.Context, db Querier, promoCode *entity.PromoCode) error {
+	query := `UPDATE promo_codes
+			  SET type = $1, value = $2, max_redemptions = $3, max_redemptions_per_user = $4, creator_id = $5,
+			  starts_at = $6, expires_at = $7, is_active = $8, updated_at = $9
+			  WHERE id = $10`
+
+	_, err := db.Exec(ctx, query, promoCode.Type, promoCode.Value, promoCode.MaxRedemptions,
+		promoCode.MaxRedemptionsPerUser, promoCode.CreatorId, promoCode.StartsAt, promoCode.ExpiresAt,
+		promoCode.IsActive, promoCode.UpdatedAt, promoCode.Id)
+	if err != nil {
+		return fmt.Errorf("failed to update promo code: %w", err)
+	}
+
+	return nil
+}
+
+func (r *promoCodeRepository) CountUserRedemptions(ctx context.Context, db Querier, promoCodeId, userId string) (int, error) {
+	query := `SELECT COUNT(*) FROM promo_redemptions
+			  WHERE promo_code_id = $1 AND user_id = $2 AND voided_at IS NULL`
+
+	var count int
+	if err := db.QueryRow(ctx, query, promoCodeId, userId).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count promo redemptions: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *promoCodeRepository) CreateRedemption(ctx context.Context, db Querier, redemption *entity.PromoRedemption) error {
+	query := `INSERT INTO promo_redemptions (id, promo_code_id, user_id, transaction_id, discount_amount, created_at)
+			  VALUES ($1, $2, $3, $4, $5, $6)`
+
+	_, err := db.Exec(ctx, query, redemption.Id, redemption.PromoCodeId, redemption.UserId, redemption.TransactionId,
+		redemption.DiscountAmount, redemption.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert promo redemption: %w", err)
+	}
+
+	return nil
+}
+
+// VoidRedemption gives the redemption of an order back, it is a no-op for orders without a promo code.
+func (r *promoCodeRepository) VoidRedemption(ctx context.Context, db Querier, transactionId string) error {
+	query := `UPDATE promo_redemptions SET voided_at = now() WHERE transaction_id = $1 AND voided_at IS NULL`
+
+	if _, err := db.Exec(ctx, query, transactionId); err != nil {
+		return fmt.Errorf("failed to void promo redemption: %w", err)
+	}
+
+	return nil
+}
+
+func scanPromoCode(row pgx.Row) (*entity.PromoCode, error) {
+	promoCode := new(entity.PromoCode)
+	err := row.Scan(&promoCode.Id, &promoCode.Code, &promoCode.Type, &promoCode.Value, &promoCode.MaxRedemptions,
+		&promoCode.MaxRedemptionsPerUser, &promoCode.CreatorId, &promoCode.StartsAt, &promoCode.ExpiresAt,
+		&promoCode.IsActive, &promoCode.RedemptionCount, &promoCode.CreatedAt, &promoCode.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return promoCode, nil
+}
@@ -12,3 +12,9 @@ type Querier interface {
 	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
 	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
 }
+
+// DB is what the usecases need from the connection pool, a Querier that can also start transactions.
+type DB interface {
+	Querier
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
@@ -2,79 +2,288 @@ package repository
 
 import (
 	"be-yourmoments/transaction-svc/internal/entity"
+	"be-yourmoments/transaction-svc/internal/enum"
 	"context"
+	"errors"
 	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
 )
 
 type TransactionRepository interface {
 	Create(ctx context.Context, db Querier, transaction *entity.Transaction) error
-	// UpdateProcessedUrl(ctx context.Context, db Querier, transaction *entity.Transaction) error
-	// UpdateClaimedPhoto(ctx context.Context, db Querier, transaction *entity.Transaction) error
-	// UpdatePhotoStatus(ctx context.Context, db Querier, transaction *entity.Transaction) error
+	FindById(ctx context.Context, db Querier, id string) (*entity.Transaction, error)
+	FindByIdForUpdate(ctx context.Context, db Querier, id string) (*entity.Transaction, error)
+	UpdatePayment(ctx context.Context, db Querier, transaction *entity.Transaction) error
+	UpdateItemCommissions(ctx context.Context, db Querier, items []*entity.TransactionItem) error
+	FindPhotoUnsynced(ctx context.Context, db Querier, limit int) ([]*entity.Transaction, error)
+	MarkPhotoSynced(ctx context.Context, db Querier, id string, status enum.TransactionStatus) error
+	ClaimRefund(ctx context.Context, db Querier, id string, claimedAt time.Time, staleBefore time.Time) (bool, error)
+	ReleaseRefundClaim(ctx context.Context, db Querier, id string) error
+	FindByFilter(ctx context.Context, db Querier, filter *TransactionFilter, limit, offset int) ([]*entity.Transaction, error)
+	CountByFilter(ctx context.Context, db Querier, filter *TransactionFilter) (int64, error)
+	FindPaidBetween(ctx context.Context, db Querier, from, to time.Time) ([]*entity.Transaction, error)
+	FindPendingBefore(ctx context.Context, db Querier, before time.Time, limit int) ([]*entity.Transaction, error)
+}
+
+// TransactionFilter selects a buyer's transactions, an empty Status and nil dates match everything.
+// From is inclusive and To exclusive.
+type TransactionFilter struct {
+	UserId string
+	Status enum.TransactionStatus
+	From   *time.Time
+	To     *time.Time
 }
 
+const transactionColumns = `id, user_id, amount, discount_amount, COALESCE(promo_code_id, ''), fee_amount, net_amount, status, snap_token, payment_method_type,
+	payment_method_provider, payment_method_account, external_status, external_callback_response, photo_synced, paid_at, refunded_at, created_at, updated_at`
+
 type transactionRepository struct {
 }
 
-func NewTransactionpository() TransactionRepository {
+func NewTransactionRepository() TransactionRepository {
 	return &transactionRepository{}
 }
 
+// Create inserts the transaction together with its items.
 func (r *transactionRepository) Create(ctx context.Context, db Querier, transaction *entity.Transaction) error {
-
 	query := `INSERT INTO transactions 
-			  (id, user_id, photo_id, snap_token, external_callback_response, created_at, updated_at) 
-			  VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id`
-
-	err := db.QueryRow(ctx, query, transaction.Id, transaction.UserId, transaction.PhotoId,
-		transaction.SnapToken, transaction.ExternalCallbackResponse, transaction.PaidAt,
-		transaction.CreatedAt, transaction.UpdatedAt).Scan(&transaction.Id)
+			  (id, user_id, amount, discount_amount, promo_code_id, status, snap_token, created_at, updated_at) 
+			  VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, $7, $8, $9)`
 
+	_, err := db.Exec(ctx, query, transaction.Id, transaction.UserId, transaction.Amount, transaction.DiscountAmount,
+		transaction.PromoCodeId, transaction.Status, transaction.SnapToken, transaction.CreatedAt, transaction.UpdatedAt)
 	if err != nil {
 		return fmt.Errorf("failed to insert transaction: %w", err)
 	}
 
+	itemQuery := `INSERT INTO transaction_items
+				  (id, transaction_id, photo_id, photo_title, creator_id, amount, discount_amount, created_at)
+				  VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	for _, item := range transaction.Items {
+		_, err := db.Exec(ctx, itemQuery, item.Id, item.TransactionId, item.PhotoId, item.PhotoTitle, item.CreatorId,
+			item.Amount, item.DiscountAmount, item.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to insert transaction item: %w", err)
+		}
+	}
+
 	return nil
 }
 
-// func (r *transactionRepository) UpdateProcessedUrl(ctx context.Context, db Querier, transaction *entity.Transaction) error {
-// 	query := `UPDATE photos
-// 	          SET preview_url = $1, preview_with_bounding_url = $2, updated_at = $3
-// 	          WHERE id = $4`
+func (r *transactionRepository) FindById(ctx context.Context, db Querier, id string) (*entity.Transaction, error) {
+	query := `SELECT ` + transactionColumns + ` FROM transactions WHERE id = $1`
 
-// 	_, err := db.Exec(ctx, query, transaction.PreviewUrl, transaction.PreviewWithBoundingUrl, transaction.UpdatedAt, transaction.Id)
+	transaction, err := scanTransaction(db.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, err
+	}
 
-// 	if err != nil {
-// 		return err
-// 	}
+	return transaction, r.loadItems(ctx, db, transaction)
+}
 
-// 	return nil
-// }
+// FindByIdForUpdate locks the row until the surrounding transaction ends.
+func (r *transactionRepository) FindByIdForUpdate(ctx context.Context, db Querier, id string) (*entity.Transaction, error) {
+	query := `SELECT ` + transactionColumns + ` FROM transactions WHERE id = $1 FOR UPDATE`
 
-// func (r *transactionRepository) UpdateClaimedPhoto(ctx context.Context, db Querier, transaction *entity.Transaction) error {
-// 	query := `UPDATE photos
-// 	          SET owned_by_user_id = $1, status = $2, updated_at = $3
-// 	          WHERE id = $4`
+	transaction, err := scanTransaction(db.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction, r.loadItems(ctx, db, transaction)
+}
 
-// 	_, err := db.Exec(ctx, query, transaction.OwnedByUserId, transaction.Status, transaction.UpdatedAt, transaction.Id)
+// scanTransaction reads a row selected with transactionColumns.
+func scanTransaction(row pgx.Row) (*entity.Transaction, error) {
+	transaction := new(entity.Transaction)
+	err := row.Scan(&transaction.Id, &transaction.UserId, &transaction.Amount, &transaction.DiscountAmount,
+		&transaction.PromoCodeId, &transaction.FeeAmount, &transaction.NetAmount, &transaction.Status,
+		&transaction.SnapToken, &transaction.PaymentMethod.Type, &transaction.PaymentMethod.Provider,
+		&transaction.PaymentMethod.Account, &transaction.ExternalStatus, &transaction.ExternalCallbackResponse,
+		&transaction.PhotoSynced, &transaction.PaidAt, &transaction.RefundedAt, &transaction.CreatedAt, &transaction.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
 
-// 	if err != nil {
-// 		return err
-// 	}
+	return transaction, nil
+}
 
-// 	return nil
-// }
+func (r *transactionRepository) UpdatePayment(ctx context.Context, db Querier, transaction *entity.Transaction) error {
+	query := `UPDATE transactions 
+			  SET status = $1, snap_token = $2, external_status = $3, external_callback_response = $4, paid_at = $5,
+			  fee_amount = $6, net_amount = $7, photo_synced = $8, refunded_at = $9, payment_method_type = $10,
+			  payment_method_provider = $11, payment_method_account = $12, updated_at = $13
+			  WHERE id = $14`
 
-// func (r *transactionRepository) UpdatePhotoStatus(ctx context.Context, db Querier, transaction *entity.Transaction) error {
-// 	query := `UPDATE photos
-// 	          SET status = $1, updated_at = $3
-// 	          WHERE id = $4`
+	_, err := db.Exec(ctx, query, transaction.Status, transaction.SnapToken, transaction.ExternalStatus,
+		transaction.ExternalCallbackResponse, transaction.PaidAt, transaction.FeeAmount, transaction.NetAmount,
+		transaction.PhotoSynced, transaction.RefundedAt, transaction.PaymentMethod.Type,
+		transaction.PaymentMethod.Provider, transaction.PaymentMethod.Account, transaction.UpdatedAt, transaction.Id)
+	if err != nil {
+		return fmt.Errorf("failed to update transaction payment: %w", err)
+	}
 
-// 	_, err := db.Exec(ctx, query, transaction.Status, transaction.UpdatedAt, transaction.Id)
+	return nil
+}
+
+// FindPhotoUnsynced returns settled or refunded transactions whose photo ownership was not updated yet.
+func (r *transactionRepository) FindPhotoUnsynced(ctx context.Context, db Querier, limit int) ([]*entity.Transaction, error) {
+	query := `SELECT ` + transactionColumns + ` FROM transactions
+			  WHERE photo_synced = FALSE ORDER BY updated_at LIMIT $1`
+
+	return r.findMany(ctx, db, query, limit)
+}
+
+// FindByFilter returns the newest transactions first.
+func (r *transactionRepository) FindByFilter(ctx context.Context, db Querier, filter *TransactionFilter, limit, offset int) ([]*entity.Transaction, error) {
+	query := `SELECT ` + transactionColumns + ` FROM transactions WHERE ` + transactionFilterCondition + `
+			  ORDER BY created_at DESC, id DESC LIMIT $5 OFFSET $6`
+
+	return r.findMany(ctx, db, query, filter.UserId, filter.Status, filter.From, filter.To, limit, offset)
+}
+
+func (r *transactionRepository) CountByFilter(ctx context.Context, db Querier, filter *TransactionFilter) (int64, error) {
+	query := `SELECT COUNT(*) FROM transactions WHERE ` + transactionFilterCondition
+
+	var count int64
+	err := db.QueryRow(ctx, query, filter.UserId, filter.Status, filter.From, filter.To).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count transactions: %w", err)
+	}
+
+	return count, nil
+}
+
+// FindPaidBetween returns the transactions charged through the gateway with paid_at in [from, to),
+// orders a promo code made free never reach the gateway and are left out.
+func (r *transactionRepository) FindPaidBetween(ctx context.Context, db Querier, from, to time.Time) ([]*entity.Transaction, error) {
+	query := `SELECT ` + transactionColumns + ` FROM transactions
+			  WHERE paid_at >= $1 AND paid_at < $2 AND amount > 0 ORDER BY paid_at`
+
+	return r.findMany(ctx, db, query, from, to)
+}
+
+func (r *transactionRepository) FindPendingBefore(ctx context.Context, db Querier, before time.Time, limit int) ([]*entity.Transaction, error) {
+	query := `SELECT ` + transactionColumns + ` FROM transactions
+			  WHERE status = 'PENDING' AND created_at < $1 ORDER BY created_at LIMIT $2`
+
+	return r.findMany(ctx, db, query, before, limit)
+}
 
-// 	if err != nil {
-// 		return err
-// 	}
+// transactionFilterCondition takes the TransactionFilter fields as $1 to $4.
+const transactionFilterCondition = `user_id = $1 AND ($2::text = '' OR status::text = $2)
+			  AND ($3::timestamptz IS NULL OR created_at >= $3) AND ($4::timestamptz IS NULL OR created_at < $4)`
 
-// 	return nil
-// }
+// findMany loads the items after the rows are closed, a connection can not run a query while another one is being read.
+func (r *transactionRepository) findMany(ctx context.Context, db Querier, query string, args ...any) ([]*entity.Transaction, error) {
+	rows, err := db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query transactions: %w", err)
+	}
+	defer rows.Close()
+
+	var transactions []*entity.Transaction
+	for rows.Next() {
+		transaction, err := scanTransaction(rows)
+		if err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, transaction)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	for _, transaction := range transactions {
+		if err := r.loadItems(ctx, db, transaction); err != nil {
+			return nil, err
+		}
+	}
+
+	return transactions, nil
+}
+
+// MarkPhotoSynced only marks the transaction when it is still in the status that was synced,
+// a refund that lands in between keeps it unsynced.
+func (r *transactionRepository) MarkPhotoSynced(ctx context.Context, db Querier, id string, status enum.TransactionStatus) error {
+	query := `UPDATE transactions SET photo_synced = TRUE WHERE id = $1 AND status = $2`
+
+	if _, err := db.Exec(ctx, query, id, status); err != nil {
+		return fmt.Errorf("failed to mark transaction photo synced: %w", err)
+	}
+
+	return nil
+}
+
+// ClaimRefund marks a settled transaction as being refunded, unless another refund holds a claim
+// taken after staleBefore. It reports whether the claim was taken.
+func (r *transactionRepository) ClaimRefund(ctx context.Context, db Querier, id string, claimedAt time.Time, staleBefore time.Time) (bool, error) {
+	query := `UPDATE transactions SET refund_claimed_at = $1
+			  WHERE id = $2 AND status = $3 AND (refund_claimed_at IS NULL OR refund_claimed_at < $4)
+			  RETURNING id`
+
+	var claimed string
+	err := db.QueryRow(ctx, query, claimedAt, id, enum.TransactionStatusSettled, staleBefore).Scan(&claimed)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to claim transaction refund: %w", err)
+	}
+
+	return true, nil
+}
+
+func (r *transactionRepository) ReleaseRefundClaim(ctx context.Context, db Querier, id string) error {
+	if _, err := db.Exec(ctx, `UPDATE transactions SET refund_claimed_at = NULL WHERE id = $1`, id); err != nil {
+		return fmt.Errorf("failed to release transaction refund claim: %w", err)
+	}
+
+	return nil
+}
+
+func (r *transactionRepository) UpdateItemCommissions(ctx context.Context, db Querier, items []*entity.TransactionItem) error {
+	query := `UPDATE transaction_items
+			  SET fee_amount = $1, net_amount = $2, commission_rule_id = NULLIF($3, ''),
+			  commission_percentage_bps = $4, commission_flat_fee = $5
+			  WHERE id = $6`
+
+	for _, item := range items {
+		_, err := db.Exec(ctx, query, item.FeeAmount, item.NetAmount, item.CommissionRuleId,
+			item.CommissionPercentageBps, item.CommissionFlatFee, item.Id)
+		if err != nil {
+			return fmt.Errorf("failed to update transaction item commission: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *transactionRepository) loadItems(ctx context.Context, db Querier, transaction *entity.Transaction) error {
+	query := `SELECT id, transaction_id, photo_id, photo_title, creator_id, amount, discount_amount, fee_amount, net_amount,
+			  COALESCE(commission_rule_id, ''), commission_percentage_bps, commission_flat_fee, created_at
+			  FROM transaction_items WHERE transaction_id = $1 ORDER BY id`
+
+	rows, err := db.Query(ctx, query, transaction.Id)
+	if err != nil {
+		return fmt.Errorf("failed to query transaction items: %w", err)
+	}
+	defer rows.Close()
+
+	transaction.Items = nil
+	for rows.Next() {
+		item := new(entity.TransactionItem)
+		if err := rows.Scan(&item.Id, &item.TransactionId, &item.PhotoId, &item.PhotoTitle, &item.CreatorId, &item.Amount,
+			&item.DiscountAmount, &item.FeeAmount, &item.NetAmount, &item.CommissionRuleId, &item.CommissionPercentageBps,
+			&item.CommissionFlatFee, &item.CreatedAt); err != nil {
+			return err
+		}
+		transaction.Items = append(transaction.Items, item)
+	}
+
+	return rows.Err()
+}
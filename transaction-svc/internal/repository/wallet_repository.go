@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"be-yourmoments/transaction-svc/internal/entity"
+	"be-yourmoments/transaction-svc/internal/enum"
+	"context"
+	"fmt"
+
+	"github.com/oklog/ulid/v2"
+)
+
+type WalletRepository interface {
+	FindOrCreate(ctx context.Context, db Querier, ownerId string, walletType enum.WalletType) (*entity.Wallet, error)
+	FindByOwner(ctx context.Context, db Querier, ownerId string, walletType enum.WalletType) (*entity.Wallet, error)
+	LockByIds(ctx context.Context, db Querier, walletIds []string) error
+}
+
+type walletRepository struct {
+}
+
+func NewWalletRepository() WalletRepository {
+	return &walletRepository{}
+}
+
+// FindOrCreate does not lock the wallet, the platform wallets are in every sale and must not serialize them.
+func (r *walletRepository) FindOrCreate(ctx context.Context, db Querier, ownerId string, walletType enum.WalletType) (*entity.Wallet, error) {
+	query := `INSERT INTO wallets (id, owner_id, type) VALUES ($1, $2, $3) ON CONFLICT (owner_id, type) DO NOTHING`
+
+	if _, err := db.Exec(ctx, query, ulid.Make().String(), ownerId, walletType); err != nil {
+		return nil, fmt.Errorf("failed to create wallet: %w", err)
+	}
+
+	wallet, err := r.FindByOwner(ctx, db, ownerId, walletType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find wallet: %w", err)
+	}
+
+	return wallet, nil
+}
+
+func (r *walletRepository) FindByOwner(ctx context.Context, db Querier, ownerId string, walletType enum.WalletType) (*entity.Wallet, error) {
+	query := `SELECT id, owner_id, type, created_at FROM wallets WHERE owner_id = $1 AND type = $2`
+
+	wallet := new(entity.Wallet)
+	err := db.QueryRow(ctx, query, ownerId, walletType).Scan(&wallet.Id, &wallet.OwnerId, &wallet.Type, &wallet.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet, nil
+}
+
+// LockByIds locks the wallets in id order, so two journals touching the same wallets can not deadlock.
+func (r *walletRepository) LockByIds(ctx context.Context, db Querier, walletIds []string) error {
+	query := `SELECT id FROM wallets WHERE id = ANY($1) ORDER BY id FOR UPDATE`
+
+	if _, err := db.Exec(ctx, query, walletIds); err != nil {
+		return fmt.Errorf("failed to lock wallets: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"be-yourmoments/transaction-svc/internal/entity"
+	"be-yourmoments/transaction-svc/internal/enum"
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type WithdrawalRepository interface {
+	Create(ctx context.Context, db Querier, withdrawal *entity.Withdrawal) error
+	FindByIdForUpdate(ctx context.Context, db Querier, id string) (*entity.Withdrawal, error)
+	FindByUserId(ctx context.Context, db Querier, userId string) ([]*entity.Withdrawal, error)
+	FindByStatus(ctx context.Context, db Querier, status enum.WithdrawalStatus) ([]*entity.Withdrawal, error)
+	Update(ctx context.Context, db Querier, withdrawal *entity.Withdrawal) error
+}
+
+const withdrawalColumns = `id, user_id, amount, status, bank_name, bank_account_number, bank_account_name,
+	rejection_reason, disbursement_reference, disbursement_claimed_at, approved_at, paid_at, rejected_at,
+	created_at, updated_at`
+
+type withdrawalRepository struct {
+}
+
+func NewWithdrawalRepository() WithdrawalRepository {
+	return &withdrawalRepository{}
+}
+
+func (r *withdrawalRepository) Create(ctx context.Context, db Querier, withdrawal *entity.Withdrawal) error {
+	query := `INSERT INTO withdrawals
+			  (id, user_id, amount, status, bank_name, bank_account_number, bank_account_name, created_at, updated_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	_, err := db.Exec(ctx, query, withdrawal.Id, withdrawal.UserId, withdrawal.Amount, withdrawal.Status,
+		withdrawal.BankName, withdrawal.BankAccountNumber, withdrawal.BankAccountName,
+		withdrawal.CreatedAt, withdrawal.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert withdrawal: %w", err)
+	}
+
+	return nil
+}
+
+func (r *withdrawalRepository) FindByIdForUpdate(ctx context.Context, db Querier, id string) (*entity.Withdrawal, error) {
+	query := `SELECT ` + withdrawalColumns + ` FROM withdrawals WHERE id = $1 FOR UPDATE`
+
+	return scanWithdrawal(db.QueryRow(ctx, query, id))
+}
+
+func (r *withdrawalRepository) FindByUserId(ctx context.Context, db Querier, userId string) ([]*entity.Withdrawal, error) {
+	query := `SELECT ` + withdrawalColumns + ` FROM withdrawals WHERE user_id = $1 ORDER BY created_at DESC`
+
+	return r.findMany(ctx, db, query, userId)
+}
+
+func (r *withdrawalRepository) FindByStatus(ctx context.Context, db Querier, status enum.WithdrawalStatus) ([]*entity.Withdrawal, error) {
+	query := `SELECT ` + withdrawalColumns + ` FROM withdrawals WHERE status = $1 ORDER BY created_at`
+
+	return r.findMany(ctx, db, query, status)
+}
+
+func (r *withdrawalRepository) Update(ctx context.Context, db Querier, withdrawal *entity.Withdrawal) error {
+	query := `UPDATE withdrawals
+			  SET status = $1, rejection_reason = $2, disbursement_reference = $3, disbursement_claimed_at = $4,
+			  approved_at = $5, paid_at = $6, rejected_at = $7, updated_at = $8
+			  WHERE id = $9`
+
+	_, err := db.Exec(ctx, query, withdrawal.Status, withdrawal.RejectionReason, withdrawal.DisbursementReference,
+		withdrawal.DisbursementClaimedAt, withdrawal.ApprovedAt, withdrawal.PaidAt, withdrawal.RejectedAt,
+		withdrawal.UpdatedAt, withdrawal.Id)
+	if err != nil {
+		return fmt.Errorf("failed to update withdrawal: %w", err)
+	}
+
+	return nil
+}
+
+func (r *withdrawalRepository) findMany(ctx context.Context, db Querier, query string, args ...any) ([]*entity.Withdrawal, error) {
+	rows, err := db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query withdrawals: %w", err)
+	}
+	defer rows.Close()
+
+	var withdrawals []*entity.Withdrawal
+	for rows.Next() {
+		withdrawal, err := scanWithdrawal(rows)
+		if err != nil {
+			return nil, err
+		}
+		withdrawals = append(withdrawals, withdrawal)
+	}
+
+	return withdrawals, rows.Err()
+}
+
+func scanWithdrawal(row pgx.Row) (*entity.Withdrawal, error) {
+	withdrawal := new(entity.Withdrawal)
+	err := row.Scan(&withdrawal.Id, &withdrawal.UserId, &withdrawal.Amount, &withdrawal.Status, &withdrawal.BankName,
+		&withdrawal.BankAccountNumber, &withdrawal.BankAccountName, &withdrawal.RejectionReason,
+		&withdrawal.DisbursementReference, &withdrawal.DisbursementClaimedAt, &withdrawal.ApprovedAt,
+		&withdrawal.PaidAt, &withdrawal.RejectedAt, &withdrawal.CreatedAt, &withdrawal.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return withdrawal, nil
+}
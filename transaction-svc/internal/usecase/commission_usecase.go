@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"be-yourmoments/transaction-svc/internal/entity"
+	"be-yourmoments/transaction-svc/internal/model"
+	"be-yourmoments/transaction-svc/internal/model/converter"
+	"be-yourmoments/transaction-svc/internal/repository"
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/oklog/ulid/v2"
+)
+
+type CommissionUsecase interface {
+	ListRules(ctx context.Context) ([]*model.CommissionRuleResponse, error)
+	UpsertRule(ctx context.Context, request *model.UpsertCommissionRuleRequest) (*model.CommissionRuleResponse, error)
+	DeleteCreatorRule(ctx context.Context, creatorId string) error
+}
+
+type commissionUsecase struct {
+	db                 repository.DB
+	commissionRuleRepo repository.CommissionRuleRepository
+}
+
+func NewCommissionUsecase(db repository.DB, commissionRuleRepo repository.CommissionRuleRepository) CommissionUsecase {
+	return &commissionUsecase{
+		db:                 db,
+		commissionRuleRepo: commissionRuleRepo,
+	}
+}
+
+func (u *commissionUsecase) ListRules(ctx context.Context) ([]*model.CommissionRuleResponse, error) {
+	rules, err := u.commissionRuleRepo.FindAll(ctx, u.db)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return converter.CommissionRulesToResponse(rules), nil
+}
+
+// UpsertRule sets the platform default rule when CreatorId is empty, otherwise the creator override.
+func (u *commissionUsecase) UpsertRule(ctx context.Context, request *model.UpsertCommissionRuleRequest) (*model.CommissionRuleResponse, error) {
+	if request.PercentageBps < 0 || request.PercentageBps > 10000 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "percentage_bps must be between 0 and 10000")
+	}
+	if request.FlatFee < 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "flat_fee can not be negative")
+	}
+
+	now := time.Now()
+	rule := &entity.CommissionRule{
+		Id:            ulid.Make().String(),
+		CreatorId:     request.CreatorId,
+		PercentageBps: request.PercentageBps,
+		FlatFee:       request.FlatFee,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	if err := u.commissionRuleRepo.Upsert(ctx, u.db, rule); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return converter.CommissionRuleToResponse(rule), nil
+}
+
+func (u *commissionUsecase) DeleteCreatorRule(ctx context.Context, creatorId string) error {
+	deleted, err := u.commissionRuleRepo.DeleteByCreatorId(ctx, u.db, creatorId)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	if deleted == 0 {
+		return fiber.NewError(fiber.StatusNotFound, "commission rule not found")
+	}
+
+	return nil
+}
+
+// applyCommission splits every item into fee and net using its creator's rule, keeps a copy of
+// the rule on the item so the split can be reproduced later and sums the split onto the transaction.
+// Without any rule the platform takes nothing.
+func applyCommission(ctx context.Context, db repository.Querier, commissionRuleRepo repository.CommissionRuleRepository,
+	transaction *entity.Transaction) error {
+	transaction.FeeAmount = 0
+	transaction.NetAmount = 0
+
+	for _, item := range transaction.Items {
+		rule, err := commissionRuleRepo.FindForCreator(ctx, db, item.CreatorId)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+		if rule == nil {
+			rule = &entity.CommissionRule{}
+		}
+
+		fee := item.Amount*int64(rule.PercentageBps)/10000 + rule.FlatFee
+		if fee > item.Amount {
+			fee = item.Amount
+		}
+
+		item.CommissionRuleId = rule.Id
+		item.CommissionPercentageBps = rule.PercentageBps
+		item.CommissionFlatFee = rule.FlatFee
+		item.FeeAmount = fee
+		item.NetAmount = item.Amount - fee
+
+		transaction.FeeAmount += item.FeeAmount
+		transaction.NetAmount += item.NetAmount
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"be-yourmoments/transaction-svc/internal/entity"
+	"be-yourmoments/transaction-svc/internal/model"
+	"be-yourmoments/transaction-svc/internal/repository"
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5"
+)
+
+// idempotencyKeyTTL is how long a key is remembered, a key reused after that starts a new request.
+const idempotencyKeyTTL = 24 * time.Hour
+
+type IdempotencyUsecase interface {
+	// Begin reserves the key of the user for a new request and returns nil, or returns the stored
+	// response when the user already sent the key with the same fingerprint.
+	Begin(ctx context.Context, userId string, key string, fingerprint string) (*model.IdempotentResponse, error)
+	Complete(ctx context.Context, userId string, key string, response *model.IdempotentResponse) error
+	Release(ctx context.Context, userId string, key string) error
+}
+
+type idempotencyUsecase struct {
+	db                 repository.DB
+	idempotencyKeyRepo repository.IdempotencyKeyRepository
+}
+
+func NewIdempotencyUsecase(db repository.DB, idempotencyKeyRepo repository.IdempotencyKeyRepository) IdempotencyUsecase {
+	return &idempotencyUsecase{
+		db:                 db,
+		idempotencyKeyRepo: idempotencyKeyRepo,
+	}
+}
+
+func (u *idempotencyUsecase) Begin(ctx context.Context, userId string, key string, fingerprint string) (*model.IdempotentResponse, error) {
+	reserved, err := u.idempotencyKeyRepo.Reserve(ctx, u.db, &entity.IdempotencyKey{
+		UserId:      userId,
+		Key:         key,
+		Fingerprint: fingerprint,
+		CreatedAt:   time.Now(),
+	}, idempotencyKeyTTL)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	if reserved {
+		return nil, nil
+	}
+
+	stored, err := u.idempotencyKeyRepo.FindByKey(ctx, u.db, userId, key)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// released by a failed request in between, let the client retry
+			return nil, fiber.NewError(fiber.StatusConflict, "request with this Idempotency-Key failed, retry it")
+		}
+		log.Println(err)
+		return nil, err
+	}
+
+	if stored.Fingerprint != fingerprint {
+		return nil, fiber.NewError(fiber.StatusConflict, "Idempotency-Key was already used with a different request")
+	}
+
+	if stored.CompletedAt == nil {
+		return nil, fiber.NewError(fiber.StatusConflict, "request with this Idempotency-Key is still being processed")
+	}
+
+	return &model.IdempotentResponse{
+		StatusCode:  stored.StatusCode,
+		ContentType: stored.ContentType,
+		Body:        stored.ResponseBody,
+	}, nil
+}
+
+func (u *idempotencyUsecase) Complete(ctx context.Context, userId string, key string, response *model.IdempotentResponse) error {
+	now := time.Now()
+	err := u.idempotencyKeyRepo.Complete(ctx, u.db, &entity.IdempotencyKey{
+		UserId:       userId,
+		Key:          key,
+		StatusCode:   response.StatusCode,
+		ContentType:  response.ContentType,
+		ResponseBody: response.Body,
+		CompletedAt:  &now,
+	})
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+func (u *idempotencyUsecase) Release(ctx context.Context, userId string, key string) error {
+	if err := u.idempotencyKeyRepo.Delete(ctx, u.db, userId, key); err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
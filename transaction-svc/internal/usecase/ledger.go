@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"be-yourmoments/transaction-svc/internal/entity"
+	"be-yourmoments/transaction-svc/internal/enum"
+	"be-yourmoments/transaction-svc/internal/repository"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// ledgerLeg is one side of a journal, Amount is positive for credit and negative for debit.
+type ledgerLeg struct {
+	OwnerId    string
+	WalletType enum.WalletType
+	Amount     int64
+}
+
+// ledgerRef is what a journal was posted for, only one of the ids is set.
+type ledgerRef struct {
+	TransactionId string
+	WithdrawalId  string
+}
+
+// ledger writes balanced journals. It never updates or deletes entries, corrections are new journals.
+type ledger struct {
+	walletRepo repository.WalletRepository
+	ledgerRepo repository.LedgerRepository
+}
+
+func newLedger(walletRepo repository.WalletRepository, ledgerRepo repository.LedgerRepository) *ledger {
+	return &ledger{
+		walletRepo: walletRepo,
+		ledgerRepo: ledgerRepo,
+	}
+}
+
+// post must run inside the same db transaction as the change it records.
+func (l *ledger) post(ctx context.Context, db repository.Querier, ref ledgerRef, entryType enum.LedgerEntryType,
+	description string, legs ...ledgerLeg) error {
+	var sum int64
+	for _, leg := range legs {
+		sum += leg.Amount
+	}
+	if sum != 0 {
+		return fmt.Errorf("unbalanced %s journal for %+v: legs sum to %d", entryType, ref, sum)
+	}
+
+	// one entry per wallet, an order with several photos of the same creator credits them once
+	merged := make([]ledgerLeg, 0, len(legs))
+	for _, leg := range legs {
+		found := false
+		for i := range merged {
+			if merged[i].OwnerId == leg.OwnerId && merged[i].WalletType == leg.WalletType {
+				merged[i].Amount += leg.Amount
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged = append(merged, leg)
+		}
+	}
+
+	journalId := ulid.Make().String()
+	now := time.Now()
+
+	entries := make([]*entity.LedgerEntry, 0, len(merged))
+	lockIds := make([]string, 0, len(merged))
+	for _, leg := range merged {
+		if leg.Amount == 0 {
+			continue
+		}
+
+		wallet, err := l.walletRepo.FindOrCreate(ctx, db, leg.OwnerId, leg.WalletType)
+		if err != nil {
+			return err
+		}
+
+		// the platform wallets are in every journal, their balance is never checked so they are not locked
+		if leg.OwnerId != enum.PlatformWalletOwnerId {
+			lockIds = append(lockIds, wallet.Id)
+		}
+
+		entries = append(entries, &entity.LedgerEntry{
+			Id:            ulid.Make().String(),
+			JournalId:     journalId,
+			WalletId:      wallet.Id,
+			TransactionId: ref.TransactionId,
+			WithdrawalId:  ref.WithdrawalId,
+			Type:          entryType,
+			Amount:        leg.Amount,
+			Description:   description,
+			CreatedAt:     now,
+		})
+	}
+
+	// creator wallets are locked so a withdrawal can not check the balance while a journal changes it
+	if len(lockIds) > 0 {
+		sort.Strings(lockIds)
+		if err := l.walletRepo.LockByIds(ctx, db, lockIds); err != nil {
+			return err
+		}
+	}
+
+	return l.ledgerRepo.CreateEntries(ctx, db, entries)
+}
+
+// recordSale debits the platform clearing account for the gross amount, credits every creator
+// with the net amount of their photos and the platform revenue account with the fees.
+func (l *ledger) recordSale(ctx context.Context, db repository.Querier, transaction *entity.Transaction) error {
+	return l.post(ctx, db, ledgerRef{TransactionId: transaction.Id}, enum.LedgerEntryTypeSale,
+		"photo sale "+transaction.Id, saleLegs(transaction, 1)...)
+}
+
+// reverseSale posts the exact opposite of recordSale for a refunded transaction. The creator
+// balance may go negative when the earnings were already withdrawn.
+func (l *ledger) reverseSale(ctx context.Context, db repository.Querier, transaction *entity.Transaction) error {
+	return l.post(ctx, db, ledgerRef{TransactionId: transaction.Id}, enum.LedgerEntryTypeSaleReversal,
+		"refund photo sale "+transaction.Id, saleLegs(transaction, -1)...)
+}
+
+func saleLegs(transaction *entity.Transaction, sign int64) []ledgerLeg {
+	legs := make([]ledgerLeg, 0, len(transaction.Items)+2)
+	for _, item := range transaction.Items {
+		legs = append(legs, ledgerLeg{OwnerId: item.CreatorId, WalletType: enum.WalletTypeCreator, Amount: sign * item.NetAmount})
+	}
+
+	return append(legs,
+		ledgerLeg{OwnerId: enum.PlatformWalletOwnerId, WalletType: enum.WalletTypePlatformRevenue, Amount: sign * transaction.FeeAmount},
+		ledgerLeg{OwnerId: enum.PlatformWalletOwnerId, WalletType: enum.WalletTypePlatformClearing, Amount: -sign * transaction.Amount},
+	)
+}
+
+// holdWithdrawal moves the requested amount out of the creator's available balance.
+func (l *ledger) holdWithdrawal(ctx context.Context, db repository.Querier, withdrawal *entity.Withdrawal) error {
+	return l.post(ctx, db, ledgerRef{WithdrawalId: withdrawal.Id}, enum.LedgerEntryTypeWithdrawalHold, "withdrawal requested",
+		ledgerLeg{OwnerId: withdrawal.UserId, WalletType: enum.WalletTypeCreator, Amount: -withdrawal.Amount},
+		ledgerLeg{OwnerId: withdrawal.UserId, WalletType: enum.WalletTypeCreatorHold, Amount: withdrawal.Amount},
+	)
+}
+
+// releaseWithdrawal gives held funds back to the creator when a withdrawal is rejected.
+func (l *ledger) releaseWithdrawal(ctx context.Context, db repository.Querier, withdrawal *entity.Withdrawal) error {
+	return l.post(ctx, db, ledgerRef{WithdrawalId: withdrawal.Id}, enum.LedgerEntryTypeWithdrawalRelease, "withdrawal rejected",
+		ledgerLeg{OwnerId: withdrawal.UserId, WalletType: enum.WalletTypeCreatorHold, Amount: -withdrawal.Amount},
+		ledgerLeg{OwnerId: withdrawal.UserId, WalletType: enum.WalletTypeCreator, Amount: withdrawal.Amount},
+	)
+}
+
+// payWithdrawal settles held funds against the clearing account once the money has left the platform.
+func (l *ledger) payWithdrawal(ctx context.Context, db repository.Querier, withdrawal *entity.Withdrawal) error {
+	return l.post(ctx, db, ledgerRef{WithdrawalId: withdrawal.Id}, enum.LedgerEntryTypeWithdrawalPayout, "withdrawal paid",
+		ledgerLeg{OwnerId: withdrawal.UserId, WalletType: enum.WalletTypeCreatorHold, Amount: -withdrawal.Amount},
+		ledgerLeg{OwnerId: enum.PlatformWalletOwnerId, WalletType: enum.WalletTypePlatformClearing, Amount: withdrawal.Amount},
+	)
+}
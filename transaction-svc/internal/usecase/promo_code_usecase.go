@@ -0,0 +1,249 @@
+package usecase
+
+import (
+	"be-yourmoments/transaction-svc/internal/entity"
+	"be-yourmoments/transaction-svc/internal/enum"
+	"be-yourmoments/transaction-svc/internal/model"
+	"be-yourmoments/transaction-svc/internal/model/converter"
+	"be-yourmoments/transaction-svc/internal/repository"
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/oklog/ulid/v2"
+)
+
+type PromoCodeUsecase interface {
+	CreatePromoCode(ctx context.Context, request *model.PromoCodeRequest) (*model.PromoCodeResponse, error)
+	ListPromoCodes(ctx context.Context) ([]*model.PromoCodeResponse, error)
+	GetPromoCode(ctx context.Context, id string) (*model.PromoCodeResponse, error)
+	UpdatePromoCode(ctx context.Context, request *model.PromoCodeRequest) (*model.PromoCodeResponse, error)
+	DeactivatePromoCode(ctx context.Context, id string) error
+}
+
+type promoCodeUsecase struct {
+	db            repository.DB
+	promoCodeRepo repository.PromoCodeRepository
+}
+
+func NewPromoCodeUsecase(db repository.DB, promoCodeRepo repository.PromoCodeRepository) PromoCodeUsecase {
+	return &promoCodeUsecase{
+		db:            db,
+		promoCodeRepo: promoCodeRepo,
+	}
+}
+
+func (u *promoCodeUsecase) CreatePromoCode(ctx context.Context, request *model.PromoCodeRequest) (*model.PromoCodeResponse, error) {
+	code := normalizePromoCode(request.Code)
+	if code == "" {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "code is required")
+	}
+
+	now := time.Now()
+	promoCode := &entity.PromoCode{
+		Id:        ulid.Make().String(),
+		Code:      code,
+		IsActive:  true,
+		CreatedAt: now,
+	}
+	if err := setPromoCodeFields(promoCode, request); err != nil {
+		return nil, err
+	}
+
+	if err := u.promoCodeRepo.Create(ctx, u.db, promoCode); err != nil {
+		if errors.Is(err, repository.ErrPromoCodeExists) {
+			return nil, fiber.NewError(fiber.StatusConflict, "promo code "+code+" already exists")
+		}
+		log.Println(err)
+		return nil, err
+	}
+
+	return converter.PromoCodeToResponse(promoCode), nil
+}
+
+func (u *promoCodeUsecase) ListPromoCodes(ctx context.Context) ([]*model.PromoCodeResponse, error) {
+	promoCodes, err := u.promoCodeRepo.FindAll(ctx, u.db)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return converter.PromoCodesToResponse(promoCodes), nil
+}
+
+func (u *promoCodeUsecase) GetPromoCode(ctx context.Context, id string) (*model.PromoCodeResponse, error) {
+	promoCode, err := u.findPromoCode(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.PromoCodeToResponse(promoCode), nil
+}
+
+// UpdatePromoCode replaces every setting but the code itself. Lowering a limit below the
+// redemptions already made only stops new redemptions.
+func (u *promoCodeUsecase) UpdatePromoCode(ctx context.Context, request *model.PromoCodeRequest) (*model.PromoCodeResponse, error) {
+	promoCode, err := u.findPromoCode(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := setPromoCodeFields(promoCode, request); err != nil {
+		return nil, err
+	}
+
+	if err := u.promoCodeRepo.Update(ctx, u.db, promoCode); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return converter.PromoCodeToResponse(promoCode), nil
+}
+
+// DeactivatePromoCode stops a promo code from being redeemed, it is kept for its redemption history.
+func (u *promoCodeUsecase) DeactivatePromoCode(ctx context.Context, id string) error {
+	promoCode, err := u.findPromoCode(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	promoCode.IsActive = false
+	promoCode.UpdatedAt = time.Now()
+	if err := u.promoCodeRepo.Update(ctx, u.db, promoCode); err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+func (u *promoCodeUsecase) findPromoCode(ctx context.Context, id string) (*entity.PromoCode, error) {
+	promoCode, err := u.promoCodeRepo.FindById(ctx, u.db, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fiber.NewError(fiber.StatusNotFound, "promo code not found")
+		}
+		log.Println(err)
+		return nil, err
+	}
+
+	return promoCode, nil
+}
+
+func setPromoCodeFields(promoCode *entity.PromoCode, request *model.PromoCodeRequest) error {
+	switch request.Type {
+	case enum.PromoTypePercentage:
+		if request.Value <= 0 || request.Value > 10000 {
+			return fiber.NewError(fiber.StatusBadRequest, "value must be between 1 and 10000 basis points")
+		}
+	case enum.PromoTypeFixed:
+		if request.Value <= 0 {
+			return fiber.NewError(fiber.StatusBadRequest, "value must be positive")
+		}
+	default:
+		return fiber.NewError(fiber.StatusBadRequest, "type must be PERCENTAGE or FIXED")
+	}
+	if request.MaxRedemptions < 0 || request.MaxRedemptionsPerUser < 0 {
+		return fiber.NewError(fiber.StatusBadRequest, "redemption limits can not be negative")
+	}
+	if request.StartsAt != nil && request.ExpiresAt != nil && !request.ExpiresAt.After(*request.StartsAt) {
+		return fiber.NewError(fiber.StatusBadRequest, "expires_at must be after starts_at")
+	}
+
+	promoCode.Type = request.Type
+	promoCode.Value = request.Value
+	promoCode.MaxRedemptions = request.MaxRedemptions
+	promoCode.MaxRedemptionsPerUser = request.MaxRedemptionsPerUser
+	promoCode.CreatorId = request.CreatorId
+	promoCode.StartsAt = request.StartsAt
+	promoCode.ExpiresAt = request.ExpiresAt
+	if request.IsActive != nil {
+		promoCode.IsActive = *request.IsActive
+	}
+	promoCode.UpdatedAt = time.Now()
+
+	return nil
+}
+
+func normalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// applyPromoCode discounts the eligible items of a new order before anything is charged. It locks the
+// promo code, so it must run in the db transaction that also stores the order and its redemption.
+// A FIXED discount is split over the eligible items by price, the last one takes the rounding remainder.
+func applyPromoCode(ctx context.Context, tx pgx.Tx, promoCodeRepo repository.PromoCodeRepository,
+	transaction *entity.Transaction, code string) error {
+	promoCode, err := promoCodeRepo.FindByCodeForUpdate(ctx, tx, normalizePromoCode(code))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fiber.NewError(fiber.StatusNotFound, "promo code not found")
+		}
+		return err
+	}
+
+	now := time.Now()
+	if !promoCode.IsActive || (promoCode.StartsAt != nil && now.Before(*promoCode.StartsAt)) {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, "promo code is not active")
+	}
+	if promoCode.ExpiresAt != nil && !now.Before(*promoCode.ExpiresAt) {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, "promo code has expired")
+	}
+	if promoCode.MaxRedemptions > 0 && promoCode.RedemptionCount >= promoCode.MaxRedemptions {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, "promo code has been fully redeemed")
+	}
+
+	if promoCode.MaxRedemptionsPerUser > 0 {
+		used, err := promoCodeRepo.CountUserRedemptions(ctx, tx, promoCode.Id, transaction.UserId)
+		if err != nil {
+			return err
+		}
+		if used >= promoCode.MaxRedemptionsPerUser {
+			return fiber.NewError(fiber.StatusUnprocessableEntity, "promo code has already been used")
+		}
+	}
+
+	var eligible []*entity.TransactionItem
+	var subtotal int64
+	for _, item := range transaction.Items {
+		if promoCode.CreatorId == "" || item.CreatorId == promoCode.CreatorId {
+			eligible = append(eligible, item)
+			subtotal += item.Amount
+		}
+	}
+	if len(eligible) == 0 || subtotal == 0 {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, "promo code does not apply to these photos")
+	}
+
+	var discount int64
+	switch promoCode.Type {
+	case enum.PromoTypePercentage:
+		for _, item := range eligible {
+			item.DiscountAmount = item.Amount * promoCode.Value / 10000
+			discount += item.DiscountAmount
+		}
+	case enum.PromoTypeFixed:
+		discount = min(promoCode.Value, subtotal)
+		remaining := discount
+		for i, item := range eligible {
+			item.DiscountAmount = discount * item.Amount / subtotal
+			if i == len(eligible)-1 {
+				item.DiscountAmount = remaining
+			}
+			remaining -= item.DiscountAmount
+		}
+	}
+
+	for _, item := range eligible {
+		item.Amount -= item.DiscountAmount
+	}
+	transaction.Amount -= discount
+	transaction.DiscountAmount = discount
+	transaction.PromoCodeId = promoCode.Id
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"be-yourmoments/transaction-svc/internal/entity"
+	"be-yourmoments/transaction-svc/internal/enum"
+	"be-yourmoments/transaction-svc/internal/model"
+	"be-yourmoments/transaction-svc/internal/repository"
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5"
+)
+
+type ReconciliationUsecase interface {
+	Reconcile(ctx context.Context, request *model.ReconciliationRequest) (*model.ReconciliationResponse, error)
+}
+
+type reconciliationUsecase struct {
+	db                 repository.DB
+	transactionRepo    repository.TransactionRepository
+	transactionUsecase TransactionUsecase
+}
+
+func NewReconciliationUsecase(db repository.DB, transactionRepo repository.TransactionRepository,
+	transactionUsecase TransactionUsecase) ReconciliationUsecase {
+	return &reconciliationUsecase{
+		db:                 db,
+		transactionRepo:    transactionRepo,
+		transactionUsecase: transactionUsecase,
+	}
+}
+
+// Reconcile only reports what disagrees, it never changes a transaction the gateway knows about.
+// The only write is expiring stuck pending transactions when the request asks for it.
+func (u *reconciliationUsecase) Reconcile(ctx context.Context, request *model.ReconciliationRequest) (*model.ReconciliationResponse, error) {
+	if !request.To.After(request.From) {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "to must be after from")
+	}
+	if request.PendingTimeout <= 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "pending timeout must be positive")
+	}
+
+	response := &model.ReconciliationResponse{
+		From:        request.From,
+		To:          request.To,
+		RecordCount: len(request.Records),
+		Issues:      []*model.ReconciliationIssue{},
+		ExpiredIds:  []string{},
+	}
+
+	reported := make(map[string]*model.SettlementRecord, len(request.Records))
+	for _, record := range request.Records {
+		reported[record.OrderId] = record
+
+		transaction, err := u.transactionRepo.FindById(ctx, u.db, record.OrderId)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			log.Println(err)
+			return nil, err
+		}
+
+		issue := compareSettlement(transaction, record)
+		if issue == nil {
+			response.MatchedCount++
+			continue
+		}
+		response.Issues = append(response.Issues, issue)
+	}
+
+	paid, err := u.transactionRepo.FindPaidBetween(ctx, u.db, request.From, request.To)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	for _, transaction := range paid {
+		if _, ok := reported[transaction.Id]; !ok {
+			response.Issues = append(response.Issues, &model.ReconciliationIssue{
+				TransactionId: transaction.Id,
+				Type:          enum.ReconciliationIssueMissingGateway,
+				LocalStatus:   transaction.Status,
+				LocalAmount:   transaction.Amount,
+			})
+		}
+	}
+
+	pending, err := u.transactionRepo.FindPendingBefore(ctx, u.db, time.Now().Add(-request.PendingTimeout), 1000)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	for _, transaction := range pending {
+		record := reported[transaction.Id]
+		if record != nil {
+			// already flagged above when the gateway disagrees
+			continue
+		}
+
+		response.Issues = append(response.Issues, &model.ReconciliationIssue{
+			TransactionId: transaction.Id,
+			Type:          enum.ReconciliationIssueStuckPending,
+			LocalStatus:   transaction.Status,
+			LocalAmount:   transaction.Amount,
+		})
+
+		if request.ExpirePending {
+			expired, err := u.transactionUsecase.ExpirePending(ctx, transaction.Id)
+			if err != nil {
+				log.Printf("failed to expire transaction %s: %v", transaction.Id, err)
+				continue
+			}
+			if expired {
+				response.ExpiredIds = append(response.ExpiredIds, transaction.Id)
+			}
+		}
+	}
+
+	log.Printf("reconciled %d settlement records from %s to %s: %d matched, %d issues, %d expired",
+		response.RecordCount, request.From.Format(time.DateOnly), request.To.Format(time.DateOnly),
+		response.MatchedCount, len(response.Issues), len(response.ExpiredIds))
+
+	return response, nil
+}
+
+// compareSettlement returns nil when the local transaction agrees with the gateway record.
+func compareSettlement(transaction *entity.Transaction, record *model.SettlementRecord) *model.ReconciliationIssue {
+	issue := &model.ReconciliationIssue{
+		TransactionId: record.OrderId,
+		GatewayStatus: record.Status,
+		GatewayAmount: record.GrossAmount,
+	}
+
+	if transaction == nil {
+		issue.Type = enum.ReconciliationIssueMissingLocal
+		return issue
+	}
+
+	issue.LocalStatus = transaction.Status
+	issue.LocalAmount = transaction.Amount
+
+	if transaction.Amount != record.GrossAmount {
+		issue.Type = enum.ReconciliationIssueAmountMismatch
+		return issue
+	}
+
+	if status, ok := record.Status.TransactionStatus(); !ok || status != transaction.Status {
+		issue.Type = enum.ReconciliationIssueStatusMismatch
+		return issue
+	}
+
+	return nil
+}
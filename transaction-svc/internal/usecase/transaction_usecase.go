@@ -2,24 +2,623 @@ package usecase
 
 import (
 	"be-yourmoments/transaction-svc/internal/adapter"
+	"be-yourmoments/transaction-svc/internal/entity"
+	"be-yourmoments/transaction-svc/internal/enum"
+	"be-yourmoments/transaction-svc/internal/helper/document"
+	"be-yourmoments/transaction-svc/internal/model"
+	"be-yourmoments/transaction-svc/internal/model/converter"
 	"be-yourmoments/transaction-svc/internal/repository"
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/oklog/ulid/v2"
 )
 
 type TransactionUsecase interface {
+	BuyPhoto(ctx context.Context, request *model.BuyPhotoRequest) (*model.TransactionResponse, error)
+	Checkout(ctx context.Context, request *model.CheckoutRequest) (*model.TransactionResponse, error)
+	HandlePaymentNotification(ctx context.Context, notification *model.PaymentNotification) error
+	Refund(ctx context.Context, request *model.RefundRequest) (*model.TransactionResponse, error)
+	GetHistory(ctx context.Context, request *model.TransactionHistoryRequest) ([]*model.TransactionResponse, *model.PageMetadata, error)
+	GetReceipt(ctx context.Context, userId, transactionId string) (*model.ReceiptResponse, error)
+	GetReceiptPdf(ctx context.Context, userId, transactionId string) ([]byte, error)
+	RetryPhotoSync(ctx context.Context) error
+	ExpirePending(ctx context.Context, transactionId string) (bool, error)
+	ExpireStalePending(ctx context.Context, timeout time.Duration) ([]string, error)
 }
 
 type transactionUsecase struct {
-	transactionRepo repository.TransactionRepository
-	photoAdapter    adapter.PhotoAdapter
+	db                  repository.DB
+	transactionRepo     repository.TransactionRepository
+	paymentCallbackRepo repository.PaymentCallbackRepository
+	commissionRuleRepo  repository.CommissionRuleRepository
+	promoCodeRepo       repository.PromoCodeRepository
+	ledger              *ledger
+	photoAdapter        adapter.PhotoAdapter
+	paymentAdapter      adapter.PaymentAdapter
 }
 
-func NewTransactionUsecase(transactionRepo repository.TransactionRepository, photoAdapter adapter.PhotoAdapter) TransactionUsecase {
+func NewTransactionUsecase(db repository.DB, transactionRepo repository.TransactionRepository,
+	paymentCallbackRepo repository.PaymentCallbackRepository, commissionRuleRepo repository.CommissionRuleRepository,
+	promoCodeRepo repository.PromoCodeRepository, walletRepo repository.WalletRepository, ledgerRepo repository.LedgerRepository, photoAdapter adapter.PhotoAdapter,
+	paymentAdapter adapter.PaymentAdapter) TransactionUsecase {
 	return &transactionUsecase{
-		transactionRepo: transactionRepo,
-		photoAdapter:    photoAdapter,
+		db:                  db,
+		transactionRepo:     transactionRepo,
+		paymentCallbackRepo: paymentCallbackRepo,
+		commissionRuleRepo:  commissionRuleRepo,
+		promoCodeRepo:       promoCodeRepo,
+		ledger:              newLedger(walletRepo, ledgerRepo),
+		photoAdapter:        photoAdapter,
+		paymentAdapter:      paymentAdapter,
+	}
+}
+
+func (u *transactionUsecase) BuyPhoto(ctx context.Context, request *model.BuyPhotoRequest) (*model.TransactionResponse, error) {
+	photo, err := u.photoAdapter.GetPhotoPrice(ctx, request.PhotoId)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return u.createOrder(ctx, request.UserId, request.PromoCode, []*model.PhotoPrice{photo})
+}
+
+// Checkout buys every photo in the user's cart as one order with a single payment.
+func (u *transactionUsecase) Checkout(ctx context.Context, request *model.CheckoutRequest) (*model.TransactionResponse, error) {
+	photos, err := u.photoAdapter.GetCart(ctx, request.UserId)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	if len(photos) == 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "cart is empty")
+	}
+
+	return u.createOrder(ctx, request.UserId, request.PromoCode, photos)
+}
+
+// createOrder checks and reserves every photo before anything is charged, so a photo that was
+// already bought or is being paid by someone else fails the whole order instead of charging for it.
+// An order that a promo code makes free is settled right away without going through the payment gateway.
+func (u *transactionUsecase) createOrder(ctx context.Context, userId, promoCode string,
+	photos []*model.PhotoPrice) (*model.TransactionResponse, error) {
+	now := time.Now()
+	transaction := &entity.Transaction{
+		Id:        ulid.Make().String(),
+		UserId:    userId,
+		Status:    enum.TransactionStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	for _, photo := range photos {
+		if photo.OwnedByUserId != "" {
+			return nil, fiber.NewError(fiber.StatusConflict, "photo "+photo.Id+" has already been bought")
+		}
+
+		if photo.CreatorId == userId {
+			return nil, fiber.NewError(fiber.StatusBadRequest, "creator can not buy their own photo "+photo.Id)
+		}
+
+		transaction.Amount += photo.Price
+		transaction.Items = append(transaction.Items, &entity.TransactionItem{
+			Id:            ulid.Make().String(),
+			TransactionId: transaction.Id,
+			PhotoId:       photo.Id,
+			PhotoTitle:    photo.Title,
+			CreatorId:     photo.CreatorId,
+			Amount:        photo.Price,
+			CreatedAt:     now,
+		})
+	}
+
+	if err := u.photoAdapter.ReservePhotos(ctx, photoIdsOf(transaction), userId); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	if err := u.createTransaction(ctx, transaction, promoCode); err != nil {
+		log.Println(err)
+		u.releasePhotos(ctx, transaction)
+		return nil, err
+	}
+
+	payment := &model.PaymentResponse{Status: enum.TransactionStatusSettled}
+	var paymentMethod entity.PaymentMethod
+	if transaction.Amount > 0 {
+		var err error
+		payment, err = u.paymentAdapter.CreatePayment(ctx, transaction)
+		if err != nil {
+			log.Printf("payment for transaction %s failed: %v", transaction.Id, err)
+			denied, err := u.updatePayment(ctx, transaction.Id, "", paymentMethod, enum.TransactionStatusDenied, "", nil)
+			if err != nil {
+				log.Println(err)
+			} else {
+				u.syncPhotoOwner(ctx, denied)
+			}
+			return nil, fiber.NewError(fiber.StatusPaymentRequired, "payment failed")
+		}
+	} else {
+		paymentMethod.Type = enum.PaymentTypeFree
+	}
+
+	// a callback may already have moved the transaction, updatePayment never goes backwards
+	transaction, err := u.updatePayment(ctx, transaction.Id, payment.Token, paymentMethod, payment.Status, "", payment.RawResponse)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	u.syncPhotoOwner(ctx, transaction)
+
+	return converter.TransactionToResponse(transaction), nil
+}
+
+func (u *transactionUsecase) createTransaction(ctx context.Context, transaction *entity.Transaction, promoCode string) error {
+	tx, err := u.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if promoCode != "" {
+		if err := applyPromoCode(ctx, tx, u.promoCodeRepo, transaction, promoCode); err != nil {
+			return err
+		}
+	}
+
+	if err := u.transactionRepo.Create(ctx, tx, transaction); err != nil {
+		return err
+	}
+
+	if transaction.PromoCodeId != "" {
+		redemption := &entity.PromoRedemption{
+			Id:             ulid.Make().String(),
+			PromoCodeId:    transaction.PromoCodeId,
+			UserId:         transaction.UserId,
+			TransactionId:  transaction.Id,
+			DiscountAmount: transaction.DiscountAmount,
+			CreatedAt:      transaction.CreatedAt,
+		}
+		if err := u.promoCodeRepo.CreateRedemption(ctx, tx, redemption); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (u *transactionUsecase) HandlePaymentNotification(ctx context.Context, notification *model.PaymentNotification) error {
+	if !u.paymentAdapter.VerifySignature(notification) {
+		return fiber.NewError(fiber.StatusUnauthorized, "invalid signature")
+	}
+
+	status, ok := notification.TransactionStatus.TransactionStatus()
+	if ok && notification.TransactionStatus == enum.MidtransPaymentStatusCapture && notification.FraudStatus == "challenge" {
+		status = enum.TransactionStatusPending
+	}
+
+	tx, err := u.db.Begin(ctx)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	transaction, err := u.transactionRepo.FindByIdForUpdate(ctx, tx, notification.OrderId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fiber.NewError(fiber.StatusNotFound, "transaction not found")
+		}
+		log.Println(err)
+		return err
+	}
+
+	grossAmount, err := strconv.ParseFloat(notification.GrossAmount, 64)
+	if err != nil || int64(grossAmount) != transaction.Amount {
+		return fiber.NewError(fiber.StatusBadRequest, "gross_amount does not match transaction amount")
+	}
+
+	// a partial refund can only follow a settlement, on any other status the callback is only recorded
+	// and the settlement reconciliation reports the mismatch
+	if notification.TransactionStatus == enum.MidtransPaymentStatusPartialRefund &&
+		transaction.Status != enum.TransactionStatusSettled {
+		ok = false
+	}
+
+	applied := ok && applyTransition(transaction, status, notification.TransactionStatus, notification.Raw)
+	if applied {
+		if notification.PaymentType != "" {
+			transaction.PaymentMethod = converter.PaymentNotificationToPaymentMethod(notification)
+		}
+		if err := u.saveTransition(ctx, tx, transaction); err != nil {
+			log.Println(err)
+			return err
+		}
+	} else {
+		log.Printf("payment callback %s for transaction %s ignored, current status %s",
+			notification.TransactionStatus, transaction.Id, transaction.Status)
+	}
+
+	callback := &entity.PaymentCallback{
+		Id:             ulid.Make().String(),
+		TransactionId:  transaction.Id,
+		ExternalStatus: notification.TransactionStatus,
+		Payload:        notification.Raw,
+		Applied:        applied,
+		CreatedAt:      time.Now(),
+	}
+	if err := u.paymentCallbackRepo.Create(ctx, tx, callback); err != nil {
+		log.Println(err)
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Println(err)
+		return err
+	}
+
+	if applied {
+		u.syncPhotoOwner(ctx, transaction)
+	}
+
+	return nil
+}
+
+// refundClaimTimeout is how long a refund owns the gateway call. A claim older than this is left by
+// a crashed refund and may be taken over, the gateway refund key keeps it refunded once.
+const refundClaimTimeout = 5 * time.Minute
+
+// Refund refunds a settled transaction at the gateway, reverses its ledger entries and takes the
+// photo back from the buyer. The transaction is claimed before the gateway is called so concurrent
+// refunds never call it twice. Every step can be retried: refunding a REFUNDED transaction only
+// retries the photo-svc update, and RetryPhotoSync picks it up in the background as well.
+func (u *transactionUsecase) Refund(ctx context.Context, request *model.RefundRequest) (*model.TransactionResponse, error) {
+	transaction, err := u.transactionRepo.FindById(ctx, u.db, request.TransactionId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fiber.NewError(fiber.StatusNotFound, "transaction not found")
+		}
+		log.Println(err)
+		return nil, err
+	}
+
+	if transaction.Status != enum.TransactionStatusRefunded {
+		if transaction.Status != enum.TransactionStatusSettled {
+			return nil, fiber.NewError(fiber.StatusConflict, "only settled transactions can be refunded")
+		}
+
+		now := time.Now()
+		claimed, err := u.transactionRepo.ClaimRefund(ctx, u.db, transaction.Id, now, now.Add(-refundClaimTimeout))
+		if err != nil {
+			log.Println(err)
+			return nil, err
+		}
+		if !claimed {
+			return nil, fiber.NewError(fiber.StatusConflict, "transaction is already being refunded")
+		}
+
+		rawResponse, err := u.paymentAdapter.Refund(ctx, transaction, request.Reason)
+		if err != nil {
+			log.Printf("refund of transaction %s failed at the gateway: %v", transaction.Id, err)
+			if err := u.transactionRepo.ReleaseRefundClaim(ctx, u.db, transaction.Id); err != nil {
+				log.Println(err)
+			}
+			return nil, fiber.NewError(fiber.StatusBadGateway, "refund failed at the payment gateway")
+		}
+
+		transaction, err = u.updatePayment(ctx, transaction.Id, "", entity.PaymentMethod{}, enum.TransactionStatusRefunded,
+			enum.MidtransPaymentStatusRefund, rawResponse)
+		if err != nil {
+			log.Println(err)
+			return nil, err
+		}
+	}
+
+	if !transaction.PhotoSynced {
+		u.syncPhotoOwner(ctx, transaction)
+	}
+
+	return converter.TransactionToResponse(transaction), nil
+}
+
+// GetHistory returns a buyer's transactions newest first.
+func (u *transactionUsecase) GetHistory(ctx context.Context, request *model.TransactionHistoryRequest) ([]*model.TransactionResponse, *model.PageMetadata, error) {
+	switch request.Status {
+	case "", enum.TransactionStatusPending, enum.TransactionStatusSettled, enum.TransactionStatusExpired,
+		enum.TransactionStatusDenied, enum.TransactionStatusRefunded:
+	default:
+		return nil, nil, fiber.NewError(fiber.StatusBadRequest, "unknown status "+string(request.Status))
+	}
+
+	filter := &repository.TransactionFilter{
+		UserId: request.UserId,
+		Status: request.Status,
+		From:   request.From,
+		To:     request.To,
+	}
+
+	total, err := u.transactionRepo.CountByFilter(ctx, u.db, filter)
+	if err != nil {
+		log.Println(err)
+		return nil, nil, err
+	}
+
+	transactions, err := u.transactionRepo.FindByFilter(ctx, u.db, filter, request.Size, (request.Page-1)*request.Size)
+	if err != nil {
+		log.Println(err)
+		return nil, nil, err
+	}
+
+	return converter.TransactionsToResponse(transactions), model.NewPageMetadata(request.Page, request.Size, total), nil
+}
+
+// GetReceipt is only available once the transaction was paid, other users' transactions are reported as not found.
+func (u *transactionUsecase) GetReceipt(ctx context.Context, userId, transactionId string) (*model.ReceiptResponse, error) {
+	transaction, err := u.transactionRepo.FindById(ctx, u.db, transactionId)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		log.Println(err)
+		return nil, err
+	}
+	if transaction == nil || transaction.UserId != userId {
+		return nil, fiber.NewError(fiber.StatusNotFound, "transaction not found")
+	}
+
+	if transaction.PaidAt == nil {
+		return nil, fiber.NewError(fiber.StatusConflict, "receipt is only available for paid transactions")
+	}
+
+	var promoCode string
+	if transaction.PromoCodeId != "" {
+		promo, err := u.promoCodeRepo.FindById(ctx, u.db, transaction.PromoCodeId)
+		if err != nil {
+			log.Println(err)
+			return nil, err
+		}
+		promoCode = promo.Code
+	}
+
+	return converter.TransactionToReceipt(transaction, promoCode), nil
+}
+
+func (u *transactionUsecase) GetReceiptPdf(ctx context.Context, userId, transactionId string) ([]byte, error) {
+	receipt, err := u.GetReceipt(ctx, userId, transactionId)
+	if err != nil {
+		return nil, err
+	}
+
+	pdf, err := document.ReceiptPdf(receipt)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return pdf, nil
+}
+
+// RetryPhotoSync pushes ownership changes that photo-svc missed, it is safe to run at any time.
+func (u *transactionUsecase) RetryPhotoSync(ctx context.Context) error {
+	transactions, err := u.transactionRepo.FindPhotoUnsynced(ctx, u.db, 50)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	for _, transaction := range transactions {
+		u.syncPhotoOwner(ctx, transaction)
+	}
+
+	return nil
+}
+
+// ExpirePending expires a transaction that is still pending, it reports false when a payment
+// moved it on in the meantime.
+func (u *transactionUsecase) ExpirePending(ctx context.Context, transactionId string) (bool, error) {
+	transaction, err := u.updatePayment(ctx, transactionId, "", entity.PaymentMethod{}, enum.TransactionStatusExpired,
+		enum.MidtransPaymentStatusExpire, nil)
+	if err != nil {
+		return false, err
+	}
+
+	if transaction.Status != enum.TransactionStatusExpired {
+		return false, nil
 	}
+
+	u.syncPhotoOwner(ctx, transaction)
+	return true, nil
+}
+
+// ExpireStalePending expires transactions pending for longer than timeout. The timeout must be
+// longer than the gateway's own payment expiry, a payment that lands after this is ignored.
+func (u *transactionUsecase) ExpireStalePending(ctx context.Context, timeout time.Duration) ([]string, error) {
+	transactions, err := u.transactionRepo.FindPendingBefore(ctx, u.db, time.Now().Add(-timeout), 100)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	var expiredIds []string
+	for _, transaction := range transactions {
+		expired, err := u.ExpirePending(ctx, transaction.Id)
+		if err != nil {
+			log.Printf("failed to expire transaction %s: %v", transaction.Id, err)
+			continue
+		}
+		if expired {
+			expiredIds = append(expiredIds, transaction.Id)
+		}
+	}
+
+	return expiredIds, nil
 }
 
-func (u *transactionUsecase) BuyPhoto() {
+// syncPhotoOwner gives a settled photo to the buyer or takes a refunded one back, an order that
+// was never paid releases its photo reservations. Failures are only logged, the transaction stays
+// unsynced and RetryPhotoSync tries again later.
+func (u *transactionUsecase) syncPhotoOwner(ctx context.Context, transaction *entity.Transaction) {
+	photoIds := photoIdsOf(transaction)
+
+	var err error
+	switch transaction.Status {
+	case enum.TransactionStatusSettled:
+		err = u.photoAdapter.UpdatePhotosOwner(ctx, photoIds, transaction.UserId)
+		var fiberErr *fiber.Error
+		if errors.As(err, &fiberErr) && fiberErr.Code == fiber.StatusConflict {
+			// another order got one of the photos first, none of them were given to this buyer
+			log.Printf("transaction %s can not be delivered, refunding: %v", transaction.Id, err)
+			if _, err := u.Refund(ctx, &model.RefundRequest{
+				TransactionId: transaction.Id,
+				Reason:        "photo already owned by another user",
+			}); err != nil {
+				log.Println(err)
+			}
+			return
+		}
+	case enum.TransactionStatusRefunded:
+		err = u.photoAdapter.ClearPhotosOwner(ctx, photoIds, transaction.UserId)
+	case enum.TransactionStatusDenied, enum.TransactionStatusExpired:
+		u.releasePhotos(ctx, transaction)
+		return
+	default:
+		return
+	}
+
+	if err != nil {
+		log.Printf("transaction %s is %s but photo ownership was not updated: %v", transaction.Id, transaction.Status, err)
+		return
+	}
+
+	if err := u.transactionRepo.MarkPhotoSynced(ctx, u.db, transaction.Id, transaction.Status); err != nil {
+		log.Println(err)
+		return
+	}
+	transaction.PhotoSynced = true
+}
+
+// releasePhotos cancels the order's photo reservations. They run out on their own in photo-svc,
+// so a failure here only keeps the photos from other buyers a bit longer.
+func (u *transactionUsecase) releasePhotos(ctx context.Context, transaction *entity.Transaction) {
+	if err := u.photoAdapter.CancelPhotoReservations(ctx, photoIdsOf(transaction), transaction.UserId); err != nil {
+		log.Printf("failed to release photo reservations of transaction %s: %v", transaction.Id, err)
+	}
+}
+
+func photoIdsOf(transaction *entity.Transaction) []string {
+	photoIds := make([]string, 0, len(transaction.Items))
+	for _, item := range transaction.Items {
+		photoIds = append(photoIds, item.PhotoId)
+	}
+	return photoIds
+}
+
+// updatePayment locks the transaction, stores the gateway token and payment method when given and
+// applies status if it is a valid next state. It returns the transaction as stored afterwards.
+func (u *transactionUsecase) updatePayment(ctx context.Context, id, token string, paymentMethod entity.PaymentMethod,
+	status enum.TransactionStatus, externalStatus enum.MidtransPaymentStatus, rawResponse json.RawMessage) (*entity.Transaction, error) {
+	tx, err := u.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	transaction, err := u.transactionRepo.FindByIdForUpdate(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := token != "" || paymentMethod.Type != ""
+	if token != "" {
+		transaction.SnapToken = token
+	}
+	if paymentMethod.Type != "" {
+		transaction.PaymentMethod = paymentMethod
+	}
+	if changed {
+		transaction.UpdatedAt = time.Now()
+	}
+	applied := applyTransition(transaction, status, externalStatus, rawResponse)
+	if !applied && !changed {
+		return transaction, nil
+	}
+
+	if applied {
+		err = u.saveTransition(ctx, tx, transaction)
+	} else {
+		err = u.transactionRepo.UpdatePayment(ctx, tx, transaction)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+// saveTransition stores a transaction that just changed status and writes the ledger journal
+// for that change in the same db transaction, so a settled sale is never stored without its entries.
+// An order that is never paid gives its promo code redemption back.
+func (u *transactionUsecase) saveTransition(ctx context.Context, tx pgx.Tx, transaction *entity.Transaction) error {
+	switch transaction.Status {
+	case enum.TransactionStatusSettled:
+		if err := applyCommission(ctx, tx, u.commissionRuleRepo, transaction); err != nil {
+			return err
+		}
+		transaction.PhotoSynced = false
+	case enum.TransactionStatusRefunded:
+		transaction.PhotoSynced = false
+	}
+
+	if err := u.transactionRepo.UpdatePayment(ctx, tx, transaction); err != nil {
+		return err
+	}
+
+	switch transaction.Status {
+	case enum.TransactionStatusSettled:
+		if err := u.transactionRepo.UpdateItemCommissions(ctx, tx, transaction.Items); err != nil {
+			return err
+		}
+		return u.ledger.recordSale(ctx, tx, transaction)
+	case enum.TransactionStatusRefunded:
+		return u.ledger.reverseSale(ctx, tx, transaction)
+	case enum.TransactionStatusDenied, enum.TransactionStatusExpired:
+		return u.promoCodeRepo.VoidRedemption(ctx, tx, transaction.Id)
+	}
+
+	return nil
+}
+
+// applyTransition moves transaction to next when the state machine allows it.
+// Replays of the current status and transitions back to earlier states are ignored.
+func applyTransition(transaction *entity.Transaction, next enum.TransactionStatus,
+	externalStatus enum.MidtransPaymentStatus, rawResponse json.RawMessage) bool {
+	if !transaction.Status.CanTransitionTo(next) {
+		return false
+	}
+
+	now := time.Now()
+	transaction.Status = next
+	transaction.ExternalStatus = externalStatus
+	if rawResponse != nil {
+		transaction.ExternalCallbackResponse = rawResponse
+	}
+	switch next {
+	case enum.TransactionStatusSettled:
+		transaction.PaidAt = &now
+	case enum.TransactionStatusRefunded:
+		transaction.RefundedAt = &now
+	}
+	transaction.UpdatedAt = now
 
+	return true
 }
@@ -1,8 +1,323 @@
 package usecase
 
-type WalletUsecase interface{}
-type walletUsecase struct{}
+import (
+	"be-yourmoments/transaction-svc/internal/adapter"
+	"be-yourmoments/transaction-svc/internal/entity"
+	"be-yourmoments/transaction-svc/internal/enum"
+	"be-yourmoments/transaction-svc/internal/model"
+	"be-yourmoments/transaction-svc/internal/model/converter"
+	"be-yourmoments/transaction-svc/internal/repository"
+	"context"
+	"errors"
+	"log"
+	"time"
 
-func NewWalletUsecase() WalletUsecase {
-	return &walletUsecase{}
+	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/oklog/ulid/v2"
+)
+
+type WalletUsecase interface {
+	GetBalance(ctx context.Context, userId string) (*model.WalletBalanceResponse, error)
+	GetHistory(ctx context.Context, request *model.WalletHistoryRequest) ([]*model.LedgerEntryResponse, *model.PageMetadata, error)
+	RequestWithdrawal(ctx context.Context, request *model.CreateWithdrawalRequest) (*model.WithdrawalResponse, error)
+	GetUserWithdrawals(ctx context.Context, userId string) ([]*model.WithdrawalResponse, error)
+	GetWithdrawalsByStatus(ctx context.Context, status enum.WithdrawalStatus) ([]*model.WithdrawalResponse, error)
+	ApproveWithdrawal(ctx context.Context, withdrawalId string) (*model.WithdrawalResponse, error)
+	RejectWithdrawal(ctx context.Context, request *model.RejectWithdrawalRequest) (*model.WithdrawalResponse, error)
+}
+
+type walletUsecase struct {
+	db                  repository.DB
+	walletRepo          repository.WalletRepository
+	ledgerRepo          repository.LedgerRepository
+	withdrawalRepo      repository.WithdrawalRepository
+	ledger              *ledger
+	disbursementAdapter adapter.DisbursementAdapter
+}
+
+func NewWalletUsecase(db repository.DB, walletRepo repository.WalletRepository, ledgerRepo repository.LedgerRepository,
+	withdrawalRepo repository.WithdrawalRepository, disbursementAdapter adapter.DisbursementAdapter) WalletUsecase {
+	return &walletUsecase{
+		db:                  db,
+		walletRepo:          walletRepo,
+		ledgerRepo:          ledgerRepo,
+		withdrawalRepo:      withdrawalRepo,
+		ledger:              newLedger(walletRepo, ledgerRepo),
+		disbursementAdapter: disbursementAdapter,
+	}
+}
+
+func (u *walletUsecase) GetBalance(ctx context.Context, userId string) (*model.WalletBalanceResponse, error) {
+	balance, err := u.balanceOf(ctx, u.db, userId, enum.WalletTypeCreator)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	held, err := u.balanceOf(ctx, u.db, userId, enum.WalletTypeCreatorHold)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return &model.WalletBalanceResponse{
+		UserId:  userId,
+		Balance: balance,
+		Held:    held,
+	}, nil
+}
+
+func (u *walletUsecase) GetHistory(ctx context.Context, request *model.WalletHistoryRequest) ([]*model.LedgerEntryResponse, *model.PageMetadata, error) {
+	wallet, err := u.walletRepo.FindByOwner(ctx, u.db, request.UserId, enum.WalletTypeCreator)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return []*model.LedgerEntryResponse{}, model.NewPageMetadata(request.Page, request.Size, 0), nil
+		}
+		log.Println(err)
+		return nil, nil, err
+	}
+
+	total, err := u.ledgerRepo.CountByWallet(ctx, u.db, wallet.Id)
+	if err != nil {
+		log.Println(err)
+		return nil, nil, err
+	}
+
+	entries, err := u.ledgerRepo.FindByWallet(ctx, u.db, wallet.Id, request.Size, (request.Page-1)*request.Size)
+	if err != nil {
+		log.Println(err)
+		return nil, nil, err
+	}
+
+	return converter.LedgerEntriesToResponse(entries), model.NewPageMetadata(request.Page, request.Size, total), nil
+}
+
+func (u *walletUsecase) RequestWithdrawal(ctx context.Context, request *model.CreateWithdrawalRequest) (*model.WithdrawalResponse, error) {
+	if request.Amount <= 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "amount must be greater than 0")
+	}
+	if request.BankName == "" || request.BankAccountNumber == "" || request.BankAccountName == "" {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "bank_name, bank_account_number and bank_account_name are required")
+	}
+
+	tx, err := u.db.Begin(ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	wallet, err := u.walletRepo.FindOrCreate(ctx, tx, request.UserId, enum.WalletTypeCreator)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	// the wallet row is locked, so concurrent requests can not spend the same balance twice
+	if err := u.walletRepo.LockByIds(ctx, tx, []string{wallet.Id}); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	balance, err := u.ledgerRepo.SumByWallet(ctx, tx, wallet.Id)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	if request.Amount > balance {
+		return nil, fiber.NewError(fiber.StatusUnprocessableEntity, "insufficient balance")
+	}
+
+	now := time.Now()
+	withdrawal := &entity.Withdrawal{
+		Id:                ulid.Make().String(),
+		UserId:            request.UserId,
+		Amount:            request.Amount,
+		Status:            enum.WithdrawalStatusRequested,
+		BankName:          request.BankName,
+		BankAccountNumber: request.BankAccountNumber,
+		BankAccountName:   request.BankAccountName,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+
+	if err := u.withdrawalRepo.Create(ctx, tx, withdrawal); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	if err := u.ledger.holdWithdrawal(ctx, tx, withdrawal); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return converter.WithdrawalToResponse(withdrawal), nil
+}
+
+func (u *walletUsecase) GetUserWithdrawals(ctx context.Context, userId string) ([]*model.WithdrawalResponse, error) {
+	withdrawals, err := u.withdrawalRepo.FindByUserId(ctx, u.db, userId)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return converter.WithdrawalsToResponse(withdrawals), nil
+}
+
+func (u *walletUsecase) GetWithdrawalsByStatus(ctx context.Context, status enum.WithdrawalStatus) ([]*model.WithdrawalResponse, error) {
+	withdrawals, err := u.withdrawalRepo.FindByStatus(ctx, u.db, status)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return converter.WithdrawalsToResponse(withdrawals), nil
+}
+
+// disbursementClaimTimeout is how long an approval owns a payout call. A claim older than this is
+// left by a crashed approval and may be taken over, the idempotency key keeps it paid once.
+const disbursementClaimTimeout = 5 * time.Minute
+
+// ApproveWithdrawal approves a requested withdrawal and pays it out. The withdrawal is claimed as
+// DISBURSING under the row lock before the provider is called, so concurrent approvals never pay
+// twice and a withdrawal that may already be paid can no longer be rejected. When the payout fails
+// the withdrawal stays DISBURSING and approving it again retries the payout with the same key.
+func (u *walletUsecase) ApproveWithdrawal(ctx context.Context, withdrawalId string) (*model.WithdrawalResponse, error) {
+	withdrawal, err := u.updateWithdrawal(ctx, withdrawalId, func(tx pgx.Tx, withdrawal *entity.Withdrawal) error {
+		now := time.Now()
+		if withdrawal.Status == enum.WithdrawalStatusRequested {
+			withdrawal.Status = enum.WithdrawalStatusApproved
+			withdrawal.ApprovedAt = &now
+		}
+
+		if withdrawal.Status == enum.WithdrawalStatusDisbursing {
+			if withdrawal.DisbursementClaimedAt != nil && now.Sub(*withdrawal.DisbursementClaimedAt) < disbursementClaimTimeout {
+				return fiber.NewError(fiber.StatusConflict, "withdrawal is already being disbursed")
+			}
+		} else if !withdrawal.Status.CanTransitionTo(enum.WithdrawalStatusDisbursing) {
+			return fiber.NewError(fiber.StatusConflict, "withdrawal is already "+string(withdrawal.Status))
+		}
+
+		withdrawal.Status = enum.WithdrawalStatusDisbursing
+		withdrawal.DisbursementClaimedAt = &now
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reference, err := u.disbursementAdapter.Disburse(ctx, disbursementIdempotencyKey(withdrawal), withdrawal)
+	if err != nil {
+		log.Printf("disbursement of withdrawal %s failed: %v", withdrawal.Id, err)
+		// the payout may still have gone through, release the claim but keep the withdrawal DISBURSING
+		if _, err := u.updateWithdrawal(ctx, withdrawalId, func(tx pgx.Tx, withdrawal *entity.Withdrawal) error {
+			withdrawal.DisbursementClaimedAt = nil
+			return nil
+		}); err != nil {
+			log.Println(err)
+		}
+		return nil, fiber.NewError(fiber.StatusBadGateway, "withdrawal approved but disbursement failed, approve again to retry")
+	}
+
+	withdrawal, err = u.updateWithdrawal(ctx, withdrawalId, func(tx pgx.Tx, withdrawal *entity.Withdrawal) error {
+		if !withdrawal.Status.CanTransitionTo(enum.WithdrawalStatusPaid) {
+			return fiber.NewError(fiber.StatusConflict, "withdrawal is already "+string(withdrawal.Status))
+		}
+
+		now := time.Now()
+		withdrawal.Status = enum.WithdrawalStatusPaid
+		withdrawal.DisbursementReference = reference
+		withdrawal.DisbursementClaimedAt = nil
+		withdrawal.PaidAt = &now
+		return u.ledger.payWithdrawal(ctx, tx, withdrawal)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.WithdrawalToResponse(withdrawal), nil
+}
+
+// disbursementIdempotencyKey stays the same for every payout attempt of a withdrawal.
+func disbursementIdempotencyKey(withdrawal *entity.Withdrawal) string {
+	return "withdrawal-" + withdrawal.Id
+}
+
+func (u *walletUsecase) RejectWithdrawal(ctx context.Context, request *model.RejectWithdrawalRequest) (*model.WithdrawalResponse, error) {
+	if request.Reason == "" {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "reason is required")
+	}
+
+	withdrawal, err := u.updateWithdrawal(ctx, request.Id, func(tx pgx.Tx, withdrawal *entity.Withdrawal) error {
+		if !withdrawal.Status.CanTransitionTo(enum.WithdrawalStatusRejected) {
+			return fiber.NewError(fiber.StatusConflict, "withdrawal is already "+string(withdrawal.Status))
+		}
+
+		now := time.Now()
+		withdrawal.Status = enum.WithdrawalStatusRejected
+		withdrawal.RejectionReason = request.Reason
+		withdrawal.RejectedAt = &now
+		return u.ledger.releaseWithdrawal(ctx, tx, withdrawal)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.WithdrawalToResponse(withdrawal), nil
+}
+
+// updateWithdrawal locks the withdrawal, lets change modify it and saves it in one db transaction.
+func (u *walletUsecase) updateWithdrawal(ctx context.Context, withdrawalId string,
+	change func(tx pgx.Tx, withdrawal *entity.Withdrawal) error) (*entity.Withdrawal, error) {
+	tx, err := u.db.Begin(ctx)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	withdrawal, err := u.withdrawalRepo.FindByIdForUpdate(ctx, tx, withdrawalId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fiber.NewError(fiber.StatusNotFound, "withdrawal not found")
+		}
+		log.Println(err)
+		return nil, err
+	}
+
+	if err := change(tx, withdrawal); err != nil {
+		return nil, err
+	}
+
+	withdrawal.UpdatedAt = time.Now()
+	if err := u.withdrawalRepo.Update(ctx, tx, withdrawal); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return withdrawal, nil
+}
+
+func (u *walletUsecase) balanceOf(ctx context.Context, db repository.Querier, ownerId string, walletType enum.WalletType) (int64, error) {
+	wallet, err := u.walletRepo.FindByOwner(ctx, db, ownerId, walletType)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return u.ledgerRepo.SumByWallet(ctx, db, wallet.Id)
 }
@@ -0,0 +1,73 @@
+package enum
+
+import (
+	"be-yourmoments/transaction-svc/internal/enum"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransactionStatusTransitions(t *testing.T) {
+	statuses := []enum.TransactionStatus{
+		enum.TransactionStatusPending,
+		enum.TransactionStatusSettled,
+		enum.TransactionStatusExpired,
+		enum.TransactionStatusDenied,
+		enum.TransactionStatusRefunded,
+	}
+	allowed := map[enum.TransactionStatus][]enum.TransactionStatus{
+		enum.TransactionStatusPending: {enum.TransactionStatusSettled, enum.TransactionStatusExpired, enum.TransactionStatusDenied},
+		enum.TransactionStatusSettled: {enum.TransactionStatusRefunded},
+	}
+
+	for _, from := range statuses {
+		for _, to := range statuses {
+			assert.Equal(t, slices.Contains(allowed[from], to), from.CanTransitionTo(to), "%s to %s", from, to)
+		}
+	}
+}
+
+func TestWithdrawalStatusTransitions(t *testing.T) {
+	statuses := []enum.WithdrawalStatus{
+		enum.WithdrawalStatusRequested,
+		enum.WithdrawalStatusApproved,
+		enum.WithdrawalStatusDisbursing,
+		enum.WithdrawalStatusPaid,
+		enum.WithdrawalStatusRejected,
+	}
+	allowed := map[enum.WithdrawalStatus][]enum.WithdrawalStatus{
+		enum.WithdrawalStatusRequested:  {enum.WithdrawalStatusApproved, enum.WithdrawalStatusRejected},
+		enum.WithdrawalStatusApproved:   {enum.WithdrawalStatusDisbursing},
+		enum.WithdrawalStatusDisbursing: {enum.WithdrawalStatusPaid},
+	}
+
+	for _, from := range statuses {
+		for _, to := range statuses {
+			assert.Equal(t, slices.Contains(allowed[from], to), from.CanTransitionTo(to), "%s to %s", from, to)
+		}
+	}
+}
+
+func TestMidtransPaymentStatus(t *testing.T) {
+	cases := map[enum.MidtransPaymentStatus]enum.TransactionStatus{
+		enum.MidtransPaymentStatusCapture:       enum.TransactionStatusSettled,
+		enum.MidtransPaymentStatusSettlement:    enum.TransactionStatusSettled,
+		enum.MidtransPaymentStatusPartialRefund: enum.TransactionStatusSettled,
+		enum.MidtransPaymentStatusPending:       enum.TransactionStatusPending,
+		enum.MidtransPaymentStatusDeny:          enum.TransactionStatusDenied,
+		enum.MidtransPaymentStatusCancel:        enum.TransactionStatusDenied,
+		enum.MidtransPaymentStatusFailure:       enum.TransactionStatusDenied,
+		enum.MidtransPaymentStatusExpire:        enum.TransactionStatusExpired,
+		enum.MidtransPaymentStatusRefund:        enum.TransactionStatusRefunded,
+	}
+
+	for external, expected := range cases {
+		status, ok := external.TransactionStatus()
+		assert.True(t, ok, external)
+		assert.Equal(t, expected, status, external)
+	}
+
+	_, ok := enum.MidtransPaymentStatus("authorize").TransactionStatus()
+	assert.False(t, ok)
+}
@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"be-yourmoments/transaction-svc/internal/entity"
+	mockdb "be-yourmoments/transaction-svc/internal/mocks/db"
+	mockrepository "be-yourmoments/transaction-svc/internal/mocks/repository"
+	"be-yourmoments/transaction-svc/internal/repository"
+	"be-yourmoments/transaction-svc/internal/usecase"
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdempotencyBegin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	mockDB := mockdb.NewMockDB(ctrl)
+	mockIdempotencyKeyRepo := mockrepository.NewMockIdempotencyKeyRepository(ctrl)
+
+	idempotencyUC := usecase.NewIdempotencyUsecase(mockDB, mockIdempotencyKeyRepo)
+
+	completedAt := time.Now()
+
+	t.Run("New key is reserved", func(t *testing.T) {
+		mockIdempotencyKeyRepo.EXPECT().Reserve(ctx, mockDB, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, db repository.Querier, key *entity.IdempotencyKey, ttl time.Duration) (bool, error) {
+				assert.Equal(t, "user-1", key.UserId)
+				assert.Equal(t, "key-1", key.Key)
+				return true, nil
+			})
+
+		resp, err := idempotencyUC.Begin(ctx, "user-1", "key-1", "fingerprint-1")
+		assert.NoError(t, err)
+		assert.Nil(t, resp)
+	})
+
+	t.Run("Completed key replays the stored response", func(t *testing.T) {
+		mockIdempotencyKeyRepo.EXPECT().Reserve(ctx, mockDB, gomock.Any(), gomock.Any()).Return(false, nil)
+		mockIdempotencyKeyRepo.EXPECT().FindByKey(ctx, mockDB, "user-1", "key-1").Return(&entity.IdempotencyKey{
+			Key:          "key-1",
+			Fingerprint:  "fingerprint-1",
+			StatusCode:   http.StatusOK,
+			ContentType:  fiber.MIMEApplicationJSON,
+			ResponseBody: []byte(`{"success":true}`),
+			CompletedAt:  &completedAt,
+		}, nil)
+
+		resp, err := idempotencyUC.Begin(ctx, "user-1", "key-1", "fingerprint-1")
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, fiber.MIMEApplicationJSON, resp.ContentType)
+		assert.Equal(t, []byte(`{"success":true}`), resp.Body)
+	})
+
+	t.Run("Key reused with a different request", func(t *testing.T) {
+		mockIdempotencyKeyRepo.EXPECT().Reserve(ctx, mockDB, gomock.Any(), gomock.Any()).Return(false, nil)
+		mockIdempotencyKeyRepo.EXPECT().FindByKey(ctx, mockDB, "user-1", "key-1").
+			Return(&entity.IdempotencyKey{Key: "key-1", Fingerprint: "fingerprint-1", CompletedAt: &completedAt}, nil)
+
+		resp, err := idempotencyUC.Begin(ctx, "user-1", "key-1", "fingerprint-2")
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.Equal(t, fiber.StatusConflict, err.(*fiber.Error).Code)
+	})
+
+	t.Run("Key still being processed", func(t *testing.T) {
+		mockIdempotencyKeyRepo.EXPECT().Reserve(ctx, mockDB, gomock.Any(), gomock.Any()).Return(false, nil)
+		mockIdempotencyKeyRepo.EXPECT().FindByKey(ctx, mockDB, "user-1", "key-1").
+			Return(&entity.IdempotencyKey{Key: "key-1", Fingerprint: "fingerprint-1"}, nil)
+
+		resp, err := idempotencyUC.Begin(ctx, "user-1", "key-1", "fingerprint-1")
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.Equal(t, fiber.StatusConflict, err.(*fiber.Error).Code)
+	})
+
+	t.Run("Key released by a failed request in between", func(t *testing.T) {
+		mockIdempotencyKeyRepo.EXPECT().Reserve(ctx, mockDB, gomock.Any(), gomock.Any()).Return(false, nil)
+		mockIdempotencyKeyRepo.EXPECT().FindByKey(ctx, mockDB, "user-1", "key-1").Return(nil, pgx.ErrNoRows)
+
+		resp, err := idempotencyUC.Begin(ctx, "user-1", "key-1", "fingerprint-1")
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.Equal(t, fiber.StatusConflict, err.(*fiber.Error).Code)
+	})
+}
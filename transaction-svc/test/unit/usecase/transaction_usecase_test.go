@@ -0,0 +1,502 @@
+package usecase
+
+import (
+	"be-yourmoments/transaction-svc/internal/entity"
+	"be-yourmoments/transaction-svc/internal/enum"
+	mockadapter "be-yourmoments/transaction-svc/internal/mocks/adapter"
+	mockdb "be-yourmoments/transaction-svc/internal/mocks/db"
+	mockrepository "be-yourmoments/transaction-svc/internal/mocks/repository"
+	"be-yourmoments/transaction-svc/internal/model"
+	"be-yourmoments/transaction-svc/internal/repository"
+	"be-yourmoments/transaction-svc/internal/usecase"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+// walletIdOf is the id the mocked wallet repository gives every wallet, so entries can be told apart.
+func walletIdOf(ownerId string, walletType enum.WalletType) string {
+	return ownerId + "/" + string(walletType)
+}
+
+// expectWallets returns the ids of every LockByIds call, in the order they were locked.
+func expectWallets(walletRepo *mockrepository.MockWalletRepository) *[]string {
+	walletRepo.EXPECT().FindOrCreate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, db repository.Querier, ownerId string, walletType enum.WalletType) (*entity.Wallet, error) {
+			return &entity.Wallet{Id: walletIdOf(ownerId, walletType), OwnerId: ownerId, Type: walletType}, nil
+		}).AnyTimes()
+
+	locked := new([]string)
+	walletRepo.EXPECT().LockByIds(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, db repository.Querier, walletIds []string) error {
+			*locked = append(*locked, walletIds...)
+			return nil
+		}).AnyTimes()
+
+	return locked
+}
+
+func entryAmounts(entries []*entity.LedgerEntry) (map[string]int64, int64) {
+	amounts := make(map[string]int64)
+	var sum int64
+	for _, entry := range entries {
+		amounts[entry.WalletId] += entry.Amount
+		sum += entry.Amount
+	}
+	return amounts, sum
+}
+
+func TestHandlePaymentNotification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	mockDB := mockdb.NewMockDB(ctrl)
+	mockTx := mockdb.NewMockTx(ctrl)
+	mockTransactionRepo := mockrepository.NewMockTransactionRepository(ctrl)
+	mockPaymentCallbackRepo := mockrepository.NewMockPaymentCallbackRepository(ctrl)
+	mockCommissionRuleRepo := mockrepository.NewMockCommissionRuleRepository(ctrl)
+	mockPromoCodeRepo := mockrepository.NewMockPromoCodeRepository(ctrl)
+	mockWalletRepo := mockrepository.NewMockWalletRepository(ctrl)
+	mockLedgerRepo := mockrepository.NewMockLedgerRepository(ctrl)
+	mockPhotoAdapter := mockadapter.NewMockPhotoAdapter(ctrl)
+	mockPaymentAdapter := mockadapter.NewMockPaymentAdapter(ctrl)
+
+	transactionUC := usecase.NewTransactionUsecase(mockDB, mockTransactionRepo, mockPaymentCallbackRepo, mockCommissionRuleRepo,
+		mockPromoCodeRepo, mockWalletRepo, mockLedgerRepo, mockPhotoAdapter, mockPaymentAdapter)
+
+	locked := expectWallets(mockWalletRepo)
+
+	newTransaction := func(status enum.TransactionStatus) *entity.Transaction {
+		return &entity.Transaction{
+			Id:     "trx-1",
+			UserId: "buyer",
+			Amount: 15000,
+			Status: status,
+			Items: []*entity.TransactionItem{
+				{Id: "item-1", PhotoId: "photo-1", CreatorId: "creator-1", Amount: 10000},
+				{Id: "item-2", PhotoId: "photo-2", CreatorId: "creator-2", Amount: 5000},
+			},
+		}
+	}
+
+	t.Run("Invalid signature", func(t *testing.T) {
+		notification := &model.PaymentNotification{OrderId: "trx-1", TransactionStatus: enum.MidtransPaymentStatusSettlement}
+		mockPaymentAdapter.EXPECT().VerifySignature(notification).Return(false)
+
+		err := transactionUC.HandlePaymentNotification(ctx, notification)
+		assert.Error(t, err)
+		assert.Equal(t, fiber.StatusUnauthorized, err.(*fiber.Error).Code)
+	})
+
+	t.Run("Settlement splits commission and posts a balanced journal", func(t *testing.T) {
+		*locked = nil
+		transaction := newTransaction(enum.TransactionStatusPending)
+		notification := &model.PaymentNotification{
+			OrderId:           "trx-1",
+			GrossAmount:       "15000.00",
+			TransactionStatus: enum.MidtransPaymentStatusSettlement,
+		}
+
+		mockPaymentAdapter.EXPECT().VerifySignature(notification).Return(true)
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+		mockTransactionRepo.EXPECT().FindByIdForUpdate(ctx, mockTx, "trx-1").Return(transaction, nil)
+		// 10% + 500 of 10000, and a flat fee larger than the 5000 photo which is capped at its price
+		mockCommissionRuleRepo.EXPECT().FindForCreator(ctx, mockTx, "creator-1").
+			Return(&entity.CommissionRule{Id: "rule-1", CreatorId: "creator-1", PercentageBps: 1000, FlatFee: 500}, nil)
+		mockCommissionRuleRepo.EXPECT().FindForCreator(ctx, mockTx, "creator-2").
+			Return(&entity.CommissionRule{Id: "rule-2", CreatorId: "creator-2", PercentageBps: 500, FlatFee: 8000}, nil)
+		mockTransactionRepo.EXPECT().UpdatePayment(ctx, mockTx, transaction).Return(nil)
+		mockTransactionRepo.EXPECT().UpdateItemCommissions(ctx, mockTx, transaction.Items).Return(nil)
+
+		var entries []*entity.LedgerEntry
+		mockLedgerRepo.EXPECT().CreateEntries(ctx, mockTx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, db repository.Querier, created []*entity.LedgerEntry) error {
+				entries = created
+				return nil
+			})
+		mockPaymentCallbackRepo.EXPECT().Create(ctx, mockTx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, db repository.Querier, callback *entity.PaymentCallback) error {
+				assert.True(t, callback.Applied)
+				return nil
+			})
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+		mockPhotoAdapter.EXPECT().UpdatePhotosOwner(ctx, []string{"photo-1", "photo-2"}, "buyer").Return(nil)
+		mockTransactionRepo.EXPECT().MarkPhotoSynced(ctx, mockDB, "trx-1", enum.TransactionStatusSettled).Return(nil)
+
+		err := transactionUC.HandlePaymentNotification(ctx, notification)
+		assert.NoError(t, err)
+
+		assert.Equal(t, enum.TransactionStatusSettled, transaction.Status)
+		assert.Equal(t, int64(1500), transaction.Items[0].FeeAmount)
+		assert.Equal(t, int64(8500), transaction.Items[0].NetAmount)
+		assert.Equal(t, int64(5000), transaction.Items[1].FeeAmount)
+		assert.Equal(t, int64(0), transaction.Items[1].NetAmount)
+		assert.Equal(t, int64(6500), transaction.FeeAmount)
+		assert.Equal(t, int64(8500), transaction.NetAmount)
+
+		amounts, sum := entryAmounts(entries)
+		assert.Equal(t, int64(0), sum)
+		assert.Len(t, entries, 3, "a creator with nothing left is not credited")
+		assert.Equal(t, int64(8500), amounts[walletIdOf("creator-1", enum.WalletTypeCreator)])
+		assert.Equal(t, int64(6500), amounts[walletIdOf(enum.PlatformWalletOwnerId, enum.WalletTypePlatformRevenue)])
+		assert.Equal(t, int64(-15000), amounts[walletIdOf(enum.PlatformWalletOwnerId, enum.WalletTypePlatformClearing)])
+		assert.Equal(t, []string{walletIdOf("creator-1", enum.WalletTypeCreator)}, *locked, "the platform wallets are not locked")
+	})
+
+	t.Run("Settlement without any commission rule", func(t *testing.T) {
+		*locked = nil
+		transaction := newTransaction(enum.TransactionStatusPending)
+		notification := &model.PaymentNotification{
+			OrderId:           "trx-1",
+			GrossAmount:       "15000.00",
+			TransactionStatus: enum.MidtransPaymentStatusCapture,
+			FraudStatus:       "accept",
+		}
+
+		mockPaymentAdapter.EXPECT().VerifySignature(notification).Return(true)
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+		mockTransactionRepo.EXPECT().FindByIdForUpdate(ctx, mockTx, "trx-1").Return(transaction, nil)
+		mockCommissionRuleRepo.EXPECT().FindForCreator(ctx, mockTx, gomock.Any()).Return(nil, pgx.ErrNoRows).Times(2)
+		mockTransactionRepo.EXPECT().UpdatePayment(ctx, mockTx, transaction).Return(nil)
+		mockTransactionRepo.EXPECT().UpdateItemCommissions(ctx, mockTx, transaction.Items).Return(nil)
+
+		var entries []*entity.LedgerEntry
+		mockLedgerRepo.EXPECT().CreateEntries(ctx, mockTx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, db repository.Querier, created []*entity.LedgerEntry) error {
+				entries = created
+				return nil
+			})
+		mockPaymentCallbackRepo.EXPECT().Create(ctx, mockTx, gomock.Any()).Return(nil)
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+		mockPhotoAdapter.EXPECT().UpdatePhotosOwner(ctx, gomock.Any(), "buyer").Return(nil)
+		mockTransactionRepo.EXPECT().MarkPhotoSynced(ctx, mockDB, "trx-1", enum.TransactionStatusSettled).Return(nil)
+
+		err := transactionUC.HandlePaymentNotification(ctx, notification)
+		assert.NoError(t, err)
+
+		amounts, sum := entryAmounts(entries)
+		assert.Equal(t, int64(0), sum)
+		assert.Equal(t, int64(0), transaction.FeeAmount)
+		assert.Equal(t, int64(10000), amounts[walletIdOf("creator-1", enum.WalletTypeCreator)])
+		assert.Equal(t, int64(5000), amounts[walletIdOf("creator-2", enum.WalletTypeCreator)])
+		assert.NotContains(t, amounts, walletIdOf(enum.PlatformWalletOwnerId, enum.WalletTypePlatformRevenue))
+		assert.Equal(t, []string{walletIdOf("creator-1", enum.WalletTypeCreator), walletIdOf("creator-2", enum.WalletTypeCreator)},
+			*locked, "creator wallets are locked in id order")
+	})
+
+	t.Run("Refund reverses the sale", func(t *testing.T) {
+		transaction := newTransaction(enum.TransactionStatusSettled)
+		transaction.FeeAmount, transaction.NetAmount = 6500, 8500
+		transaction.Items[0].FeeAmount, transaction.Items[0].NetAmount = 1500, 8500
+		transaction.Items[1].FeeAmount, transaction.Items[1].NetAmount = 5000, 0
+		notification := &model.PaymentNotification{
+			OrderId:           "trx-1",
+			GrossAmount:       "15000.00",
+			TransactionStatus: enum.MidtransPaymentStatusRefund,
+		}
+
+		mockPaymentAdapter.EXPECT().VerifySignature(notification).Return(true)
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+		mockTransactionRepo.EXPECT().FindByIdForUpdate(ctx, mockTx, "trx-1").Return(transaction, nil)
+		mockTransactionRepo.EXPECT().UpdatePayment(ctx, mockTx, transaction).Return(nil)
+
+		var entries []*entity.LedgerEntry
+		mockLedgerRepo.EXPECT().CreateEntries(ctx, mockTx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, db repository.Querier, created []*entity.LedgerEntry) error {
+				entries = created
+				return nil
+			})
+		mockPaymentCallbackRepo.EXPECT().Create(ctx, mockTx, gomock.Any()).Return(nil)
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+		mockPhotoAdapter.EXPECT().ClearPhotosOwner(ctx, []string{"photo-1", "photo-2"}, "buyer").Return(nil)
+		mockTransactionRepo.EXPECT().MarkPhotoSynced(ctx, mockDB, "trx-1", enum.TransactionStatusRefunded).Return(nil)
+
+		err := transactionUC.HandlePaymentNotification(ctx, notification)
+		assert.NoError(t, err)
+
+		amounts, sum := entryAmounts(entries)
+		assert.Equal(t, enum.TransactionStatusRefunded, transaction.Status)
+		assert.Equal(t, int64(0), sum)
+		assert.Equal(t, int64(-8500), amounts[walletIdOf("creator-1", enum.WalletTypeCreator)])
+		assert.Equal(t, int64(-6500), amounts[walletIdOf(enum.PlatformWalletOwnerId, enum.WalletTypePlatformRevenue)])
+		assert.Equal(t, int64(15000), amounts[walletIdOf(enum.PlatformWalletOwnerId, enum.WalletTypePlatformClearing)])
+	})
+
+	t.Run("Challenged capture stays pending", func(t *testing.T) {
+		transaction := newTransaction(enum.TransactionStatusPending)
+		notification := &model.PaymentNotification{
+			OrderId:           "trx-1",
+			GrossAmount:       "15000.00",
+			TransactionStatus: enum.MidtransPaymentStatusCapture,
+			FraudStatus:       "challenge",
+		}
+
+		mockPaymentAdapter.EXPECT().VerifySignature(notification).Return(true)
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+		mockTransactionRepo.EXPECT().FindByIdForUpdate(ctx, mockTx, "trx-1").Return(transaction, nil)
+		mockPaymentCallbackRepo.EXPECT().Create(ctx, mockTx, gomock.Any()).Return(nil)
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+
+		err := transactionUC.HandlePaymentNotification(ctx, notification)
+		assert.NoError(t, err)
+		assert.Equal(t, enum.TransactionStatusPending, transaction.Status)
+	})
+
+	t.Run("Late expiry after settlement is recorded but not applied", func(t *testing.T) {
+		transaction := newTransaction(enum.TransactionStatusSettled)
+		notification := &model.PaymentNotification{
+			OrderId:           "trx-1",
+			GrossAmount:       "15000.00",
+			TransactionStatus: enum.MidtransPaymentStatusExpire,
+		}
+
+		mockPaymentAdapter.EXPECT().VerifySignature(notification).Return(true)
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+		mockTransactionRepo.EXPECT().FindByIdForUpdate(ctx, mockTx, "trx-1").Return(transaction, nil)
+		mockPaymentCallbackRepo.EXPECT().Create(ctx, mockTx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, db repository.Querier, callback *entity.PaymentCallback) error {
+				assert.False(t, callback.Applied)
+				return nil
+			})
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+
+		err := transactionUC.HandlePaymentNotification(ctx, notification)
+		assert.NoError(t, err)
+		assert.Equal(t, enum.TransactionStatusSettled, transaction.Status)
+	})
+
+	t.Run("Partial refund keeps the sale", func(t *testing.T) {
+		transaction := newTransaction(enum.TransactionStatusSettled)
+		notification := &model.PaymentNotification{
+			OrderId:           "trx-1",
+			GrossAmount:       "15000.00",
+			TransactionStatus: enum.MidtransPaymentStatusPartialRefund,
+		}
+
+		mockPaymentAdapter.EXPECT().VerifySignature(notification).Return(true)
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+		mockTransactionRepo.EXPECT().FindByIdForUpdate(ctx, mockTx, "trx-1").Return(transaction, nil)
+		mockPaymentCallbackRepo.EXPECT().Create(ctx, mockTx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, db repository.Querier, callback *entity.PaymentCallback) error {
+				assert.False(t, callback.Applied)
+				return nil
+			})
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+
+		err := transactionUC.HandlePaymentNotification(ctx, notification)
+		assert.NoError(t, err)
+		assert.Equal(t, enum.TransactionStatusSettled, transaction.Status)
+	})
+
+	t.Run("Partial refund before settlement is recorded but not applied", func(t *testing.T) {
+		transaction := newTransaction(enum.TransactionStatusPending)
+		notification := &model.PaymentNotification{
+			OrderId:           "trx-1",
+			GrossAmount:       "15000.00",
+			TransactionStatus: enum.MidtransPaymentStatusPartialRefund,
+		}
+
+		mockPaymentAdapter.EXPECT().VerifySignature(notification).Return(true)
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+		mockTransactionRepo.EXPECT().FindByIdForUpdate(ctx, mockTx, "trx-1").Return(transaction, nil)
+		mockPaymentCallbackRepo.EXPECT().Create(ctx, mockTx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, db repository.Querier, callback *entity.PaymentCallback) error {
+				assert.False(t, callback.Applied)
+				return nil
+			})
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+
+		err := transactionUC.HandlePaymentNotification(ctx, notification)
+		assert.NoError(t, err)
+		assert.Equal(t, enum.TransactionStatusPending, transaction.Status)
+	})
+
+	t.Run("Gross amount does not match", func(t *testing.T) {
+		transaction := newTransaction(enum.TransactionStatusPending)
+		notification := &model.PaymentNotification{
+			OrderId:           "trx-1",
+			GrossAmount:       "1.00",
+			TransactionStatus: enum.MidtransPaymentStatusSettlement,
+		}
+
+		mockPaymentAdapter.EXPECT().VerifySignature(notification).Return(true)
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+		mockTransactionRepo.EXPECT().FindByIdForUpdate(ctx, mockTx, "trx-1").Return(transaction, nil)
+
+		err := transactionUC.HandlePaymentNotification(ctx, notification)
+		assert.Error(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, err.(*fiber.Error).Code)
+		assert.Equal(t, enum.TransactionStatusPending, transaction.Status)
+	})
+}
+
+func TestRefund(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	mockDB := mockdb.NewMockDB(ctrl)
+	mockTransactionRepo := mockrepository.NewMockTransactionRepository(ctrl)
+	mockPaymentAdapter := mockadapter.NewMockPaymentAdapter(ctrl)
+
+	transactionUC := usecase.NewTransactionUsecase(mockDB, mockTransactionRepo, mockrepository.NewMockPaymentCallbackRepository(ctrl),
+		mockrepository.NewMockCommissionRuleRepository(ctrl), mockrepository.NewMockPromoCodeRepository(ctrl),
+		mockrepository.NewMockWalletRepository(ctrl), mockrepository.NewMockLedgerRepository(ctrl),
+		mockadapter.NewMockPhotoAdapter(ctrl), mockPaymentAdapter)
+
+	transaction := &entity.Transaction{Id: "trx-1", UserId: "buyer", Amount: 15000, Status: enum.TransactionStatusSettled}
+	request := &model.RefundRequest{TransactionId: "trx-1", Reason: "duplicate order"}
+
+	t.Run("Refund already claimed does not call the gateway", func(t *testing.T) {
+		mockTransactionRepo.EXPECT().FindById(ctx, mockDB, "trx-1").Return(transaction, nil)
+		mockTransactionRepo.EXPECT().ClaimRefund(ctx, mockDB, "trx-1", gomock.Any(), gomock.Any()).Return(false, nil)
+
+		resp, err := transactionUC.Refund(ctx, request)
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.Equal(t, fiber.StatusConflict, err.(*fiber.Error).Code)
+	})
+
+	t.Run("Failed gateway refund releases the claim", func(t *testing.T) {
+		mockTransactionRepo.EXPECT().FindById(ctx, mockDB, "trx-1").Return(transaction, nil)
+		mockTransactionRepo.EXPECT().ClaimRefund(ctx, mockDB, "trx-1", gomock.Any(), gomock.Any()).Return(true, nil)
+		mockPaymentAdapter.EXPECT().Refund(ctx, transaction, "duplicate order").Return(nil, errors.New("gateway unavailable"))
+		mockTransactionRepo.EXPECT().ReleaseRefundClaim(ctx, mockDB, "trx-1").Return(nil)
+
+		resp, err := transactionUC.Refund(ctx, request)
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.Equal(t, fiber.StatusBadGateway, err.(*fiber.Error).Code)
+	})
+
+	t.Run("Only settled transactions can be refunded", func(t *testing.T) {
+		mockTransactionRepo.EXPECT().FindById(ctx, mockDB, "trx-1").
+			Return(&entity.Transaction{Id: "trx-1", Status: enum.TransactionStatusPending}, nil)
+
+		_, err := transactionUC.Refund(ctx, request)
+		assert.Error(t, err)
+		assert.Equal(t, fiber.StatusConflict, err.(*fiber.Error).Code)
+	})
+}
+
+func TestCheckoutPromoCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	mockDB := mockdb.NewMockDB(ctrl)
+	mockTx := mockdb.NewMockTx(ctrl)
+	mockTransactionRepo := mockrepository.NewMockTransactionRepository(ctrl)
+	mockPromoCodeRepo := mockrepository.NewMockPromoCodeRepository(ctrl)
+	mockPhotoAdapter := mockadapter.NewMockPhotoAdapter(ctrl)
+
+	transactionUC := usecase.NewTransactionUsecase(mockDB, mockTransactionRepo, mockrepository.NewMockPaymentCallbackRepository(ctrl),
+		mockrepository.NewMockCommissionRuleRepository(ctrl), mockPromoCodeRepo, mockrepository.NewMockWalletRepository(ctrl),
+		mockrepository.NewMockLedgerRepository(ctrl), mockPhotoAdapter, mockadapter.NewMockPaymentAdapter(ctrl))
+
+	photos := func() []*model.PhotoPrice {
+		return []*model.PhotoPrice{
+			{Id: "photo-1", CreatorId: "creator-1", Price: 3000},
+			{Id: "photo-2", CreatorId: "creator-1", Price: 3000},
+			{Id: "photo-3", CreatorId: "creator-2", Price: 1000},
+			{Id: "photo-4", CreatorId: "creator-2", Price: 2000},
+		}
+	}
+	photoIds := []string{"photo-1", "photo-2", "photo-3", "photo-4"}
+	errStop := errors.New("stop before payment")
+
+	// checkout captures the discounted order and stops there, the photos are released again
+	expectCheckout := func(promoCode *entity.PromoCode, created **entity.Transaction) {
+		mockPhotoAdapter.EXPECT().GetCart(ctx, "buyer").Return(photos(), nil)
+		mockPhotoAdapter.EXPECT().ReservePhotos(ctx, photoIds, "buyer").Return(nil)
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+		mockPromoCodeRepo.EXPECT().FindByCodeForUpdate(ctx, mockTx, promoCode.Code).Return(promoCode, nil)
+		mockTransactionRepo.EXPECT().Create(ctx, mockTx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, db repository.Querier, transaction *entity.Transaction) error {
+				*created = transaction
+				return errStop
+			})
+		mockPhotoAdapter.EXPECT().CancelPhotoReservations(ctx, photoIds, "buyer").Return(nil)
+	}
+
+	t.Run("Fixed discount is split over the creator's photos", func(t *testing.T) {
+		promoCode := &entity.PromoCode{Id: "promo-1", Code: "HEMAT", Type: enum.PromoTypeFixed, Value: 1000,
+			CreatorId: "creator-2", IsActive: true}
+
+		var created *entity.Transaction
+		expectCheckout(promoCode, &created)
+
+		resp, err := transactionUC.Checkout(ctx, &model.CheckoutRequest{UserId: "buyer", PromoCode: " hemat "})
+		assert.ErrorIs(t, err, errStop)
+		assert.Nil(t, resp)
+
+		// 1000 * 1000 / 3000 rounds down to 333, the last eligible photo takes the remaining 667
+		assert.Equal(t, int64(0), created.Items[0].DiscountAmount)
+		assert.Equal(t, int64(0), created.Items[1].DiscountAmount)
+		assert.Equal(t, int64(333), created.Items[2].DiscountAmount)
+		assert.Equal(t, int64(667), created.Items[3].DiscountAmount)
+		assert.Equal(t, int64(667), created.Items[2].Amount)
+		assert.Equal(t, int64(1333), created.Items[3].Amount)
+		assert.Equal(t, int64(1000), created.DiscountAmount)
+		assert.Equal(t, int64(8000), created.Amount)
+		assert.Equal(t, "promo-1", created.PromoCodeId)
+
+		var itemTotal int64
+		for _, item := range created.Items {
+			itemTotal += item.Amount
+		}
+		assert.Equal(t, created.Amount, itemTotal)
+	})
+
+	t.Run("Fixed discount is capped at the eligible subtotal", func(t *testing.T) {
+		promoCode := &entity.PromoCode{Id: "promo-2", Code: "GRATIS", Type: enum.PromoTypeFixed, Value: 50000, IsActive: true}
+
+		var created *entity.Transaction
+		expectCheckout(promoCode, &created)
+
+		_, err := transactionUC.Checkout(ctx, &model.CheckoutRequest{UserId: "buyer", PromoCode: "GRATIS"})
+		assert.ErrorIs(t, err, errStop)
+
+		assert.Equal(t, int64(9000), created.DiscountAmount)
+		assert.Equal(t, int64(0), created.Amount)
+		for _, item := range created.Items {
+			assert.Equal(t, int64(0), item.Amount)
+		}
+	})
+
+	t.Run("Promo code already used by the buyer", func(t *testing.T) {
+		promoCode := &entity.PromoCode{Id: "promo-3", Code: "SEKALI", Type: enum.PromoTypeFixed, Value: 1000,
+			MaxRedemptionsPerUser: 1, IsActive: true}
+
+		mockPhotoAdapter.EXPECT().GetCart(ctx, "buyer").Return(photos(), nil)
+		mockPhotoAdapter.EXPECT().ReservePhotos(ctx, photoIds, "buyer").Return(nil)
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+		mockPromoCodeRepo.EXPECT().FindByCodeForUpdate(ctx, mockTx, "SEKALI").Return(promoCode, nil)
+		mockPromoCodeRepo.EXPECT().CountUserRedemptions(ctx, mockTx, "promo-3", "buyer").Return(1, nil)
+		mockPhotoAdapter.EXPECT().CancelPhotoReservations(ctx, photoIds, "buyer").Return(nil)
+
+		resp, err := transactionUC.Checkout(ctx, &model.CheckoutRequest{UserId: "buyer", PromoCode: "SEKALI"})
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.Equal(t, fiber.StatusUnprocessableEntity, err.(*fiber.Error).Code)
+	})
+}
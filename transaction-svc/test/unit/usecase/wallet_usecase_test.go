@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"be-yourmoments/transaction-svc/internal/entity"
+	"be-yourmoments/transaction-svc/internal/enum"
+	mockadapter "be-yourmoments/transaction-svc/internal/mocks/adapter"
+	mockdb "be-yourmoments/transaction-svc/internal/mocks/db"
+	mockrepository "be-yourmoments/transaction-svc/internal/mocks/repository"
+	"be-yourmoments/transaction-svc/internal/model"
+	"be-yourmoments/transaction-svc/internal/repository"
+	"be-yourmoments/transaction-svc/internal/usecase"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithdrawalStateMachine(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	mockDB := mockdb.NewMockDB(ctrl)
+	mockTx := mockdb.NewMockTx(ctrl)
+	mockWalletRepo := mockrepository.NewMockWalletRepository(ctrl)
+	mockLedgerRepo := mockrepository.NewMockLedgerRepository(ctrl)
+	mockWithdrawalRepo := mockrepository.NewMockWithdrawalRepository(ctrl)
+	mockDisbursementAdapter := mockadapter.NewMockDisbursementAdapter(ctrl)
+
+	walletUC := usecase.NewWalletUsecase(mockDB, mockWalletRepo, mockLedgerRepo, mockWithdrawalRepo, mockDisbursementAdapter)
+
+	expectWallets(mockWalletRepo)
+
+	// stored is the withdrawal row, every locked read returns it and every update changes it
+	var stored entity.Withdrawal
+	newWithdrawal := func(status enum.WithdrawalStatus, claimedAt *time.Time) {
+		stored = entity.Withdrawal{Id: "wd-1", UserId: "creator-1", Amount: 50000, Status: status, DisbursementClaimedAt: claimedAt}
+	}
+	var updates []entity.Withdrawal
+	expectUpdates := func(times int) {
+		updates = nil
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil).Times(times)
+		mockTx.EXPECT().Rollback(ctx).Return(nil).Times(times)
+		mockWithdrawalRepo.EXPECT().FindByIdForUpdate(ctx, mockTx, "wd-1").
+			DoAndReturn(func(ctx context.Context, db repository.Querier, id string) (*entity.Withdrawal, error) {
+				withdrawal := stored
+				return &withdrawal, nil
+			}).Times(times)
+	}
+	expectSaves := func(times int) {
+		mockWithdrawalRepo.EXPECT().Update(ctx, mockTx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, db repository.Querier, withdrawal *entity.Withdrawal) error {
+				stored = *withdrawal
+				updates = append(updates, *withdrawal)
+				return nil
+			}).Times(times)
+		mockTx.EXPECT().Commit(ctx).Return(nil).Times(times)
+	}
+
+	t.Run("Approve claims the withdrawal before paying it out", func(t *testing.T) {
+		newWithdrawal(enum.WithdrawalStatusRequested, nil)
+		expectUpdates(2)
+		expectSaves(2)
+
+		mockDisbursementAdapter.EXPECT().Disburse(ctx, "withdrawal-wd-1", gomock.Any()).
+			DoAndReturn(func(ctx context.Context, idempotencyKey string, withdrawal *entity.Withdrawal) (string, error) {
+				// the claim is committed before the provider is called
+				assert.Equal(t, enum.WithdrawalStatusDisbursing, stored.Status)
+				assert.NotNil(t, stored.DisbursementClaimedAt)
+				return "ref-1", nil
+			})
+
+		var entries []*entity.LedgerEntry
+		mockLedgerRepo.EXPECT().CreateEntries(ctx, mockTx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, db repository.Querier, created []*entity.LedgerEntry) error {
+				entries = created
+				return nil
+			})
+
+		resp, err := walletUC.ApproveWithdrawal(ctx, "wd-1")
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+
+		assert.Equal(t, enum.WithdrawalStatusDisbursing, updates[0].Status)
+		assert.NotNil(t, updates[0].ApprovedAt)
+		assert.Equal(t, enum.WithdrawalStatusPaid, stored.Status)
+		assert.Equal(t, "ref-1", stored.DisbursementReference)
+		assert.Nil(t, stored.DisbursementClaimedAt)
+		assert.NotNil(t, stored.PaidAt)
+
+		amounts, sum := entryAmounts(entries)
+		assert.Equal(t, int64(0), sum)
+		assert.Equal(t, int64(-50000), amounts[walletIdOf("creator-1", enum.WalletTypeCreatorHold)])
+		assert.Equal(t, int64(50000), amounts[walletIdOf(enum.PlatformWalletOwnerId, enum.WalletTypePlatformClearing)])
+	})
+
+	t.Run("Approve while another approval is disbursing", func(t *testing.T) {
+		claimedAt := time.Now().Add(-time.Minute)
+		newWithdrawal(enum.WithdrawalStatusDisbursing, &claimedAt)
+		expectUpdates(1)
+
+		resp, err := walletUC.ApproveWithdrawal(ctx, "wd-1")
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.Equal(t, fiber.StatusConflict, err.(*fiber.Error).Code)
+		assert.Equal(t, enum.WithdrawalStatusDisbursing, stored.Status)
+	})
+
+	t.Run("Approve takes over a stale claim with the same idempotency key", func(t *testing.T) {
+		claimedAt := time.Now().Add(-time.Hour)
+		newWithdrawal(enum.WithdrawalStatusDisbursing, &claimedAt)
+		expectUpdates(2)
+		expectSaves(2)
+
+		mockDisbursementAdapter.EXPECT().Disburse(ctx, "withdrawal-wd-1", gomock.Any()).Return("ref-1", nil)
+		mockLedgerRepo.EXPECT().CreateEntries(ctx, mockTx, gomock.Any()).Return(nil)
+
+		_, err := walletUC.ApproveWithdrawal(ctx, "wd-1")
+		assert.NoError(t, err)
+		assert.True(t, updates[0].DisbursementClaimedAt.After(claimedAt))
+		assert.Equal(t, enum.WithdrawalStatusPaid, stored.Status)
+	})
+
+	t.Run("Failed payout stays disbursing and releases the claim", func(t *testing.T) {
+		newWithdrawal(enum.WithdrawalStatusRequested, nil)
+		expectUpdates(2)
+		expectSaves(2)
+
+		mockDisbursementAdapter.EXPECT().Disburse(ctx, "withdrawal-wd-1", gomock.Any()).Return("", errors.New("provider down"))
+
+		resp, err := walletUC.ApproveWithdrawal(ctx, "wd-1")
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.Equal(t, fiber.StatusBadGateway, err.(*fiber.Error).Code)
+		assert.Equal(t, enum.WithdrawalStatusDisbursing, stored.Status)
+		assert.Nil(t, stored.DisbursementClaimedAt)
+	})
+
+	t.Run("Approve a paid withdrawal", func(t *testing.T) {
+		newWithdrawal(enum.WithdrawalStatusPaid, nil)
+		expectUpdates(1)
+
+		_, err := walletUC.ApproveWithdrawal(ctx, "wd-1")
+		assert.Error(t, err)
+		assert.Equal(t, fiber.StatusConflict, err.(*fiber.Error).Code)
+	})
+
+	t.Run("Reject gives the held funds back", func(t *testing.T) {
+		newWithdrawal(enum.WithdrawalStatusRequested, nil)
+		expectUpdates(1)
+		expectSaves(1)
+
+		var entries []*entity.LedgerEntry
+		mockLedgerRepo.EXPECT().CreateEntries(ctx, mockTx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, db repository.Querier, created []*entity.LedgerEntry) error {
+				entries = created
+				return nil
+			})
+
+		_, err := walletUC.RejectWithdrawal(ctx, &model.RejectWithdrawalRequest{Id: "wd-1", Reason: "wrong account"})
+		assert.NoError(t, err)
+		assert.Equal(t, enum.WithdrawalStatusRejected, stored.Status)
+
+		amounts, sum := entryAmounts(entries)
+		assert.Equal(t, int64(0), sum)
+		assert.Equal(t, int64(50000), amounts[walletIdOf("creator-1", enum.WalletTypeCreator)])
+		assert.Equal(t, int64(-50000), amounts[walletIdOf("creator-1", enum.WalletTypeCreatorHold)])
+	})
+
+	t.Run("Reject a withdrawal that may already be paid", func(t *testing.T) {
+		for _, status := range []enum.WithdrawalStatus{enum.WithdrawalStatusApproved, enum.WithdrawalStatusDisbursing, enum.WithdrawalStatusPaid} {
+			newWithdrawal(status, nil)
+			expectUpdates(1)
+
+			_, err := walletUC.RejectWithdrawal(ctx, &model.RejectWithdrawalRequest{Id: "wd-1", Reason: "wrong account"})
+			assert.Error(t, err)
+			assert.Equal(t, fiber.StatusConflict, err.(*fiber.Error).Code)
+			assert.Equal(t, status, stored.Status)
+		}
+	})
+}
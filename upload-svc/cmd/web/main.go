@@ -5,6 +5,8 @@ import (
 	"be-yourmoments/upload-svc/internal/config"
 	grpcHandler "be-yourmoments/upload-svc/internal/delivery/grpc"
 	"be-yourmoments/upload-svc/internal/delivery/http"
+	"be-yourmoments/upload-svc/internal/delivery/http/middleware"
+	"be-yourmoments/upload-svc/internal/delivery/worker"
 	discovery "be-yourmoments/upload-svc/internal/helper"
 	"os"
 	"os/signal"
@@ -12,6 +14,7 @@ import (
 
 	"be-yourmoments/upload-svc/internal/helper/consul"
 	"be-yourmoments/upload-svc/internal/helper/logger"
+	"be-yourmoments/upload-svc/internal/repository"
 	"be-yourmoments/upload-svc/internal/usecase"
 	"net"
 
@@ -30,12 +33,16 @@ import (
 var logs = logger.New("main")
 
 func webServer() error {
+	serverConfig := config.NewServerConfig()
 	app := fiber.New(
-		fiber.Config{BodyLimit: 100 * 1024 * 1024},
+		fiber.Config{BodyLimit: serverConfig.BodyLimit},
 	)
 
-	serverConfig := config.NewServerConfig()
 	minioConfig := config.NewMinio()
+	dbConfig := config.NewPostgresDatabase()
+	renditionConfig := config.NewRenditionConfig()
+	watermarkConfig := config.NewWatermarkConfig()
+	defer dbConfig.Close()
 
 	registry, err := consul.NewRegistry(serverConfig.ConsulAddr, serverConfig.Name)
 	if err != nil {
@@ -113,16 +120,44 @@ func webServer() error {
 		logs.Error(err)
 	}
 
+	userAdapter, err := adapter.NewUserAdapter(ctx, registry)
+	if err != nil {
+		logs.Error(err)
+	}
+
 	logs.Log(fmt.Sprintf("Success connected http service at port: %v", serverConfig.HTTP))
 
 	storageAdapter := adapter.NewStorageAdapter(minioConfig)
 	compressAdapter := adapter.NewCompressAdapter()
 
-	photoUsecase := usecase.NewPhotoUsecase(aiAdapter, photoAdapter, storageAdapter, compressAdapter)
-	photoController := http.NewPhotoController(photoUsecase)
+	compressionJobRepo := repository.NewCompressionJobRepository()
+	watermarkRepo := repository.NewWatermarkRepository()
+	uploadBatchRepo := repository.NewUploadBatchRepository()
+	uploadSessionRepo := repository.NewUploadSessionRepository()
 
-	facecamUsecase := usecase.NewFacecamUseCase(aiAdapter, photoAdapter, storageAdapter, compressAdapter)
-	facecamController := http.NewFacecamController(facecamUsecase)
+	compressionUsecase := usecase.NewCompressionUsecase(dbConfig, compressionJobRepo, aiAdapter, photoAdapter,
+		storageAdapter, compressAdapter, watermarkRepo, renditionConfig, watermarkConfig, serverConfig.CompressJobTimeout)
+	compressionWorker := worker.NewCompressionWorker(compressionUsecase, serverConfig.CompressWorkers)
+	compressionWorker.Start()
+
+	photoUsecase := usecase.NewPhotoUsecase(dbConfig, compressionJobRepo, uploadBatchRepo, uploadSessionRepo,
+		photoAdapter, storageAdapter, serverConfig)
+	authMiddleware := middleware.NewUserAuth(userAdapter)
+
+	photoController := http.NewPhotoController(photoUsecase, authMiddleware)
+
+	go func() {
+		for {
+			time.Sleep(10 * time.Minute)
+			photoUsecase.AbortExpiredUploadSessions(ctx)
+		}
+	}()
+
+	facecamUsecase := usecase.NewFacecamUseCase(dbConfig, compressionJobRepo, storageAdapter)
+	facecamController := http.NewFacecamController(facecamUsecase, authMiddleware)
+
+	watermarkUsecase := usecase.NewWatermarkUsecase(dbConfig, watermarkRepo, storageAdapter, watermarkConfig)
+	watermarkController := http.NewWatermarkController(watermarkUsecase, authMiddleware)
 
 	go func() {
 		// gRPC server + reflection
@@ -149,10 +184,29 @@ func webServer() error {
 
 	photoController.PhotoRoute(app)
 	facecamController.FacecamRoute(app)
+	watermarkController.WatermarkRoute(app)
 	logs.Log(fmt.Sprintf("Succsess connected http service at port: %v", serverConfig.HTTP))
 
+	go func() {
+		sigchan := make(chan os.Signal, 1)
+		signal.Notify(sigchan, syscall.SIGINT, syscall.SIGTERM)
+
+		sig := <-sigchan
+		logs.Log(fmt.Sprintf("Received signal: %s. Shutting down gracefully...", sig))
+		if err := app.Shutdown(); err != nil {
+			logs.Error(fmt.Sprintf("Failed to shut down HTTP server: %v", err))
+		}
+	}()
+
 	err = app.Listen(serverConfig.HTTP)
 
+	// no new uploads come in any more, let the running compression jobs finish
+	drainCtx, cancel := context.WithTimeout(context.Background(), serverConfig.ShutdownTimeout)
+	defer cancel()
+	if drainErr := compressionWorker.Shutdown(drainCtx); drainErr != nil {
+		logs.Error(fmt.Sprintf("Compression jobs still running at shutdown are retried later: %v", drainErr))
+	}
+
 	if err != nil {
 		logs.Error(fmt.Sprintf("Failed to start HTTP category server: %v", err))
 		return err
@@ -165,10 +219,5 @@ func main() {
 		logs.Error(err)
 	}
 
-	logs.Log("Api gateway server started")
-	sigchan := make(chan os.Signal, 1)
-	signal.Notify(sigchan, syscall.SIGINT, syscall.SIGTERM)
-
-	sig := <-sigchan
-	logs.Log(fmt.Sprintf("Received signal: %s. Shutting down gracefully...", sig))
+	logs.Log("Upload service stopped")
 }
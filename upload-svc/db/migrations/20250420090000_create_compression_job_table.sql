@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE compression_job_type AS ENUM ('PHOTO', 'FACECAM');
+
+CREATE TYPE compression_job_status AS ENUM ('PENDING', 'PROCESSING', 'DONE', 'DEAD');
+
+CREATE TABLE IF NOT EXISTS compression_jobs (
+    id CHAR(26) PRIMARY KEY NOT NULL,
+    type compression_job_type NOT NULL,
+    status compression_job_status NOT NULL DEFAULT 'PENDING',
+    photo_id CHAR(26),
+    user_id CHAR(26),
+    file_key VARCHAR(255) NOT NULL,
+    file_name VARCHAR(255) NOT NULL,
+    checksum VARCHAR(64),
+    result_file_key VARCHAR(255),
+    attempts INT NOT NULL DEFAULT 0,
+    max_attempts INT NOT NULL,
+    last_error TEXT,
+    run_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    locked_until TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_compression_jobs_pending ON compression_jobs(run_at) WHERE status = 'PENDING';
+
+CREATE INDEX IF NOT EXISTS idx_compression_jobs_processing ON compression_jobs(locked_until) WHERE status = 'PROCESSING';
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS compression_jobs;
+
+DROP TYPE compression_job_status;
+
+DROP TYPE compression_job_type;
+
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS creator_watermarks (
+    creator_id CHAR(26) PRIMARY KEY NOT NULL,
+    text VARCHAR(100),
+    logo_file_key VARCHAR(255),
+    opacity REAL NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS creator_watermarks;
+
+-- +goose StatementEnd
@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE upload_batch_item_status AS ENUM ('ACCEPTED', 'DUPLICATE', 'INVALID_FORMAT', 'TOO_LARGE', 'FAILED');
+
+CREATE TABLE IF NOT EXISTS upload_batches (
+    id CHAR(26) PRIMARY KEY NOT NULL,
+    creator_id CHAR(26) NOT NULL,
+    event_id CHAR(26),
+    total_files INT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp
+);
+
+CREATE TABLE IF NOT EXISTS upload_batch_items (
+    id CHAR(26) PRIMARY KEY NOT NULL,
+    batch_id CHAR(26) NOT NULL REFERENCES upload_batches(id) ON DELETE CASCADE,
+    file_name VARCHAR(255) NOT NULL,
+    size BIGINT NOT NULL,
+    checksum VARCHAR(64),
+    status upload_batch_item_status NOT NULL,
+    photo_id CHAR(26),
+    compression_job_id CHAR(26),
+    error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_upload_batch_items_batch_id ON upload_batch_items(batch_id);
+
+-- photo originals are looked up by checksum to reject duplicates of a photographer's earlier uploads
+CREATE INDEX IF NOT EXISTS idx_compression_jobs_user_checksum ON compression_jobs(user_id, checksum) WHERE type = 'PHOTO';
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_compression_jobs_user_checksum;
+
+DROP TABLE IF EXISTS upload_batch_items;
+
+DROP TABLE IF EXISTS upload_batches;
+
+DROP TYPE upload_batch_item_status;
+
+-- +goose StatementEnd
@@ -0,0 +1,50 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE upload_session_status AS ENUM ('ACTIVE', 'COMPLETED', 'ABORTED');
+
+CREATE TABLE IF NOT EXISTS upload_sessions (
+    id CHAR(26) PRIMARY KEY NOT NULL,
+    creator_id CHAR(26) NOT NULL,
+    event_id CHAR(26),
+    price INT NOT NULL DEFAULT 0,
+    price_str VARCHAR(50),
+    file_name VARCHAR(255) NOT NULL,
+    file_type VARCHAR(10) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    file_key VARCHAR(255) NOT NULL,
+    storage_upload_id VARCHAR(255) NOT NULL,
+    size BIGINT NOT NULL,
+    upload_offset BIGINT NOT NULL DEFAULT 0,
+    chunk_size BIGINT NOT NULL,
+    hash_state BYTEA,
+    width INT NOT NULL DEFAULT 0,
+    height INT NOT NULL DEFAULT 0,
+    assembled BOOLEAN NOT NULL DEFAULT FALSE,
+    status upload_session_status NOT NULL DEFAULT 'ACTIVE',
+    photo_id CHAR(26),
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_upload_sessions_active_expires_at ON upload_sessions(expires_at) WHERE status = 'ACTIVE';
+
+CREATE TABLE IF NOT EXISTS upload_session_parts (
+    session_id CHAR(26) NOT NULL REFERENCES upload_sessions(id) ON DELETE CASCADE,
+    part_number INT NOT NULL,
+    etag VARCHAR(255) NOT NULL,
+    size BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    PRIMARY KEY (session_id, part_number)
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS upload_session_parts;
+
+DROP TABLE IF EXISTS upload_sessions;
+
+DROP TYPE upload_session_status;
+
+-- +goose StatementEnd
@@ -1,65 +1,154 @@
 package adapter
 
 import (
-	"be-yourmoments/upload-svc/internal/helper/utils"
+	"be-yourmoments/upload-svc/internal/config"
+	"be-yourmoments/upload-svc/internal/model"
+	"crypto/sha256"
 	"fmt"
 	"io"
 	"log"
-	"mime/multipart"
-	"os"
-	"strconv"
 
 	"github.com/h2non/bimg"
 )
 
 type CompressAdapter interface {
-	CompressImage(originalFile *multipart.FileHeader, uploadFile multipart.File, dirname string) (string, string, error)
+	// RenderImage reads the original once and encodes every rendition from it, in the given order.
+	// Renditions marked for it get the watermark, a nil watermark leaves them clean.
+	RenderImage(original io.Reader, renditions []config.Rendition, watermark *model.Watermark) ([]*model.ImageRendition, error)
 }
 
 type compressAdapter struct {
-	compressQuality int
 }
 
 func NewCompressAdapter() CompressAdapter {
-	compressQuality, _ := strconv.Atoi(utils.GetEnv("COMPRESS_QUALITY")) //75
+	return &compressAdapter{}
+}
 
-	return &compressAdapter{
-		compressQuality: compressQuality,
-	}
+var renditionImageTypes = map[string]bimg.ImageType{
+	"JPG":  bimg.JPEG,
+	"PNG":  bimg.PNG,
+	"WEBP": bimg.WEBP,
 }
 
-func (a *compressAdapter) CompressImage(originalFile *multipart.FileHeader, uploadFile multipart.File, dirname string) (string, string, error) {
-	log.Println("open in compress image")
+var renditionMimetypes = map[string]string{
+	"JPG":  "image/jpeg",
+	"PNG":  "image/png",
+	"WEBP": "image/webp",
+}
 
-	buffer, err := io.ReadAll(uploadFile)
+func (a *compressAdapter) RenderImage(original io.Reader, renditions []config.Rendition, watermark *model.Watermark) ([]*model.ImageRendition, error) {
+	buffer, err := io.ReadAll(original)
 	if err != nil {
-		log.Println("error in compress image")
-		return "", "", err
+		log.Println("error in render image")
+		return nil, err
 	}
 
-	filename := fmt.Sprint("Compressed_" + originalFile.Filename)
-
-	options := bimg.Options{
-		Quality: a.compressQuality,
+	// rotate once up front so every rendition is sized on the orientation people see
+	buffer, err = bimg.NewImage(buffer).AutoRotate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
 	}
 
-	processed, err := bimg.NewImage(buffer).Process(options)
+	size, err := bimg.NewImage(buffer).Size()
 	if err != nil {
-		return filename, "", err
+		return nil, fmt.Errorf("failed to read image size: %w", err)
+	}
+
+	result := make([]*model.ImageRendition, 0, len(renditions))
+	for _, rendition := range renditions {
+		options := bimg.Options{
+			Type:          renditionImageTypes[rendition.Format],
+			Quality:       rendition.Quality,
+			StripMetadata: true,
+			NoAutoRotate:  true,
+		}
+
+		// fit the longer side, smaller originals keep their size
+		width, height := size.Width, size.Height
+		if rendition.MaxSize > 0 && max(width, height) > rendition.MaxSize {
+			if width >= height {
+				width, height = rendition.MaxSize, max(1, height*rendition.MaxSize/width)
+			} else {
+				width, height = max(1, width*rendition.MaxSize/height), rendition.MaxSize
+			}
+			options.Width = width
+			options.Height = height
+		}
+
+		if rendition.Watermark && watermark != nil {
+			if err := a.applyWatermark(&options, watermark, width, height); err != nil {
+				return nil, fmt.Errorf("failed to watermark %s: %w", rendition.Type, err)
+			}
+		}
+
+		data, err := bimg.Resize(buffer, options)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render %s: %w", rendition.Type, err)
+		}
+
+		renderedSize, err := bimg.NewImage(data).Size()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s size: %w", rendition.Type, err)
+		}
+
+		result = append(result, &model.ImageRendition{
+			Type:     rendition.Type,
+			Format:   rendition.Format,
+			Mimetype: renditionMimetypes[rendition.Format],
+			Width:    renderedSize.Width,
+			Height:   renderedSize.Height,
+			Checksum: fmt.Sprintf("%x", sha256.Sum256(data)),
+			Data:     data,
+		})
 	}
 
-	filePath := fmt.Sprintf("./%s/%s", dirname, filename)
+	return result, nil
+}
+
+// watermarkTextTiles is how many copies of the text fit across the rendition, with a gap of half a
+// copy between them.
+const watermarkTextTiles = 4
 
-	// Pastikan direktori ada
-	if err := os.MkdirAll(dirname, os.ModePerm); err != nil {
-		log.Println("error creating directory:", err)
-		return filename, "", err
+// applyWatermark tiles the text over the whole rendition and centers the logo on it, fitted into a
+// third of the rendition's size.
+func (a *compressAdapter) applyWatermark(options *bimg.Options, watermark *model.Watermark, width int, height int) error {
+	if watermark.Text != "" {
+		// libvips repeats the text block, wrapped at Width and padded by Margin, until it covers the image
+		tileWidth := max(1, 2*width/(3*watermarkTextTiles))
+		options.Watermark = bimg.Watermark{
+			Text:        watermark.Text,
+			Width:       tileWidth,
+			Margin:      max(1, tileWidth/2),
+			Opacity:     watermark.Opacity,
+			NoReplicate: false,
+			Background:  bimg.Color{R: 255, G: 255, B: 255},
+		}
+	}
+
+	if len(watermark.Logo) == 0 {
+		return nil
+	}
+
+	logo, err := bimg.Resize(watermark.Logo, bimg.Options{
+		Width:  max(1, width/3),
+		Height: max(1, height/3),
+		Type:   bimg.PNG,
+	})
+	if err != nil {
+		return err
+	}
+
+	logoSize, err := bimg.NewImage(logo).Size()
+	if err != nil {
+		return err
 	}
 
-	if err := bimg.Write(filePath, processed); err != nil {
-		log.Println("error when writing")
-		return filename, "", err
+	options.WatermarkImage = bimg.WatermarkImage{
+		Left:    max(0, (width-logoSize.Width)/2),
+		Top:     max(0, (height-logoSize.Height)/2),
+		Buf:     logo,
+		Opacity: watermark.Opacity,
 	}
 
-	return filename, filePath, nil
+	return nil
 }
@@ -5,13 +5,14 @@ import (
 	discovery "be-yourmoments/upload-svc/internal/helper"
 	"be-yourmoments/upload-svc/internal/pb"
 	"context"
-	"errors"
 
+	"github.com/gofiber/fiber/v2"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type PhotoAdapter interface {
 	CreatePhoto(ctx context.Context, photo *entity.Photo, facecam *entity.PhotoDetail) error
+	DeletePhoto(ctx context.Context, photoId string, creatorId string) error
 	UpdatePhotoDetail(ctx context.Context, facecam *entity.PhotoDetail) error
 	CreateFacecam(ctx context.Context, facecam *entity.Facecam) error
 }
@@ -61,6 +62,7 @@ func (a *photoAdapter) CreatePhoto(ctx context.Context, photo *entity.Photo, fac
 		CreatorId:     photo.CreatorId,
 		Title:         photo.Title,
 		CollectionUrl: photo.CollectionUrl,
+		EventId:       photo.EventId,
 		Price:         int32(photo.Price),
 		PriceStr:      photo.PriceStr,
 
@@ -85,8 +87,31 @@ func (a *photoAdapter) CreatePhoto(ctx context.Context, photo *entity.Photo, fac
 	}
 
 	res, err := a.client.CreatePhoto(context.Background(), pbRequest)
-	if res.Status >= 400 || res.Error != "" || err != nil {
-		return errors.New(res.Error)
+	if err != nil {
+		return err
+	}
+
+	if res.Status >= 400 || res.Error != "" {
+		return fiber.NewError(int(res.Status), res.Error)
+	}
+
+	return nil
+}
+
+// DeletePhoto unpublishes a photo of the creator, photo-svc also removes its files from storage.
+func (a *photoAdapter) DeletePhoto(ctx context.Context, photoId string, creatorId string) error {
+	pbRequest := &pb.DeletePhotoRequest{
+		Id:        photoId,
+		CreatorId: creatorId,
+	}
+
+	res, err := a.client.DeletePhoto(ctx, pbRequest)
+	if err != nil {
+		return err
+	}
+
+	if res.Status >= 400 || res.Error != "" {
+		return fiber.NewError(int(res.Status), res.Error)
 	}
 
 	return nil
@@ -119,9 +144,13 @@ func (a *photoAdapter) UpdatePhotoDetail(ctx context.Context, facecam *entity.Ph
 		PhotoDetail: facecampb,
 	}
 
-	res, err := a.client.UpdatePhotoDetail(context.Background(), pbRequest)
-	if res.Status >= 400 || res.Error != "" || err != nil {
-		return errors.New(res.Error)
+	res, err := a.client.UpdatePhotoDetail(ctx, pbRequest)
+	if err != nil {
+		return err
+	}
+
+	if res.Status >= 400 || res.Error != "" {
+		return fiber.NewError(int(res.Status), res.Error)
 	}
 
 	return nil
@@ -151,9 +180,13 @@ func (a *photoAdapter) CreateFacecam(ctx context.Context, facecam *entity.Faceca
 		Facecam: facecamPb,
 	}
 
-	res, err := a.client.CreateFacecam(context.Background(), pbRequest)
-	if res.Status >= 400 || res.Error != "" || err != nil {
-		return errors.New(res.Error)
+	res, err := a.client.CreateFacecam(ctx, pbRequest)
+	if err != nil {
+		return err
+	}
+
+	if res.Status >= 400 || res.Error != "" {
+		return fiber.NewError(int(res.Status), res.Error)
 	}
 
 	return nil
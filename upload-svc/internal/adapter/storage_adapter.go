@@ -2,10 +2,12 @@ package adapter
 
 import (
 	"be-yourmoments/upload-svc/internal/config"
+	"be-yourmoments/upload-svc/internal/entity"
 	"be-yourmoments/upload-svc/internal/model"
 
 	"context"
 	"fmt"
+	"io"
 	"mime/multipart"
 	"time"
 
@@ -16,6 +18,16 @@ import (
 type StorageAdapter interface {
 	UploadFile(ctx context.Context, file *multipart.FileHeader, uploadFile multipart.File, path string) (*model.MinioFileResponse, error)
 	DeleteFile(ctx context.Context, fileName string) (bool, error)
+	// OpenFile streams a stored file, errors such as a missing key only surface on the first read.
+	OpenFile(ctx context.Context, fileKey string) (multipart.File, error)
+	PresignFile(ctx context.Context, fileKey string, expiry time.Duration) (string, error)
+	// NewMultipartUpload starts an object that is sent in parts and gives its file key and upload id.
+	// Every part but the last must be at least 5 MB.
+	NewMultipartUpload(ctx context.Context, fileName string, contentType string, path string) (string, string, error)
+	// PutObjectPart stores one part and gives its etag, sending a part number again replaces it.
+	PutObjectPart(ctx context.Context, fileKey string, uploadId string, partNumber int, data io.Reader, size int64) (string, error)
+	CompleteMultipartUpload(ctx context.Context, fileKey string, uploadId string, parts *[]*entity.UploadSessionPart) error
+	AbortMultipartUpload(ctx context.Context, fileKey string, uploadId string) error
 }
 
 type storageAdapter struct {
@@ -75,3 +87,79 @@ func (a *storageAdapter) DeleteFile(ctx context.Context, fileName string) (bool,
 
 	return true, nil
 }
+
+func (a *storageAdapter) OpenFile(ctx context.Context, fileKey string) (multipart.File, error) {
+	object, err := a.minio.MinioClient.GetObject(ctx, a.minio.GetBucketName(), fileKey, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+
+	return object, nil
+}
+
+func (a *storageAdapter) PresignFile(ctx context.Context, fileKey string, expiry time.Duration) (string, error) {
+	fileURL, err := a.minio.MinioClient.PresignedGetObject(ctx, a.minio.GetBucketName(), fileKey, expiry, nil)
+	if err != nil {
+		a.minio.Logs.Error("failed to generate presigned URL:" + err.Error())
+
+		return "", fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	return fileURL.String(), nil
+}
+
+func (a *storageAdapter) NewMultipartUpload(ctx context.Context, fileName string, contentType string, path string) (string, string, error) {
+	fileKey := path + string(RandomNumber(31)) + "_" + fileName
+
+	core := minio.Core{Client: a.minio.MinioClient}
+	uploadId, err := core.NewMultipartUpload(ctx, a.minio.GetBucketName(), fileKey, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		a.minio.Logs.Error("failed to start multipart upload: " + err.Error())
+
+		return "", "", fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	return fileKey, uploadId, nil
+}
+
+func (a *storageAdapter) PutObjectPart(ctx context.Context, fileKey string, uploadId string, partNumber int, data io.Reader, size int64) (string, error) {
+	core := minio.Core{Client: a.minio.MinioClient}
+	part, err := core.PutObjectPart(ctx, a.minio.GetBucketName(), fileKey, uploadId, partNumber, data, size, minio.PutObjectPartOptions{})
+	if err != nil {
+		a.minio.Logs.Error("failed to upload part: " + err.Error())
+
+		return "", fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	return part.ETag, nil
+}
+
+func (a *storageAdapter) CompleteMultipartUpload(ctx context.Context, fileKey string, uploadId string, parts *[]*entity.UploadSessionPart) error {
+	completeParts := make([]minio.CompletePart, 0, len(*parts))
+	for _, part := range *parts {
+		completeParts = append(completeParts, minio.CompletePart{
+			PartNumber: part.PartNumber,
+			ETag:       part.ETag,
+		})
+	}
+
+	core := minio.Core{Client: a.minio.MinioClient}
+	if _, err := core.CompleteMultipartUpload(ctx, a.minio.GetBucketName(), fileKey, uploadId, completeParts, minio.PutObjectOptions{}); err != nil {
+		a.minio.Logs.Error("failed to complete multipart upload: " + err.Error())
+
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+func (a *storageAdapter) AbortMultipartUpload(ctx context.Context, fileKey string, uploadId string) error {
+	core := minio.Core{Client: a.minio.MinioClient}
+	if err := core.AbortMultipartUpload(ctx, a.minio.GetBucketName(), fileKey, uploadId); err != nil {
+		return fmt.Errorf("failed to abort multipart upload: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package config
+
+import (
+	"be-yourmoments/upload-svc/internal/enum"
+	"be-yourmoments/upload-svc/internal/helper/utils"
+	"log"
+	"strconv"
+	"strings"
+)
+
+// Rendition is one output of the image pipeline. MaxSize bounds the longer side in pixels, 0 keeps
+// the original size. Format is JPG, PNG or WEBP. Watermark draws the creator's watermark on it.
+type Rendition struct {
+	Type      enum.YourMomentsType
+	MaxSize   int
+	Format    string
+	Quality   int
+	Watermark bool
+}
+
+// NewRenditionConfig reads RENDITIONS as comma separated TYPE:MAX_SIZE:FORMAT:QUALITY[:WATERMARK]
+// entries, like THUMBNAIL:320:WEBP:70:WATERMARK,PREVIEW:1280:JPG:80,ISYOU:1280:JPG:80,COMPRESSED:0:JPG:75.
+// COMPRESSED is required, it is the rendition the ai service works on. ISYOU is what the matched
+// feed shows until a photo is bought, so it is always watermarked. Without RENDITIONS that example
+// is used, with COMPRESS_QUALITY as the quality of COMPRESSED.
+func NewRenditionConfig() []Rendition {
+	value := utils.GetEnv("RENDITIONS")
+	if value == "" {
+		quality, err := strconv.Atoi(utils.GetEnv("COMPRESS_QUALITY"))
+		if err != nil || quality <= 0 {
+			quality = 75
+		}
+		value = "THUMBNAIL:320:WEBP:70:WATERMARK,PREVIEW:1280:JPG:80,ISYOU:1280:JPG:80,COMPRESSED:0:JPG:" +
+			strconv.Itoa(quality)
+	}
+
+	var renditions []Rendition
+	hasCompressed := false
+	for _, entry := range strings.Split(value, ",") {
+		parts := strings.Split(strings.TrimSpace(entry), ":")
+		if len(parts) != 4 && len(parts) != 5 {
+			log.Fatalf("RENDITIONS entry %q must look like TYPE:MAX_SIZE:FORMAT:QUALITY[:WATERMARK]", entry)
+		}
+
+		rendition := Rendition{
+			Type:   enum.YourMomentsType(strings.ToUpper(parts[0])),
+			Format: strings.ToUpper(parts[2]),
+		}
+		switch rendition.Type {
+		case enum.YourMomentTypeThumbnail, enum.YourMomentTypePreview, enum.YourMomentTypeCompressed:
+		case enum.YourMomentTypeIsYou:
+			rendition.Watermark = true
+		default:
+			log.Fatalf("RENDITIONS type %s must be THUMBNAIL, PREVIEW, ISYOU or COMPRESSED", parts[0])
+		}
+		if len(parts) == 5 {
+			if !strings.EqualFold(parts[4], "WATERMARK") {
+				log.Fatalf("RENDITIONS flag %s must be WATERMARK", parts[4])
+			}
+			if rendition.Type == enum.YourMomentTypeCompressed {
+				log.Fatal("RENDITIONS COMPRESSED is what the ai service works on and cannot be watermarked")
+			}
+			rendition.Watermark = true
+		}
+		switch rendition.Format {
+		case "JPG", "PNG", "WEBP":
+		default:
+			log.Fatalf("RENDITIONS format %s must be JPG, PNG or WEBP", parts[2])
+		}
+
+		var err error
+		if rendition.MaxSize, err = strconv.Atoi(parts[1]); err != nil || rendition.MaxSize < 0 {
+			log.Fatalf("RENDITIONS max size %s must be 0 or a positive number of pixels", parts[1])
+		}
+		if rendition.Quality, err = strconv.Atoi(parts[3]); err != nil || rendition.Quality <= 0 || rendition.Quality > 100 {
+			log.Fatalf("RENDITIONS quality %s must be between 1 and 100", parts[3])
+		}
+
+		hasCompressed = hasCompressed || rendition.Type == enum.YourMomentTypeCompressed
+		renditions = append(renditions, rendition)
+	}
+
+	if !hasCompressed {
+		log.Fatal("RENDITIONS must contain a COMPRESSED rendition")
+	}
+
+	return renditions
+}
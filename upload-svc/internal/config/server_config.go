@@ -4,6 +4,8 @@ import (
 	"be-yourmoments/upload-svc/internal/helper/utils"
 	"fmt"
 	"log"
+	"strconv"
+	"time"
 )
 
 var EndpointPrefix = utils.GetEnv("ENDPOINT_PREFIX")
@@ -17,6 +19,25 @@ type ServerConfig struct {
 	GRPCPort   string
 	ConsulAddr string
 	Name       string
+	// CompressWorkers is how many compression jobs run at once, 4 by default.
+	CompressWorkers int
+	// CompressJobTimeout bounds one attempt of a compression job, 10 minutes by default.
+	CompressJobTimeout time.Duration
+	// ShutdownTimeout is how long running compression jobs get to finish on shutdown, 30 seconds by default.
+	ShutdownTimeout time.Duration
+	// BodyLimit bounds a whole request in bytes, 1 GB by default so batch uploads fit.
+	BodyLimit int
+	// PhotoMaxSize bounds one photo in bytes, 50 MB by default.
+	PhotoMaxSize int64
+	// BatchMaxFiles is how many files one batch upload may hold, 500 by default.
+	BatchMaxFiles int
+	// ResumableMaxSize bounds one resumable upload in bytes, 500 MB by default.
+	ResumableMaxSize int64
+	// UploadChunkSize is the size of every chunk of a resumable upload but the last, 8 MB by default.
+	// Storage needs at least 5 MB per part.
+	UploadChunkSize int64
+	// UploadSessionTTL is how long a resumable upload waits for its next chunk, 24 hours by default.
+	UploadSessionTTL time.Duration
 }
 
 func NewServerConfig() ServerConfig {
@@ -44,6 +65,69 @@ func NewServerConfig() ServerConfig {
 	if name == "" {
 		log.Fatal("SERVICE_NAME environment variable is not set")
 	}
+	compressWorkers := 4
+	if value := utils.GetEnv("COMPRESS_WORKERS"); value != "" {
+		var err error
+		if compressWorkers, err = strconv.Atoi(value); err != nil || compressWorkers <= 0 {
+			log.Fatal("COMPRESS_WORKERS must be a positive number")
+		}
+	}
+	compressJobTimeout := 10 * time.Minute
+	if value := utils.GetEnv("COMPRESS_JOB_TIMEOUT"); value != "" {
+		var err error
+		if compressJobTimeout, err = time.ParseDuration(value); err != nil || compressJobTimeout <= 0 {
+			log.Fatal("COMPRESS_JOB_TIMEOUT must be a positive duration like 10m")
+		}
+	}
+	shutdownTimeout := 30 * time.Second
+	if value := utils.GetEnv("SHUTDOWN_TIMEOUT"); value != "" {
+		var err error
+		if shutdownTimeout, err = time.ParseDuration(value); err != nil || shutdownTimeout <= 0 {
+			log.Fatal("SHUTDOWN_TIMEOUT must be a positive duration like 30s")
+		}
+	}
+	bodyLimitMB := 1024
+	if value := utils.GetEnv("BODY_LIMIT_MB"); value != "" {
+		var err error
+		if bodyLimitMB, err = strconv.Atoi(value); err != nil || bodyLimitMB <= 0 {
+			log.Fatal("BODY_LIMIT_MB must be a positive number")
+		}
+	}
+	photoMaxSizeMB := 50
+	if value := utils.GetEnv("PHOTO_MAX_SIZE_MB"); value != "" {
+		var err error
+		if photoMaxSizeMB, err = strconv.Atoi(value); err != nil || photoMaxSizeMB <= 0 {
+			log.Fatal("PHOTO_MAX_SIZE_MB must be a positive number")
+		}
+	}
+	batchMaxFiles := 500
+	if value := utils.GetEnv("BATCH_MAX_FILES"); value != "" {
+		var err error
+		if batchMaxFiles, err = strconv.Atoi(value); err != nil || batchMaxFiles <= 0 {
+			log.Fatal("BATCH_MAX_FILES must be a positive number")
+		}
+	}
+	resumableMaxSizeMB := 500
+	if value := utils.GetEnv("RESUMABLE_MAX_SIZE_MB"); value != "" {
+		var err error
+		if resumableMaxSizeMB, err = strconv.Atoi(value); err != nil || resumableMaxSizeMB <= 0 {
+			log.Fatal("RESUMABLE_MAX_SIZE_MB must be a positive number")
+		}
+	}
+	uploadChunkSizeMB := 8
+	if value := utils.GetEnv("UPLOAD_CHUNK_SIZE_MB"); value != "" {
+		var err error
+		if uploadChunkSizeMB, err = strconv.Atoi(value); err != nil || uploadChunkSizeMB < 5 {
+			log.Fatal("UPLOAD_CHUNK_SIZE_MB must be at least 5")
+		}
+	}
+	uploadSessionTTL := 24 * time.Hour
+	if value := utils.GetEnv("UPLOAD_SESSION_TTL"); value != "" {
+		var err error
+		if uploadSessionTTL, err = time.ParseDuration(value); err != nil || uploadSessionTTL <= 0 {
+			log.Fatal("UPLOAD_SESSION_TTL must be a positive duration like 24h")
+		}
+	}
 	return ServerConfig{
 		HTTP:       fmt.Sprintf("%s:%s", httpAddr, port),
 		HTTPAddr:   httpAddr,
@@ -53,5 +137,17 @@ func NewServerConfig() ServerConfig {
 		GRPCPort:   grpcPort,
 		ConsulAddr: consulAddr,
 		Name:       name,
+
+		CompressWorkers:    compressWorkers,
+		CompressJobTimeout: compressJobTimeout,
+		ShutdownTimeout:    shutdownTimeout,
+
+		BodyLimit:     bodyLimitMB << 20,
+		PhotoMaxSize:  int64(photoMaxSizeMB) << 20,
+		BatchMaxFiles: batchMaxFiles,
+
+		ResumableMaxSize: int64(resumableMaxSizeMB) << 20,
+		UploadChunkSize:  int64(uploadChunkSizeMB) << 20,
+		UploadSessionTTL: uploadSessionTTL,
 	}
 }
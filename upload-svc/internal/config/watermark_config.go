@@ -0,0 +1,40 @@
+package config
+
+import (
+	"be-yourmoments/upload-svc/internal/helper/utils"
+	"be-yourmoments/upload-svc/internal/model"
+	"log"
+	"os"
+	"strconv"
+)
+
+// NewWatermarkConfig reads the platform watermark used for photographers without their own.
+// WATERMARK_TEXT defaults to YOURMOMENTS, WATERMARK_LOGO is an optional path to a PNG logo and
+// WATERMARK_OPACITY defaults to 0.3.
+func NewWatermarkConfig() *model.Watermark {
+	watermark := &model.Watermark{
+		Text:    utils.GetEnv("WATERMARK_TEXT"),
+		Opacity: 0.3,
+	}
+	if watermark.Text == "" {
+		watermark.Text = "YOURMOMENTS"
+	}
+
+	if value := utils.GetEnv("WATERMARK_OPACITY"); value != "" {
+		opacity, err := strconv.ParseFloat(value, 32)
+		if err != nil || opacity <= 0 || opacity > 1 {
+			log.Fatalf("WATERMARK_OPACITY %s must be above 0 and at most 1", value)
+		}
+		watermark.Opacity = float32(opacity)
+	}
+
+	if path := utils.GetEnv("WATERMARK_LOGO"); path != "" {
+		logo, err := os.ReadFile(path)
+		if err != nil {
+			log.Fatalf("Failed to read WATERMARK_LOGO: %v", err)
+		}
+		watermark.Logo = logo
+	}
+
+	return watermark
+}
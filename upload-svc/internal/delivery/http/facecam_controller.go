@@ -1,8 +1,8 @@
 package http
 
 import (
+	"be-yourmoments/upload-svc/internal/delivery/http/middleware"
 	"be-yourmoments/upload-svc/internal/usecase"
-	"fmt"
 	"log"
 	"net/http"
 
@@ -16,11 +16,13 @@ type FacecamController interface {
 
 type facecamController struct {
 	facecamUseCase usecase.FacecamUseCase
+	authMiddleware fiber.Handler
 }
 
-func NewFacecamController(facecamUseCase usecase.FacecamUseCase) FacecamController {
+func NewFacecamController(facecamUseCase usecase.FacecamUseCase, authMiddleware fiber.Handler) FacecamController {
 	return &facecamController{
 		facecamUseCase: facecamUseCase,
+		authMiddleware: authMiddleware,
 	}
 }
 
@@ -31,10 +33,7 @@ func (c *facecamController) UploadFacecam(ctx *fiber.Ctx) error {
 		return fiber.NewError(http.StatusBadRequest, "invalid facecam")
 	}
 
-	userId := ctx.FormValue("userId", "xx-yy-zz")
-	fmt.Println(userId)
-
-	err = c.facecamUseCase.UploadFacecam(ctx.UserContext(), file, userId)
+	err = c.facecamUseCase.UploadFacecam(ctx.UserContext(), file, middleware.GetUser(ctx).UserId)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"be-yourmoments/upload-svc/internal/adapter"
+	"be-yourmoments/upload-svc/internal/model"
+	"log"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// NewUserAuth accepts the access tokens issued by user-svc and stores the caller in the locals.
+func NewUserAuth(userAdapter adapter.UserAdapter) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		token := strings.TrimPrefix(ctx.Get("Authorization", ""), "Bearer ")
+		if token == "" {
+			return fiber.NewError(fiber.StatusUnauthorized, "Unauthorized access")
+		}
+
+		auth, err := userAdapter.VerifyToken(ctx.UserContext(), token)
+		if err != nil {
+			if fiberErr, ok := err.(*fiber.Error); ok && fiberErr.Code < fiber.StatusInternalServerError {
+				return fiber.NewError(fiber.StatusUnauthorized, "Unauthorized access")
+			}
+			log.Println(err)
+			return fiber.NewError(fiber.StatusServiceUnavailable, "failed to verify access token")
+		}
+
+		ctx.Locals("auth", auth)
+		return ctx.Next()
+	}
+}
+
+// RequireSelf stops a caller from reaching the resources of another user, param is the route
+// parameter holding the user id. It must run after NewUserAuth.
+func RequireSelf(param string) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		if ctx.Params(param) != GetUser(ctx).UserId {
+			return fiber.NewError(fiber.StatusForbidden, "access to another user is not allowed")
+		}
+		return ctx.Next()
+	}
+}
+
+func GetUser(ctx *fiber.Ctx) *model.AuthResponse {
+	return ctx.Locals("auth").(*model.AuthResponse)
+}
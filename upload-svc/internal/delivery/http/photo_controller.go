@@ -1,6 +1,8 @@
 package http
 
 import (
+	"be-yourmoments/upload-svc/internal/delivery/http/middleware"
+	"be-yourmoments/upload-svc/internal/model"
 	"be-yourmoments/upload-svc/internal/usecase"
 	"net/http"
 	"strconv"
@@ -10,16 +12,24 @@ import (
 
 type PhotoController interface {
 	UploadPhoto(ctx *fiber.Ctx) error
+	UploadBatch(ctx *fiber.Ctx) error
+	GetUploadBatch(ctx *fiber.Ctx) error
+	CreateUploadSession(ctx *fiber.Ctx) error
+	GetUploadSession(ctx *fiber.Ctx) error
+	UploadChunk(ctx *fiber.Ctx) error
+	AbortUploadSession(ctx *fiber.Ctx) error
 	PhotoRoute(app *fiber.App)
 }
 
 type photoController struct {
-	photoUsecase usecase.PhotoUsecase
+	photoUsecase   usecase.PhotoUsecase
+	authMiddleware fiber.Handler
 }
 
-func NewPhotoController(photoUsecase usecase.PhotoUsecase) PhotoController {
+func NewPhotoController(photoUsecase usecase.PhotoUsecase, authMiddleware fiber.Handler) PhotoController {
 	return &photoController{
-		photoUsecase: photoUsecase,
+		photoUsecase:   photoUsecase,
+		authMiddleware: authMiddleware,
 	}
 }
 
@@ -29,16 +39,160 @@ func (c *photoController) UploadPhoto(ctx *fiber.Ctx) error {
 		return fiber.NewError(http.StatusBadRequest, "invalid photo")
 	}
 
-	priceStr := ctx.FormValue("price", "0")
-	price, _ := strconv.Atoi(priceStr)
+	err = c.photoUsecase.UploadPhoto(ctx.UserContext(), file, uploadPhotoRequest(ctx))
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusCreated).JSON(fiber.Map{
+		"success": true,
+	})
+
+}
+
+// UploadBatch takes any number of photos form fields and an optional archive ZIP, they all get the
+// same event_id and price. The batch is processed after the response, GetUploadBatch follows it.
+func (c *photoController) UploadBatch(ctx *fiber.Ctx) error {
+	form, err := ctx.MultipartForm()
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid form")
+	}
+
+	request := &model.UploadBatchRequest{
+		UploadPhotoRequest: *uploadPhotoRequest(ctx),
+		Files:              form.File["photos"],
+	}
+	if archives := form.File["archive"]; len(archives) > 0 {
+		request.Archive = archives[0]
+	}
+
+	batch, err := c.photoUsecase.UploadBatch(ctx.UserContext(), request)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusAccepted).JSON(fiber.Map{
+		"success": true,
+		"data":    batch,
+	})
+}
+
+func (c *photoController) GetUploadBatch(ctx *fiber.Ctx) error {
+	request := &model.GetUploadBatchRequest{
+		BatchId:   ctx.Params("batchId"),
+		CreatorId: middleware.GetUser(ctx).UserId,
+	}
+
+	batch, err := c.photoUsecase.GetUploadBatch(ctx.UserContext(), request)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"data":    batch,
+	})
+}
+
+// CreateUploadSession starts a resumable upload from a JSON body with file_name, size, event_id and
+// price. The chunks are then sent with UploadChunk, GetUploadSession tells where to continue after
+// the connection dropped.
+func (c *photoController) CreateUploadSession(ctx *fiber.Ctx) error {
+	request := new(model.CreateUploadSessionRequest)
+	if err := ctx.BodyParser(request); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid request body")
+	}
+
+	request.CreatorId = middleware.GetUser(ctx).UserId
+	if request.PriceStr == "" && request.EventId == "" {
+		request.PriceStr = "0"
+	}
+	request.Price, _ = strconv.Atoi(request.PriceStr)
 
-	err = c.photoUsecase.UploadPhoto(ctx.UserContext(), file, priceStr, price)
+	session, err := c.photoUsecase.CreateUploadSession(ctx.UserContext(), request)
 	if err != nil {
 		return err
 	}
 
+	ctx.Set("Upload-Offset", strconv.FormatInt(session.Offset, 10))
 	return ctx.Status(http.StatusCreated).JSON(fiber.Map{
 		"success": true,
+		"data":    session,
+	})
+}
+
+func (c *photoController) GetUploadSession(ctx *fiber.Ctx) error {
+	request := &model.UploadSessionRequest{
+		UploadId:  ctx.Params("uploadId"),
+		CreatorId: middleware.GetUser(ctx).UserId,
+	}
+
+	session, err := c.photoUsecase.GetUploadSession(ctx.UserContext(), request)
+	if err != nil {
+		return err
+	}
+
+	ctx.Set("Upload-Offset", strconv.FormatInt(session.Offset, 10))
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"data":    session,
+	})
+}
+
+// UploadChunk takes the raw bytes of the next chunk as the body and their position in the
+// Upload-Offset header, like a tus PATCH request.
+func (c *photoController) UploadChunk(ctx *fiber.Ctx) error {
+	offset, err := strconv.ParseInt(ctx.Get("Upload-Offset"), 10, 64)
+	if err != nil || offset < 0 {
+		return fiber.NewError(http.StatusBadRequest, "invalid Upload-Offset header")
+	}
+
+	request := &model.UploadChunkRequest{
+		UploadId:  ctx.Params("uploadId"),
+		CreatorId: middleware.GetUser(ctx).UserId,
+		Offset:    offset,
+		Data:      ctx.Body(),
+	}
+
+	session, err := c.photoUsecase.UploadChunk(ctx.UserContext(), request)
+	if err != nil {
+		return err
+	}
+
+	ctx.Set("Upload-Offset", strconv.FormatInt(session.Offset, 10))
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"data":    session,
+	})
+}
+
+func (c *photoController) AbortUploadSession(ctx *fiber.Ctx) error {
+	request := &model.UploadSessionRequest{
+		UploadId:  ctx.Params("uploadId"),
+		CreatorId: middleware.GetUser(ctx).UserId,
+	}
+
+	if err := c.photoUsecase.AbortUploadSession(ctx.UserContext(), request); err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"success": true,
 	})
+}
+
+// uploadPhotoRequest reads the form values shared by single and batch uploads. Photos uploaded to
+// an event without a price sell at the event's default price.
+func uploadPhotoRequest(ctx *fiber.Ctx) *model.UploadPhotoRequest {
+	request := &model.UploadPhotoRequest{
+		CreatorId: middleware.GetUser(ctx).UserId,
+		EventId:   ctx.FormValue("event_id"),
+		PriceStr:  ctx.FormValue("price"),
+	}
+	if request.PriceStr == "" && request.EventId == "" {
+		request.PriceStr = "0"
+	}
+	request.Price, _ = strconv.Atoi(request.PriceStr)
 
+	return request
 }
@@ -7,11 +7,24 @@ import (
 )
 
 func (c *photoController) PhotoRoute(app *fiber.App) {
-	// api := app.Group(config.EndpointPrefix)
-	// // api.Post("/single", c.UploadPhoto)
+	api := app.Group(config.EndpointPrefix)
+	api.Post("/single", c.authMiddleware, c.UploadPhoto)
+	api.Post("/batch", c.authMiddleware, c.UploadBatch)
+	api.Get("/batch/:batchId", c.authMiddleware, c.GetUploadBatch)
+	api.Post("/resumable", c.authMiddleware, c.CreateUploadSession)
+	api.Get("/resumable/:uploadId", c.authMiddleware, c.GetUploadSession)
+	api.Patch("/resumable/:uploadId", c.authMiddleware, c.UploadChunk)
+	api.Delete("/resumable/:uploadId", c.authMiddleware, c.AbortUploadSession)
 }
 
 func (c *facecamController) FacecamRoute(app *fiber.App) {
 	api := app.Group(config.EndpointPrefix)
-	api.Post("/facecam/single", c.UploadFacecam)
+	api.Post("/facecam/single", c.authMiddleware, c.UploadFacecam)
+}
+
+func (c *watermarkController) WatermarkRoute(app *fiber.App) {
+	api := app.Group(config.EndpointPrefix)
+	api.Get("/watermark", c.authMiddleware, c.GetWatermark)
+	api.Put("/watermark", c.authMiddleware, c.UpdateWatermark)
+	api.Delete("/watermark", c.authMiddleware, c.DeleteWatermark)
 }
@@ -0,0 +1,80 @@
+package http
+
+import (
+	"be-yourmoments/upload-svc/internal/delivery/http/middleware"
+	"be-yourmoments/upload-svc/internal/model"
+	"be-yourmoments/upload-svc/internal/usecase"
+	"net/http"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type WatermarkController interface {
+	GetWatermark(ctx *fiber.Ctx) error
+	UpdateWatermark(ctx *fiber.Ctx) error
+	DeleteWatermark(ctx *fiber.Ctx) error
+	WatermarkRoute(app *fiber.App)
+}
+
+type watermarkController struct {
+	watermarkUsecase usecase.WatermarkUsecase
+	authMiddleware   fiber.Handler
+}
+
+func NewWatermarkController(watermarkUsecase usecase.WatermarkUsecase, authMiddleware fiber.Handler) WatermarkController {
+	return &watermarkController{
+		watermarkUsecase: watermarkUsecase,
+		authMiddleware:   authMiddleware,
+	}
+}
+
+func (c *watermarkController) GetWatermark(ctx *fiber.Ctx) error {
+	watermark, err := c.watermarkUsecase.GetWatermark(ctx.UserContext(), middleware.GetUser(ctx).UserId)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"data":    watermark,
+	})
+}
+
+// UpdateWatermark takes a multipart form with text, opacity, an optional logo file and remove_logo.
+func (c *watermarkController) UpdateWatermark(ctx *fiber.Ctx) error {
+	opacity, err := strconv.ParseFloat(ctx.FormValue("opacity", "0.3"), 32)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid opacity")
+	}
+
+	request := &model.UpdateWatermarkRequest{
+		CreatorId:  middleware.GetUser(ctx).UserId,
+		Text:       ctx.FormValue("text"),
+		Opacity:    float32(opacity),
+		RemoveLogo: ctx.FormValue("remove_logo") == "true",
+	}
+	if logo, err := ctx.FormFile("logo"); err == nil {
+		request.Logo = logo
+	}
+
+	watermark, err := c.watermarkUsecase.UpdateWatermark(ctx.UserContext(), request)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"data":    watermark,
+	})
+}
+
+func (c *watermarkController) DeleteWatermark(ctx *fiber.Ctx) error {
+	if err := c.watermarkUsecase.DeleteWatermark(ctx.UserContext(), middleware.GetUser(ctx).UserId); err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(fiber.Map{
+		"success": true,
+	})
+}
@@ -0,0 +1,78 @@
+package worker
+
+import (
+	"be-yourmoments/upload-svc/internal/usecase"
+	"context"
+	"sync"
+	"time"
+)
+
+// CompressionWorker runs compression jobs on a fixed number of goroutines, so a burst of uploads
+// waits in the job table instead of piling up in memory.
+type CompressionWorker struct {
+	compressionUsecase usecase.CompressionUsecase
+	size               int
+	pollInterval       time.Duration
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+func NewCompressionWorker(compressionUsecase usecase.CompressionUsecase, size int) *CompressionWorker {
+	return &CompressionWorker{
+		compressionUsecase: compressionUsecase,
+		size:               size,
+		pollInterval:       2 * time.Second,
+		stop:               make(chan struct{}),
+	}
+}
+
+func (w *CompressionWorker) Start() {
+	for i := 0; i < w.size; i++ {
+		w.wg.Add(1)
+		go w.run()
+	}
+}
+
+// Shutdown stops claiming jobs and waits for the running ones to finish. Jobs still running when
+// ctx ends are claimed again by the next worker once their lock runs out.
+func (w *CompressionWorker) Shutdown(ctx context.Context) error {
+	close(w.stop)
+
+	done := make(chan struct{})
+	go func() {
+		w.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (w *CompressionWorker) run() {
+	defer w.wg.Done()
+
+	for {
+		select {
+		case <-w.stop:
+			return
+		default:
+		}
+
+		// jobs are not tied to the stop signal, a running job is drained rather than cancelled
+		found, err := w.compressionUsecase.ProcessNextJob(context.Background())
+		if found && err == nil {
+			continue
+		}
+
+		select {
+		case <-w.stop:
+			return
+		case <-time.After(w.pollInterval):
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"be-yourmoments/upload-svc/internal/enum"
+	"time"
+)
+
+// CompressionJob compresses an original stored under FileKey. PhotoId is set for photos, UserId is
+// the photographer for photos and the owner for facecams. ResultFileKey is set once the compressed file was stored and registered, so a retry
+// only redoes the steps after it.
+type CompressionJob struct {
+	Id            string                    `db:"id"`
+	Type          enum.CompressionJobType   `db:"type"`
+	Status        enum.CompressionJobStatus `db:"status"`
+	PhotoId       string                    `db:"photo_id"`
+	UserId        string                    `db:"user_id"`
+	FileKey       string                    `db:"file_key"`
+	FileName      string                    `db:"file_name"`
+	Checksum      string                    `db:"checksum"`
+	ResultFileKey string                    `db:"result_file_key"`
+	Attempts      int                       `db:"attempts"`
+	MaxAttempts   int                       `db:"max_attempts"`
+	LastError     string                    `db:"last_error"`
+	RunAt         time.Time                 `db:"run_at"`
+	LockedUntil   *time.Time                `db:"locked_until"`
+
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
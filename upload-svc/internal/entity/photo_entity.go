@@ -11,6 +11,7 @@ type Photo struct {
 	IsThisYouURL   string `db:"is_this_you_url"`
 	YourMomentsUrl string `db:"your_moments_url"`
 	CollectionUrl  string `db:"collection_url"`
+	EventId        string `db:"event_id"`
 
 	Price      int       `db:"price"`
 	PriceStr   string    `db:"price_str"`
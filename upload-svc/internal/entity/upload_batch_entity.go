@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"be-yourmoments/upload-svc/internal/enum"
+	"time"
+)
+
+type UploadBatch struct {
+	Id         string `db:"id"`
+	CreatorId  string `db:"creator_id"`
+	EventId    string `db:"event_id"`
+	TotalFiles int    `db:"total_files"`
+
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// UploadBatchItem is one file of a batch. JobStatus is read from the compression job of an
+// accepted file and is empty for the others.
+type UploadBatchItem struct {
+	Id               string                     `db:"id"`
+	BatchId          string                     `db:"batch_id"`
+	FileName         string                     `db:"file_name"`
+	Size             int64                      `db:"size"`
+	Checksum         string                     `db:"checksum"`
+	Status           enum.UploadBatchItemStatus `db:"status"`
+	PhotoId          string                     `db:"photo_id"`
+	CompressionJobId string                     `db:"compression_job_id"`
+	Error            string                     `db:"error"`
+	JobStatus        enum.CompressionJobStatus  `db:"job_status"`
+
+	CreatedAt time.Time `db:"created_at"`
+}
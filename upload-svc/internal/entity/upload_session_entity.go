@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"be-yourmoments/upload-svc/internal/enum"
+	"time"
+)
+
+// UploadSession is a resumable upload of one photo into a storage multipart upload. HashState is the
+// sha256 state over the bytes received so far, so the checksum is known without reading the file
+// again. Assembled is set once the parts were joined into the object under FileKey.
+type UploadSession struct {
+	Id              string                   `db:"id"`
+	CreatorId       string                   `db:"creator_id"`
+	EventId         string                   `db:"event_id"`
+	Price           int                      `db:"price"`
+	PriceStr        string                   `db:"price_str"`
+	FileName        string                   `db:"file_name"`
+	FileType        string                   `db:"file_type"`
+	ContentType     string                   `db:"content_type"`
+	FileKey         string                   `db:"file_key"`
+	StorageUploadId string                   `db:"storage_upload_id"`
+	Size            int64                    `db:"size"`
+	UploadOffset    int64                    `db:"upload_offset"`
+	ChunkSize       int64                    `db:"chunk_size"`
+	HashState       []byte                   `db:"hash_state"`
+	Width           int                      `db:"width"`
+	Height          int                      `db:"height"`
+	Assembled       bool                     `db:"assembled"`
+	Status          enum.UploadSessionStatus `db:"status"`
+	PhotoId         string                   `db:"photo_id"`
+	ExpiresAt       time.Time                `db:"expires_at"`
+
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+type UploadSessionPart struct {
+	SessionId  string    `db:"session_id"`
+	PartNumber int       `db:"part_number"`
+	ETag       string    `db:"etag"`
+	Size       int64     `db:"size"`
+	CreatedAt  time.Time `db:"created_at"`
+}
@@ -0,0 +1,15 @@
+package entity
+
+import "time"
+
+// CreatorWatermark replaces the platform watermark on a photographer's photos. An empty Text or
+// LogoFileKey leaves that part out.
+type CreatorWatermark struct {
+	CreatorId   string  `db:"creator_id"`
+	Text        string  `db:"text"`
+	LogoFileKey string  `db:"logo_file_key"`
+	Opacity     float32 `db:"opacity"`
+
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
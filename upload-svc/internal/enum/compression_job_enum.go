@@ -0,0 +1,19 @@
+package enum
+
+type CompressionJobType string
+
+const (
+	CompressionJobTypePhoto   CompressionJobType = "PHOTO"
+	CompressionJobTypeFacecam CompressionJobType = "FACECAM"
+)
+
+// CompressionJobStatus is where a job is in the queue. A DEAD job ran out of attempts and is only
+// kept to be looked into, it is never picked up again.
+type CompressionJobStatus string
+
+const (
+	CompressionJobStatusPending    CompressionJobStatus = "PENDING"
+	CompressionJobStatusProcessing CompressionJobStatus = "PROCESSING"
+	CompressionJobStatusDone       CompressionJobStatus = "DONE"
+	CompressionJobStatusDead       CompressionJobStatus = "DEAD"
+)
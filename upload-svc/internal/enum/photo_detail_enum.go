@@ -7,4 +7,6 @@ const (
 	YourMomentTypeIsYou      YourMomentsType = "ISYOU"
 	YourMomentTypeYou        YourMomentsType = "YOU"
 	YourMomentTypeCollection YourMomentsType = "COLLECTION"
+	YourMomentTypeThumbnail  YourMomentsType = "THUMBNAIL"
+	YourMomentTypePreview    YourMomentsType = "PREVIEW"
 )
@@ -0,0 +1,13 @@
+package enum
+
+// UploadBatchItemStatus is the outcome of one file of a batch upload. Only ACCEPTED files became
+// photos, FAILED ones were valid but could not be stored.
+type UploadBatchItemStatus string
+
+const (
+	UploadBatchItemStatusAccepted      UploadBatchItemStatus = "ACCEPTED"
+	UploadBatchItemStatusDuplicate     UploadBatchItemStatus = "DUPLICATE"
+	UploadBatchItemStatusInvalidFormat UploadBatchItemStatus = "INVALID_FORMAT"
+	UploadBatchItemStatusTooLarge      UploadBatchItemStatus = "TOO_LARGE"
+	UploadBatchItemStatusFailed        UploadBatchItemStatus = "FAILED"
+)
@@ -0,0 +1,11 @@
+package enum
+
+// UploadSessionStatus is where a resumable upload is. An ACTIVE session takes chunks until all bytes
+// arrived and the photo was registered, then it is COMPLETED.
+type UploadSessionStatus string
+
+const (
+	UploadSessionStatusActive    UploadSessionStatus = "ACTIVE"
+	UploadSessionStatusCompleted UploadSessionStatus = "COMPLETED"
+	UploadSessionStatusAborted   UploadSessionStatus = "ABORTED"
+)
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./adapter/compress_adapter.go
+
+// Package mockadapter is a generated GoMock package.
+package mockadapter
+
+import (
+	config "be-yourmoments/upload-svc/internal/config"
+	model "be-yourmoments/upload-svc/internal/model"
+	io "io"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCompressAdapter is a mock of CompressAdapter interface.
+type MockCompressAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockCompressAdapterMockRecorder
+}
+
+// MockCompressAdapterMockRecorder is the mock recorder for MockCompressAdapter.
+type MockCompressAdapterMockRecorder struct {
+	mock *MockCompressAdapter
+}
+
+// NewMockCompressAdapter creates a new mock instance.
+func NewMockCompressAdapter(ctrl *gomock.Controller) *MockCompressAdapter {
+	mock := &MockCompressAdapter{ctrl: ctrl}
+	mock.recorder = &MockCompressAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCompressAdapter) EXPECT() *MockCompressAdapterMockRecorder {
+	return m.recorder
+}
+
+// RenderImage mocks base method.
+func (m *MockCompressAdapter) RenderImage(original io.Reader, renditions []config.Rendition, watermark *model.Watermark) ([]*model.ImageRendition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderImage", original, renditions, watermark)
+	ret0, _ := ret[0].([]*model.ImageRendition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderImage indicates an expected call of RenderImage.
+func (mr *MockCompressAdapterMockRecorder) RenderImage(original, renditions, watermark interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderImage", reflect.TypeOf((*MockCompressAdapter)(nil).RenderImage), original, renditions, watermark)
+}
@@ -0,0 +1,92 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./adapter/photo_adapter.go
+
+// Package mockadapter is a generated GoMock package.
+package mockadapter
+
+import (
+	entity "be-yourmoments/upload-svc/internal/entity"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPhotoAdapter is a mock of PhotoAdapter interface.
+type MockPhotoAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockPhotoAdapterMockRecorder
+}
+
+// MockPhotoAdapterMockRecorder is the mock recorder for MockPhotoAdapter.
+type MockPhotoAdapterMockRecorder struct {
+	mock *MockPhotoAdapter
+}
+
+// NewMockPhotoAdapter creates a new mock instance.
+func NewMockPhotoAdapter(ctrl *gomock.Controller) *MockPhotoAdapter {
+	mock := &MockPhotoAdapter{ctrl: ctrl}
+	mock.recorder = &MockPhotoAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPhotoAdapter) EXPECT() *MockPhotoAdapterMockRecorder {
+	return m.recorder
+}
+
+// CreateFacecam mocks base method.
+func (m *MockPhotoAdapter) CreateFacecam(ctx context.Context, facecam *entity.Facecam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFacecam", ctx, facecam)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateFacecam indicates an expected call of CreateFacecam.
+func (mr *MockPhotoAdapterMockRecorder) CreateFacecam(ctx, facecam interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFacecam", reflect.TypeOf((*MockPhotoAdapter)(nil).CreateFacecam), ctx, facecam)
+}
+
+// CreatePhoto mocks base method.
+func (m *MockPhotoAdapter) CreatePhoto(ctx context.Context, photo *entity.Photo, facecam *entity.PhotoDetail) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePhoto", ctx, photo, facecam)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePhoto indicates an expected call of CreatePhoto.
+func (mr *MockPhotoAdapterMockRecorder) CreatePhoto(ctx, photo, facecam interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePhoto", reflect.TypeOf((*MockPhotoAdapter)(nil).CreatePhoto), ctx, photo, facecam)
+}
+
+// DeletePhoto mocks base method.
+func (m *MockPhotoAdapter) DeletePhoto(ctx context.Context, photoId, creatorId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePhoto", ctx, photoId, creatorId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePhoto indicates an expected call of DeletePhoto.
+func (mr *MockPhotoAdapterMockRecorder) DeletePhoto(ctx, photoId, creatorId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePhoto", reflect.TypeOf((*MockPhotoAdapter)(nil).DeletePhoto), ctx, photoId, creatorId)
+}
+
+// UpdatePhotoDetail mocks base method.
+func (m *MockPhotoAdapter) UpdatePhotoDetail(ctx context.Context, facecam *entity.PhotoDetail) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePhotoDetail", ctx, facecam)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePhotoDetail indicates an expected call of UpdatePhotoDetail.
+func (mr *MockPhotoAdapterMockRecorder) UpdatePhotoDetail(ctx, facecam interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePhotoDetail", reflect.TypeOf((*MockPhotoAdapter)(nil).UpdatePhotoDetail), ctx, facecam)
+}
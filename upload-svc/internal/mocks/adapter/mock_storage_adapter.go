@@ -0,0 +1,159 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./adapter/storage_adapter.go
+
+// Package mockadapter is a generated GoMock package.
+package mockadapter
+
+import (
+	entity "be-yourmoments/upload-svc/internal/entity"
+	model "be-yourmoments/upload-svc/internal/model"
+	context "context"
+	io "io"
+	multipart "mime/multipart"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStorageAdapter is a mock of StorageAdapter interface.
+type MockStorageAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageAdapterMockRecorder
+}
+
+// MockStorageAdapterMockRecorder is the mock recorder for MockStorageAdapter.
+type MockStorageAdapterMockRecorder struct {
+	mock *MockStorageAdapter
+}
+
+// NewMockStorageAdapter creates a new mock instance.
+func NewMockStorageAdapter(ctrl *gomock.Controller) *MockStorageAdapter {
+	mock := &MockStorageAdapter{ctrl: ctrl}
+	mock.recorder = &MockStorageAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorageAdapter) EXPECT() *MockStorageAdapterMockRecorder {
+	return m.recorder
+}
+
+// AbortMultipartUpload mocks base method.
+func (m *MockStorageAdapter) AbortMultipartUpload(ctx context.Context, fileKey, uploadId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AbortMultipartUpload", ctx, fileKey, uploadId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AbortMultipartUpload indicates an expected call of AbortMultipartUpload.
+func (mr *MockStorageAdapterMockRecorder) AbortMultipartUpload(ctx, fileKey, uploadId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortMultipartUpload", reflect.TypeOf((*MockStorageAdapter)(nil).AbortMultipartUpload), ctx, fileKey, uploadId)
+}
+
+// CompleteMultipartUpload mocks base method.
+func (m *MockStorageAdapter) CompleteMultipartUpload(ctx context.Context, fileKey, uploadId string, parts *[]*entity.UploadSessionPart) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteMultipartUpload", ctx, fileKey, uploadId, parts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteMultipartUpload indicates an expected call of CompleteMultipartUpload.
+func (mr *MockStorageAdapterMockRecorder) CompleteMultipartUpload(ctx, fileKey, uploadId, parts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteMultipartUpload", reflect.TypeOf((*MockStorageAdapter)(nil).CompleteMultipartUpload), ctx, fileKey, uploadId, parts)
+}
+
+// DeleteFile mocks base method.
+func (m *MockStorageAdapter) DeleteFile(ctx context.Context, fileName string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFile", ctx, fileName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFile indicates an expected call of DeleteFile.
+func (mr *MockStorageAdapterMockRecorder) DeleteFile(ctx, fileName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFile", reflect.TypeOf((*MockStorageAdapter)(nil).DeleteFile), ctx, fileName)
+}
+
+// NewMultipartUpload mocks base method.
+func (m *MockStorageAdapter) NewMultipartUpload(ctx context.Context, fileName, contentType, path string) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMultipartUpload", ctx, fileName, contentType, path)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// NewMultipartUpload indicates an expected call of NewMultipartUpload.
+func (mr *MockStorageAdapterMockRecorder) NewMultipartUpload(ctx, fileName, contentType, path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMultipartUpload", reflect.TypeOf((*MockStorageAdapter)(nil).NewMultipartUpload), ctx, fileName, contentType, path)
+}
+
+// OpenFile mocks base method.
+func (m *MockStorageAdapter) OpenFile(ctx context.Context, fileKey string) (multipart.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenFile", ctx, fileKey)
+	ret0, _ := ret[0].(multipart.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenFile indicates an expected call of OpenFile.
+func (mr *MockStorageAdapterMockRecorder) OpenFile(ctx, fileKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenFile", reflect.TypeOf((*MockStorageAdapter)(nil).OpenFile), ctx, fileKey)
+}
+
+// PresignFile mocks base method.
+func (m *MockStorageAdapter) PresignFile(ctx context.Context, fileKey string, expiry time.Duration) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresignFile", ctx, fileKey, expiry)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PresignFile indicates an expected call of PresignFile.
+func (mr *MockStorageAdapterMockRecorder) PresignFile(ctx, fileKey, expiry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresignFile", reflect.TypeOf((*MockStorageAdapter)(nil).PresignFile), ctx, fileKey, expiry)
+}
+
+// PutObjectPart mocks base method.
+func (m *MockStorageAdapter) PutObjectPart(ctx context.Context, fileKey, uploadId string, partNumber int, data io.Reader, size int64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutObjectPart", ctx, fileKey, uploadId, partNumber, data, size)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutObjectPart indicates an expected call of PutObjectPart.
+func (mr *MockStorageAdapterMockRecorder) PutObjectPart(ctx, fileKey, uploadId, partNumber, data, size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutObjectPart", reflect.TypeOf((*MockStorageAdapter)(nil).PutObjectPart), ctx, fileKey, uploadId, partNumber, data, size)
+}
+
+// UploadFile mocks base method.
+func (m *MockStorageAdapter) UploadFile(ctx context.Context, file *multipart.FileHeader, uploadFile multipart.File, path string) (*model.MinioFileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadFile", ctx, file, uploadFile, path)
+	ret0, _ := ret[0].(*model.MinioFileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadFile indicates an expected call of UploadFile.
+func (mr *MockStorageAdapterMockRecorder) UploadFile(ctx, file, uploadFile, path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadFile", reflect.TypeOf((*MockStorageAdapter)(nil).UploadFile), ctx, file, uploadFile, path)
+}
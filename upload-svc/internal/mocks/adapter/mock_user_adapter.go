@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./adapter/user_adapter.go
+
+// Package mockadapter is a generated GoMock package.
+package mockadapter
+
+import (
+	model "be-yourmoments/upload-svc/internal/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUserAdapter is a mock of UserAdapter interface.
+type MockUserAdapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserAdapterMockRecorder
+}
+
+// MockUserAdapterMockRecorder is the mock recorder for MockUserAdapter.
+type MockUserAdapterMockRecorder struct {
+	mock *MockUserAdapter
+}
+
+// NewMockUserAdapter creates a new mock instance.
+func NewMockUserAdapter(ctrl *gomock.Controller) *MockUserAdapter {
+	mock := &MockUserAdapter{ctrl: ctrl}
+	mock.recorder = &MockUserAdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserAdapter) EXPECT() *MockUserAdapterMockRecorder {
+	return m.recorder
+}
+
+// VerifyToken mocks base method.
+func (m *MockUserAdapter) VerifyToken(ctx context.Context, token string) (*model.AuthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyToken", ctx, token)
+	ret0, _ := ret[0].(*model.AuthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyToken indicates an expected call of VerifyToken.
+func (mr *MockUserAdapterMockRecorder) VerifyToken(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyToken", reflect.TypeOf((*MockUserAdapter)(nil).VerifyToken), ctx, token)
+}
@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/compression_job_repository.go
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	entity "be-yourmoments/upload-svc/internal/entity"
+	repository "be-yourmoments/upload-svc/internal/repository"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCompressionJobRepository is a mock of CompressionJobRepository interface.
+type MockCompressionJobRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCompressionJobRepositoryMockRecorder
+}
+
+// MockCompressionJobRepositoryMockRecorder is the mock recorder for MockCompressionJobRepository.
+type MockCompressionJobRepositoryMockRecorder struct {
+	mock *MockCompressionJobRepository
+}
+
+// NewMockCompressionJobRepository creates a new mock instance.
+func NewMockCompressionJobRepository(ctrl *gomock.Controller) *MockCompressionJobRepository {
+	mock := &MockCompressionJobRepository{ctrl: ctrl}
+	mock.recorder = &MockCompressionJobRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCompressionJobRepository) EXPECT() *MockCompressionJobRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Claim mocks base method.
+func (m *MockCompressionJobRepository) Claim(tx repository.Querier, lockedUntil time.Time) (*entity.CompressionJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", tx, lockedUntil)
+	ret0, _ := ret[0].(*entity.CompressionJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockCompressionJobRepositoryMockRecorder) Claim(tx, lockedUntil interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockCompressionJobRepository)(nil).Claim), tx, lockedUntil)
+}
+
+// Create mocks base method.
+func (m *MockCompressionJobRepository) Create(tx repository.Querier, job *entity.CompressionJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", tx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCompressionJobRepositoryMockRecorder) Create(tx, job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCompressionJobRepository)(nil).Create), tx, job)
+}
+
+// ExistsPhotoChecksum mocks base method.
+func (m *MockCompressionJobRepository) ExistsPhotoChecksum(tx repository.Querier, creatorId, checksum string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsPhotoChecksum", tx, creatorId, checksum)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistsPhotoChecksum indicates an expected call of ExistsPhotoChecksum.
+func (mr *MockCompressionJobRepositoryMockRecorder) ExistsPhotoChecksum(tx, creatorId, checksum interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsPhotoChecksum", reflect.TypeOf((*MockCompressionJobRepository)(nil).ExistsPhotoChecksum), tx, creatorId, checksum)
+}
+
+// Finish mocks base method.
+func (m *MockCompressionJobRepository) Finish(tx repository.Querier, job *entity.CompressionJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Finish", tx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Finish indicates an expected call of Finish.
+func (mr *MockCompressionJobRepositoryMockRecorder) Finish(tx, job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Finish", reflect.TypeOf((*MockCompressionJobRepository)(nil).Finish), tx, job)
+}
+
+// SaveResult mocks base method.
+func (m *MockCompressionJobRepository) SaveResult(tx repository.Querier, job *entity.CompressionJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveResult", tx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveResult indicates an expected call of SaveResult.
+func (mr *MockCompressionJobRepositoryMockRecorder) SaveResult(tx, job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveResult", reflect.TypeOf((*MockCompressionJobRepository)(nil).SaveResult), tx, job)
+}
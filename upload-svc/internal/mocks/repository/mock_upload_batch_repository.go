@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/upload_batch_repository.go
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	entity "be-yourmoments/upload-svc/internal/entity"
+	repository "be-yourmoments/upload-svc/internal/repository"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUploadBatchRepository is a mock of UploadBatchRepository interface.
+type MockUploadBatchRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUploadBatchRepositoryMockRecorder
+}
+
+// MockUploadBatchRepositoryMockRecorder is the mock recorder for MockUploadBatchRepository.
+type MockUploadBatchRepositoryMockRecorder struct {
+	mock *MockUploadBatchRepository
+}
+
+// NewMockUploadBatchRepository creates a new mock instance.
+func NewMockUploadBatchRepository(ctrl *gomock.Controller) *MockUploadBatchRepository {
+	mock := &MockUploadBatchRepository{ctrl: ctrl}
+	mock.recorder = &MockUploadBatchRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUploadBatchRepository) EXPECT() *MockUploadBatchRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockUploadBatchRepository) Create(tx repository.Querier, batch *entity.UploadBatch) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", tx, batch)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUploadBatchRepositoryMockRecorder) Create(tx, batch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUploadBatchRepository)(nil).Create), tx, batch)
+}
+
+// CreateItem mocks base method.
+func (m *MockUploadBatchRepository) CreateItem(tx repository.Querier, item *entity.UploadBatchItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateItem", tx, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateItem indicates an expected call of CreateItem.
+func (mr *MockUploadBatchRepositoryMockRecorder) CreateItem(tx, item interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateItem", reflect.TypeOf((*MockUploadBatchRepository)(nil).CreateItem), tx, item)
+}
+
+// FindById mocks base method.
+func (m *MockUploadBatchRepository) FindById(tx repository.Querier, id string) (*entity.UploadBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", tx, id)
+	ret0, _ := ret[0].(*entity.UploadBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockUploadBatchRepositoryMockRecorder) FindById(tx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockUploadBatchRepository)(nil).FindById), tx, id)
+}
+
+// FindItemsByBatchId mocks base method.
+func (m *MockUploadBatchRepository) FindItemsByBatchId(tx repository.Querier, batchId string) (*[]*entity.UploadBatchItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindItemsByBatchId", tx, batchId)
+	ret0, _ := ret[0].(*[]*entity.UploadBatchItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindItemsByBatchId indicates an expected call of FindItemsByBatchId.
+func (mr *MockUploadBatchRepositoryMockRecorder) FindItemsByBatchId(tx, batchId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindItemsByBatchId", reflect.TypeOf((*MockUploadBatchRepository)(nil).FindItemsByBatchId), tx, batchId)
+}
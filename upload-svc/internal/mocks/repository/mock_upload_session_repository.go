@@ -0,0 +1,139 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/upload_session_repository.go
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	entity "be-yourmoments/upload-svc/internal/entity"
+	repository "be-yourmoments/upload-svc/internal/repository"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUploadSessionRepository is a mock of UploadSessionRepository interface.
+type MockUploadSessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUploadSessionRepositoryMockRecorder
+}
+
+// MockUploadSessionRepositoryMockRecorder is the mock recorder for MockUploadSessionRepository.
+type MockUploadSessionRepositoryMockRecorder struct {
+	mock *MockUploadSessionRepository
+}
+
+// NewMockUploadSessionRepository creates a new mock instance.
+func NewMockUploadSessionRepository(ctrl *gomock.Controller) *MockUploadSessionRepository {
+	mock := &MockUploadSessionRepository{ctrl: ctrl}
+	mock.recorder = &MockUploadSessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUploadSessionRepository) EXPECT() *MockUploadSessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockUploadSessionRepository) Create(tx repository.Querier, session *entity.UploadSession) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", tx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUploadSessionRepositoryMockRecorder) Create(tx, session interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUploadSessionRepository)(nil).Create), tx, session)
+}
+
+// FindById mocks base method.
+func (m *MockUploadSessionRepository) FindById(tx repository.Querier, id string) (*entity.UploadSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", tx, id)
+	ret0, _ := ret[0].(*entity.UploadSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockUploadSessionRepositoryMockRecorder) FindById(tx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockUploadSessionRepository)(nil).FindById), tx, id)
+}
+
+// FindByIdForUpdate mocks base method.
+func (m *MockUploadSessionRepository) FindByIdForUpdate(tx repository.Querier, id string) (*entity.UploadSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIdForUpdate", tx, id)
+	ret0, _ := ret[0].(*entity.UploadSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIdForUpdate indicates an expected call of FindByIdForUpdate.
+func (mr *MockUploadSessionRepositoryMockRecorder) FindByIdForUpdate(tx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIdForUpdate", reflect.TypeOf((*MockUploadSessionRepository)(nil).FindByIdForUpdate), tx, id)
+}
+
+// FindExpired mocks base method.
+func (m *MockUploadSessionRepository) FindExpired(tx repository.Querier, now time.Time, limit int) (*[]*entity.UploadSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindExpired", tx, now, limit)
+	ret0, _ := ret[0].(*[]*entity.UploadSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindExpired indicates an expected call of FindExpired.
+func (mr *MockUploadSessionRepositoryMockRecorder) FindExpired(tx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindExpired", reflect.TypeOf((*MockUploadSessionRepository)(nil).FindExpired), tx, now, limit)
+}
+
+// FindPartsBySessionId mocks base method.
+func (m *MockUploadSessionRepository) FindPartsBySessionId(tx repository.Querier, sessionId string) (*[]*entity.UploadSessionPart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPartsBySessionId", tx, sessionId)
+	ret0, _ := ret[0].(*[]*entity.UploadSessionPart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPartsBySessionId indicates an expected call of FindPartsBySessionId.
+func (mr *MockUploadSessionRepositoryMockRecorder) FindPartsBySessionId(tx, sessionId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPartsBySessionId", reflect.TypeOf((*MockUploadSessionRepository)(nil).FindPartsBySessionId), tx, sessionId)
+}
+
+// SavePart mocks base method.
+func (m *MockUploadSessionRepository) SavePart(tx repository.Querier, part *entity.UploadSessionPart) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePart", tx, part)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePart indicates an expected call of SavePart.
+func (mr *MockUploadSessionRepositoryMockRecorder) SavePart(tx, part interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePart", reflect.TypeOf((*MockUploadSessionRepository)(nil).SavePart), tx, part)
+}
+
+// Update mocks base method.
+func (m *MockUploadSessionRepository) Update(tx repository.Querier, session *entity.UploadSession) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", tx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUploadSessionRepositoryMockRecorder) Update(tx, session interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUploadSessionRepository)(nil).Update), tx, session)
+}
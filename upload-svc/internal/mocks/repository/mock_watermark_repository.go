@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./repository/watermark_repository.go
+
+// Package mockrepository is a generated GoMock package.
+package mockrepository
+
+import (
+	entity "be-yourmoments/upload-svc/internal/entity"
+	repository "be-yourmoments/upload-svc/internal/repository"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockWatermarkRepository is a mock of WatermarkRepository interface.
+type MockWatermarkRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWatermarkRepositoryMockRecorder
+}
+
+// MockWatermarkRepositoryMockRecorder is the mock recorder for MockWatermarkRepository.
+type MockWatermarkRepositoryMockRecorder struct {
+	mock *MockWatermarkRepository
+}
+
+// NewMockWatermarkRepository creates a new mock instance.
+func NewMockWatermarkRepository(ctrl *gomock.Controller) *MockWatermarkRepository {
+	mock := &MockWatermarkRepository{ctrl: ctrl}
+	mock.recorder = &MockWatermarkRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWatermarkRepository) EXPECT() *MockWatermarkRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockWatermarkRepository) Delete(tx repository.Querier, creatorId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", tx, creatorId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWatermarkRepositoryMockRecorder) Delete(tx, creatorId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWatermarkRepository)(nil).Delete), tx, creatorId)
+}
+
+// FindByCreatorId mocks base method.
+func (m *MockWatermarkRepository) FindByCreatorId(tx repository.Querier, creatorId string) (*entity.CreatorWatermark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCreatorId", tx, creatorId)
+	ret0, _ := ret[0].(*entity.CreatorWatermark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCreatorId indicates an expected call of FindByCreatorId.
+func (mr *MockWatermarkRepositoryMockRecorder) FindByCreatorId(tx, creatorId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCreatorId", reflect.TypeOf((*MockWatermarkRepository)(nil).FindByCreatorId), tx, creatorId)
+}
+
+// Upsert mocks base method.
+func (m *MockWatermarkRepository) Upsert(tx repository.Querier, watermark *entity.CreatorWatermark) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", tx, watermark)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockWatermarkRepositoryMockRecorder) Upsert(tx, watermark interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockWatermarkRepository)(nil).Upsert), tx, watermark)
+}
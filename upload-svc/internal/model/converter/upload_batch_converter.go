@@ -0,0 +1,45 @@
+package converter
+
+import (
+	"be-yourmoments/upload-svc/internal/entity"
+	"be-yourmoments/upload-svc/internal/enum"
+	"be-yourmoments/upload-svc/internal/model"
+)
+
+func UploadBatchToResponse(batch *entity.UploadBatch, items *[]*entity.UploadBatchItem) *model.UploadBatchResponse {
+	response := &model.UploadBatchResponse{
+		BatchId:    batch.Id,
+		EventId:    batch.EventId,
+		TotalFiles: batch.TotalFiles,
+		Items:      make([]*model.UploadBatchItemResponse, 0, len(*items)),
+	}
+
+	for _, item := range *items {
+		switch item.Status {
+		case enum.UploadBatchItemStatusAccepted:
+			response.Progress.Accepted++
+			switch item.JobStatus {
+			case enum.CompressionJobStatusDone:
+				response.Progress.Done++
+			case enum.CompressionJobStatusDead:
+				response.Progress.Failed++
+			default:
+				response.Progress.Pending++
+			}
+		case enum.UploadBatchItemStatusFailed:
+			response.Progress.Failed++
+		default:
+			response.Progress.Rejected++
+		}
+
+		response.Items = append(response.Items, &model.UploadBatchItemResponse{
+			FileName:         item.FileName,
+			Status:           item.Status,
+			PhotoId:          item.PhotoId,
+			Error:            item.Error,
+			ProcessingStatus: item.JobStatus,
+		})
+	}
+
+	return response
+}
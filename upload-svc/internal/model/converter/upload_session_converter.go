@@ -0,0 +1,19 @@
+package converter
+
+import (
+	"be-yourmoments/upload-svc/internal/entity"
+	"be-yourmoments/upload-svc/internal/model"
+)
+
+func UploadSessionToResponse(session *entity.UploadSession) *model.UploadSessionResponse {
+	return &model.UploadSessionResponse{
+		UploadId:  session.Id,
+		FileName:  session.FileName,
+		Size:      session.Size,
+		Offset:    session.UploadOffset,
+		ChunkSize: session.ChunkSize,
+		Status:    session.Status,
+		PhotoId:   session.PhotoId,
+		ExpiresAt: session.ExpiresAt,
+	}
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"be-yourmoments/upload-svc/internal/enum"
 	"time"
 )
 
@@ -19,3 +20,14 @@ type MinioFileResponse struct {
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
 }
+
+// ImageRendition is one encoded output of the image pipeline, Format is the photo_details type.
+type ImageRendition struct {
+	Type     enum.YourMomentsType
+	Format   string
+	Mimetype string
+	Width    int
+	Height   int
+	Checksum string
+	Data     []byte
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"be-yourmoments/upload-svc/internal/enum"
+	"mime/multipart"
+	"time"
+)
+
 // TODO add similarity
 type RequestUpdateProcessedPhoto struct {
 	Id                     string
@@ -12,3 +18,87 @@ type RequestClaimPhoto struct {
 	Id     string
 	UserId string
 }
+
+// UploadPhotoRequest describes a photo a photographer uploads. An empty EventId uploads it on its
+// own, an empty PriceStr takes the default price of the event.
+type UploadPhotoRequest struct {
+	CreatorId string
+	EventId   string
+	PriceStr  string
+	Price     int
+}
+
+// UploadBatchRequest uploads many photos with the same event and price, either as Files or as the
+// images inside a ZIP Archive.
+type UploadBatchRequest struct {
+	UploadPhotoRequest
+	Files   []*multipart.FileHeader
+	Archive *multipart.FileHeader
+}
+
+type GetUploadBatchRequest struct {
+	BatchId   string
+	CreatorId string
+}
+
+// UploadBatchProgress counts the files of a batch. Pending and Done only count accepted files,
+// Failed counts files that could not be stored and accepted files whose processing gave up.
+type UploadBatchProgress struct {
+	Accepted int `json:"accepted"`
+	Rejected int `json:"rejected"`
+	Pending  int `json:"pending"`
+	Done     int `json:"done"`
+	Failed   int `json:"failed"`
+}
+
+type UploadBatchItemResponse struct {
+	FileName         string                     `json:"file_name"`
+	Status           enum.UploadBatchItemStatus `json:"status"`
+	PhotoId          string                     `json:"photo_id,omitempty"`
+	Error            string                     `json:"error,omitempty"`
+	ProcessingStatus enum.CompressionJobStatus  `json:"processing_status,omitempty"`
+}
+
+type UploadBatchResponse struct {
+	BatchId    string                     `json:"batch_id"`
+	EventId    string                     `json:"event_id,omitempty"`
+	TotalFiles int                        `json:"total_files"`
+	Progress   UploadBatchProgress        `json:"progress"`
+	Items      []*UploadBatchItemResponse `json:"items"`
+}
+
+// CreateUploadSessionRequest starts a resumable upload of a photo of Size bytes. Event and price
+// work like they do for UploadPhotoRequest.
+type CreateUploadSessionRequest struct {
+	CreatorId string `json:"-"`
+	FileName  string `json:"file_name"`
+	Size      int64  `json:"size"`
+	EventId   string `json:"event_id"`
+	PriceStr  string `json:"price"`
+	Price     int    `json:"-"`
+}
+
+type UploadSessionRequest struct {
+	UploadId  string
+	CreatorId string
+}
+
+// UploadChunkRequest carries the bytes of an upload starting at Offset. An empty chunk at the end of
+// the file retries registering a photo whose bytes all arrived.
+type UploadChunkRequest struct {
+	UploadId  string
+	CreatorId string
+	Offset    int64
+	Data      []byte
+}
+
+type UploadSessionResponse struct {
+	UploadId  string                   `json:"upload_id"`
+	FileName  string                   `json:"file_name"`
+	Size      int64                    `json:"size"`
+	Offset    int64                    `json:"offset"`
+	ChunkSize int64                    `json:"chunk_size"`
+	Status    enum.UploadSessionStatus `json:"status"`
+	PhotoId   string                   `json:"photo_id,omitempty"`
+	ExpiresAt time.Time                `json:"expires_at"`
+}
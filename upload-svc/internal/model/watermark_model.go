@@ -0,0 +1,34 @@
+package model
+
+import (
+	"mime/multipart"
+	"time"
+)
+
+// Watermark is what the image pipeline draws on a watermarked rendition: Text tiled over the
+// image and Logo centered on it. Either may be empty.
+type Watermark struct {
+	Text    string
+	Logo    []byte
+	Opacity float32
+}
+
+// UpdateWatermarkRequest sets the photographer's own watermark. A nil Logo keeps the current logo
+// unless RemoveLogo is set.
+type UpdateWatermarkRequest struct {
+	CreatorId  string
+	Text       string
+	Opacity    float32
+	Logo       *multipart.FileHeader
+	RemoveLogo bool
+}
+
+// WatermarkResponse is the watermark a photographer's photos get, Custom is false while the platform
+// watermark is used.
+type WatermarkResponse struct {
+	Custom    bool       `json:"custom"`
+	Text      string     `json:"text"`
+	LogoUrl   string     `json:"logo_url"`
+	Opacity   float32    `json:"opacity"`
+	UpdatedAt *time.Time `json:"updated_at"`
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"be-yourmoments/upload-svc/internal/entity"
+	"fmt"
+	"time"
+)
+
+type CompressionJobRepository interface {
+	Create(tx Querier, job *entity.CompressionJob) error
+	Claim(tx Querier, lockedUntil time.Time) (*entity.CompressionJob, error)
+	SaveResult(tx Querier, job *entity.CompressionJob) error
+	Finish(tx Querier, job *entity.CompressionJob) error
+	// ExistsPhotoChecksum reports whether the creator already uploaded a photo with this checksum,
+	// photos unpublished later in photo-svc still count.
+	ExistsPhotoChecksum(tx Querier, creatorId string, checksum string) (bool, error)
+}
+
+type compressionJobRepository struct {
+}
+
+func NewCompressionJobRepository() CompressionJobRepository {
+	return &compressionJobRepository{}
+}
+
+const compressionJobColumns = `id, type, status, COALESCE(photo_id, '') AS photo_id, COALESCE(user_id, '') AS user_id,
+	file_key, file_name, COALESCE(checksum, '') AS checksum, COALESCE(result_file_key, '') AS result_file_key,
+	attempts, max_attempts, COALESCE(last_error, '') AS last_error, run_at, locked_until, created_at, updated_at`
+
+func (r *compressionJobRepository) Create(tx Querier, job *entity.CompressionJob) error {
+	query := `INSERT INTO compression_jobs
+			  (id, type, status, photo_id, user_id, file_key, file_name, checksum, max_attempts, run_at, created_at, updated_at)
+			  VALUES ($1, $2, $3, NULLIF($4, ''), NULLIF($5, ''), $6, $7, NULLIF($8, ''), $9, $10, $11, $12)`
+
+	_, err := tx.Exec(query, job.Id, job.Type, job.Status, job.PhotoId, job.UserId, job.FileKey, job.FileName,
+		job.Checksum, job.MaxAttempts, job.RunAt, job.CreatedAt, job.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert compression job: %w", err)
+	}
+
+	return nil
+}
+
+// Claim locks the next due job for one worker until lockedUntil and counts the attempt. Jobs still
+// PROCESSING after their lock ran out belonged to a worker that stopped, they are claimed again.
+// SKIP LOCKED lets every worker poll at once without waiting on each other.
+func (r *compressionJobRepository) Claim(tx Querier, lockedUntil time.Time) (*entity.CompressionJob, error) {
+	query := `UPDATE compression_jobs
+			  SET status = 'PROCESSING', attempts = attempts + 1, locked_until = $1, updated_at = now()
+			  WHERE id = (
+				SELECT id FROM compression_jobs
+				WHERE (status = 'PENDING' AND run_at <= now()) OR (status = 'PROCESSING' AND locked_until <= now())
+				ORDER BY run_at
+				LIMIT 1
+				FOR UPDATE SKIP LOCKED
+			  )
+			  RETURNING ` + compressionJobColumns
+
+	job := new(entity.CompressionJob)
+	if err := tx.Get(job, query, lockedUntil); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+func (r *compressionJobRepository) SaveResult(tx Querier, job *entity.CompressionJob) error {
+	query := `UPDATE compression_jobs SET result_file_key = $1, updated_at = $2 WHERE id = $3`
+
+	if _, err := tx.Exec(query, job.ResultFileKey, job.UpdatedAt, job.Id); err != nil {
+		return fmt.Errorf("failed to save compression job result: %w", err)
+	}
+
+	return nil
+}
+
+// Finish stores the outcome of an attempt: DONE, DEAD or PENDING again at job.RunAt.
+func (r *compressionJobRepository) Finish(tx Querier, job *entity.CompressionJob) error {
+	query := `UPDATE compression_jobs
+			  SET status = $1, last_error = NULLIF($2, ''), run_at = $3, locked_until = NULL, updated_at = $4
+			  WHERE id = $5`
+
+	if _, err := tx.Exec(query, job.Status, job.LastError, job.RunAt, job.UpdatedAt, job.Id); err != nil {
+		return fmt.Errorf("failed to update compression job: %w", err)
+	}
+
+	return nil
+}
+
+func (r *compressionJobRepository) ExistsPhotoChecksum(tx Querier, creatorId string, checksum string) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM compression_jobs WHERE type = 'PHOTO' AND user_id = $1 AND checksum = $2)`
+
+	var exists bool
+	if err := tx.Get(&exists, query, creatorId, checksum); err != nil {
+		return false, fmt.Errorf("failed to look up photo checksum: %w", err)
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type Querier interface {
+	Queryx(query string, args ...interface{}) (*sqlx.Rows, error)
+	QueryRowx(query string, args ...interface{}) *sqlx.Row
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	Get(dest interface{}, query string, args ...interface{}) error
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"be-yourmoments/upload-svc/internal/entity"
+	"fmt"
+)
+
+type UploadBatchRepository interface {
+	Create(tx Querier, batch *entity.UploadBatch) error
+	CreateItem(tx Querier, item *entity.UploadBatchItem) error
+	FindById(tx Querier, id string) (*entity.UploadBatch, error)
+	// FindItemsByBatchId lists the files in upload order together with the status of their compression job.
+	FindItemsByBatchId(tx Querier, batchId string) (*[]*entity.UploadBatchItem, error)
+}
+
+type uploadBatchRepository struct {
+}
+
+func NewUploadBatchRepository() UploadBatchRepository {
+	return &uploadBatchRepository{}
+}
+
+func (r *uploadBatchRepository) Create(tx Querier, batch *entity.UploadBatch) error {
+	query := `INSERT INTO upload_batches (id, creator_id, event_id, total_files, created_at, updated_at)
+			  VALUES ($1, $2, NULLIF($3, ''), $4, $5, $6)`
+
+	_, err := tx.Exec(query, batch.Id, batch.CreatorId, batch.EventId, batch.TotalFiles, batch.CreatedAt, batch.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert upload batch: %w", err)
+	}
+
+	return nil
+}
+
+func (r *uploadBatchRepository) CreateItem(tx Querier, item *entity.UploadBatchItem) error {
+	query := `INSERT INTO upload_batch_items
+			  (id, batch_id, file_name, size, checksum, status, photo_id, compression_job_id, error, created_at)
+			  VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, NULLIF($7, ''), NULLIF($8, ''), NULLIF($9, ''), $10)`
+
+	_, err := tx.Exec(query, item.Id, item.BatchId, item.FileName, item.Size, item.Checksum, item.Status,
+		item.PhotoId, item.CompressionJobId, item.Error, item.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert upload batch item: %w", err)
+	}
+
+	return nil
+}
+
+func (r *uploadBatchRepository) FindById(tx Querier, id string) (*entity.UploadBatch, error) {
+	query := `SELECT id, creator_id, COALESCE(event_id, '') AS event_id, total_files, created_at, updated_at
+			  FROM upload_batches WHERE id = $1`
+
+	batch := new(entity.UploadBatch)
+	if err := tx.Get(batch, query, id); err != nil {
+		return nil, err
+	}
+
+	return batch, nil
+}
+
+func (r *uploadBatchRepository) FindItemsByBatchId(tx Querier, batchId string) (*[]*entity.UploadBatchItem, error) {
+	query := `SELECT i.id, i.batch_id, i.file_name, i.size, COALESCE(i.checksum, '') AS checksum, i.status,
+			  COALESCE(i.photo_id, '') AS photo_id, COALESCE(i.compression_job_id, '') AS compression_job_id,
+			  COALESCE(i.error, '') AS error, COALESCE(j.status::text, '') AS job_status, i.created_at
+			  FROM upload_batch_items i
+			  LEFT JOIN compression_jobs j ON j.id = i.compression_job_id
+			  WHERE i.batch_id = $1
+			  ORDER BY i.id`
+
+	rows, err := tx.Queryx(query, batchId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query upload batch items: %w", err)
+	}
+	defer rows.Close()
+
+	items := make([]*entity.UploadBatchItem, 0)
+	for rows.Next() {
+		item := new(entity.UploadBatchItem)
+		if err := rows.StructScan(item); err != nil {
+			return nil, fmt.Errorf("failed to scan upload batch item: %w", err)
+		}
+		items = append(items, item)
+	}
+
+	return &items, rows.Err()
+}
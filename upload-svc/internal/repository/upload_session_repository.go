@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"be-yourmoments/upload-svc/internal/entity"
+	"fmt"
+	"time"
+)
+
+type UploadSessionRepository interface {
+	Create(tx Querier, session *entity.UploadSession) error
+	FindById(tx Querier, id string) (*entity.UploadSession, error)
+	// FindByIdForUpdate locks the session until tx ends, so chunks of one upload are stored one at a time.
+	FindByIdForUpdate(tx Querier, id string) (*entity.UploadSession, error)
+	Update(tx Querier, session *entity.UploadSession) error
+	// FindExpired lists up to limit active sessions that ran out before they were completed.
+	FindExpired(tx Querier, now time.Time, limit int) (*[]*entity.UploadSession, error)
+	SavePart(tx Querier, part *entity.UploadSessionPart) error
+	FindPartsBySessionId(tx Querier, sessionId string) (*[]*entity.UploadSessionPart, error)
+}
+
+type uploadSessionRepository struct {
+}
+
+func NewUploadSessionRepository() UploadSessionRepository {
+	return &uploadSessionRepository{}
+}
+
+const uploadSessionColumns = `id, creator_id, COALESCE(event_id, '') AS event_id, price, COALESCE(price_str, '') AS price_str,
+	file_name, file_type, content_type, file_key, storage_upload_id, size, upload_offset, chunk_size,
+	COALESCE(hash_state, ''::bytea) AS hash_state, width, height, assembled, status, COALESCE(photo_id, '') AS photo_id,
+	expires_at, created_at, updated_at`
+
+func (r *uploadSessionRepository) Create(tx Querier, session *entity.UploadSession) error {
+	query := `INSERT INTO upload_sessions
+			  (id, creator_id, event_id, price, price_str, file_name, file_type, content_type, file_key,
+			  storage_upload_id, size, chunk_size, status, expires_at, created_at, updated_at)
+			  VALUES ($1, $2, NULLIF($3, ''), $4, NULLIF($5, ''), $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)`
+
+	_, err := tx.Exec(query, session.Id, session.CreatorId, session.EventId, session.Price, session.PriceStr,
+		session.FileName, session.FileType, session.ContentType, session.FileKey, session.StorageUploadId,
+		session.Size, session.ChunkSize, session.Status, session.ExpiresAt, session.CreatedAt, session.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert upload session: %w", err)
+	}
+
+	return nil
+}
+
+func (r *uploadSessionRepository) FindById(tx Querier, id string) (*entity.UploadSession, error) {
+	query := `SELECT ` + uploadSessionColumns + ` FROM upload_sessions WHERE id = $1`
+
+	session := new(entity.UploadSession)
+	if err := tx.Get(session, query, id); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func (r *uploadSessionRepository) FindByIdForUpdate(tx Querier, id string) (*entity.UploadSession, error) {
+	query := `SELECT ` + uploadSessionColumns + ` FROM upload_sessions WHERE id = $1 FOR UPDATE`
+
+	session := new(entity.UploadSession)
+	if err := tx.Get(session, query, id); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func (r *uploadSessionRepository) Update(tx Querier, session *entity.UploadSession) error {
+	query := `UPDATE upload_sessions
+			  SET upload_offset = $1, hash_state = $2, width = $3, height = $4, assembled = $5, status = $6,
+			  photo_id = NULLIF($7, ''), expires_at = $8, updated_at = $9
+			  WHERE id = $10`
+
+	_, err := tx.Exec(query, session.UploadOffset, session.HashState, session.Width, session.Height,
+		session.Assembled, session.Status, session.PhotoId, session.ExpiresAt, session.UpdatedAt, session.Id)
+	if err != nil {
+		return fmt.Errorf("failed to update upload session: %w", err)
+	}
+
+	return nil
+}
+
+func (r *uploadSessionRepository) FindExpired(tx Querier, now time.Time, limit int) (*[]*entity.UploadSession, error) {
+	query := `SELECT ` + uploadSessionColumns + ` FROM upload_sessions
+			  WHERE status = 'ACTIVE' AND expires_at <= $1
+			  ORDER BY expires_at
+			  LIMIT $2`
+
+	rows, err := tx.Queryx(query, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query expired upload sessions: %w", err)
+	}
+	defer rows.Close()
+
+	sessions := make([]*entity.UploadSession, 0)
+	for rows.Next() {
+		session := new(entity.UploadSession)
+		if err := rows.StructScan(session); err != nil {
+			return nil, fmt.Errorf("failed to scan upload session: %w", err)
+		}
+		sessions = append(sessions, session)
+	}
+
+	return &sessions, rows.Err()
+}
+
+// SavePart keeps the newest etag when a part is sent again after its response got lost.
+func (r *uploadSessionRepository) SavePart(tx Querier, part *entity.UploadSessionPart) error {
+	query := `INSERT INTO upload_session_parts (session_id, part_number, etag, size, created_at)
+			  VALUES ($1, $2, $3, $4, $5)
+			  ON CONFLICT (session_id, part_number) DO UPDATE
+			  SET etag = EXCLUDED.etag, size = EXCLUDED.size, created_at = EXCLUDED.created_at`
+
+	_, err := tx.Exec(query, part.SessionId, part.PartNumber, part.ETag, part.Size, part.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save upload session part: %w", err)
+	}
+
+	return nil
+}
+
+func (r *uploadSessionRepository) FindPartsBySessionId(tx Querier, sessionId string) (*[]*entity.UploadSessionPart, error) {
+	query := `SELECT session_id, part_number, etag, size, created_at
+			  FROM upload_session_parts WHERE session_id = $1
+			  ORDER BY part_number`
+
+	rows, err := tx.Queryx(query, sessionId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query upload session parts: %w", err)
+	}
+	defer rows.Close()
+
+	parts := make([]*entity.UploadSessionPart, 0)
+	for rows.Next() {
+		part := new(entity.UploadSessionPart)
+		if err := rows.StructScan(part); err != nil {
+			return nil, fmt.Errorf("failed to scan upload session part: %w", err)
+		}
+		parts = append(parts, part)
+	}
+
+	return &parts, rows.Err()
+}
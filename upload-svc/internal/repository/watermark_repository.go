@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"be-yourmoments/upload-svc/internal/entity"
+	"fmt"
+)
+
+type WatermarkRepository interface {
+	FindByCreatorId(tx Querier, creatorId string) (*entity.CreatorWatermark, error)
+	Upsert(tx Querier, watermark *entity.CreatorWatermark) error
+	Delete(tx Querier, creatorId string) error
+}
+
+type watermarkRepository struct {
+}
+
+func NewWatermarkRepository() WatermarkRepository {
+	return &watermarkRepository{}
+}
+
+func (r *watermarkRepository) FindByCreatorId(tx Querier, creatorId string) (*entity.CreatorWatermark, error) {
+	query := `SELECT creator_id, COALESCE(text, '') AS text, COALESCE(logo_file_key, '') AS logo_file_key,
+			  opacity, created_at, updated_at
+			  FROM creator_watermarks WHERE creator_id = $1`
+
+	watermark := new(entity.CreatorWatermark)
+	if err := tx.Get(watermark, query, creatorId); err != nil {
+		return nil, err
+	}
+
+	return watermark, nil
+}
+
+func (r *watermarkRepository) Upsert(tx Querier, watermark *entity.CreatorWatermark) error {
+	query := `INSERT INTO creator_watermarks (creator_id, text, logo_file_key, opacity, created_at, updated_at)
+			  VALUES ($1, NULLIF($2, ''), NULLIF($3, ''), $4, $5, $6)
+			  ON CONFLICT (creator_id) DO UPDATE
+			  SET text = EXCLUDED.text, logo_file_key = EXCLUDED.logo_file_key, opacity = EXCLUDED.opacity,
+			  updated_at = EXCLUDED.updated_at`
+
+	_, err := tx.Exec(query, watermark.CreatorId, watermark.Text, watermark.LogoFileKey, watermark.Opacity,
+		watermark.CreatedAt, watermark.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save watermark: %w", err)
+	}
+
+	return nil
+}
+
+func (r *watermarkRepository) Delete(tx Querier, creatorId string) error {
+	if _, err := tx.Exec(`DELETE FROM creator_watermarks WHERE creator_id = $1`, creatorId); err != nil {
+		return fmt.Errorf("failed to delete watermark: %w", err)
+	}
+
+	return nil
+}
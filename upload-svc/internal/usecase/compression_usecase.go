@@ -0,0 +1,289 @@
+package usecase
+
+import (
+	"be-yourmoments/upload-svc/internal/adapter"
+	"be-yourmoments/upload-svc/internal/config"
+	"be-yourmoments/upload-svc/internal/entity"
+	"be-yourmoments/upload-svc/internal/enum"
+	"be-yourmoments/upload-svc/internal/model"
+	"be-yourmoments/upload-svc/internal/repository"
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"mime/multipart"
+	"net/textproto"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/oklog/ulid/v2"
+)
+
+// compressionMaxAttempts is how often a job is tried before it is left DEAD.
+const compressionMaxAttempts = 5
+
+// compressionBackoff is the wait before the next attempt, it doubles from 30 seconds up to an hour.
+func compressionBackoff(attempts int) time.Duration {
+	backoff := 30 * time.Second
+	for i := 1; i < attempts && backoff < time.Hour; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, time.Hour)
+}
+
+func newCompressionJob(jobType enum.CompressionJobType, fileKey string, fileName string) *entity.CompressionJob {
+	now := time.Now()
+	return &entity.CompressionJob{
+		Id:          ulid.Make().String(),
+		Type:        jobType,
+		Status:      enum.CompressionJobStatusPending,
+		FileKey:     fileKey,
+		FileName:    fileName,
+		MaxAttempts: compressionMaxAttempts,
+		RunAt:       now,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+type CompressionUsecase interface {
+	// ProcessNextJob runs one due job and reports whether there was one. A failed job is put back
+	// with a backoff, so the error is only returned when the queue itself could not be read or updated.
+	ProcessNextJob(ctx context.Context) (bool, error)
+}
+
+type compressionUsecase struct {
+	db                 *sqlx.DB
+	compressionJobRepo repository.CompressionJobRepository
+	aiAdapter          adapter.AiAdapter
+	photoAdapter       adapter.PhotoAdapter
+	storageAdapter     adapter.StorageAdapter
+	compressAdapter    adapter.CompressAdapter
+	watermarkRepo      repository.WatermarkRepository
+
+	renditions        []config.Rendition
+	platformWatermark *model.Watermark
+	jobTimeout        time.Duration
+}
+
+func NewCompressionUsecase(db *sqlx.DB, compressionJobRepo repository.CompressionJobRepository,
+	aiAdapter adapter.AiAdapter, photoAdapter adapter.PhotoAdapter, storageAdapter adapter.StorageAdapter,
+	compressAdapter adapter.CompressAdapter, watermarkRepo repository.WatermarkRepository, renditions []config.Rendition,
+	platformWatermark *model.Watermark, jobTimeout time.Duration) CompressionUsecase {
+	return &compressionUsecase{
+		db:                 db,
+		compressionJobRepo: compressionJobRepo,
+		aiAdapter:          aiAdapter,
+		photoAdapter:       photoAdapter,
+		storageAdapter:     storageAdapter,
+		compressAdapter:    compressAdapter,
+		watermarkRepo:      watermarkRepo,
+
+		renditions:        renditions,
+		platformWatermark: platformWatermark,
+		jobTimeout:        jobTimeout,
+	}
+}
+
+func (u *compressionUsecase) ProcessNextJob(ctx context.Context) (bool, error) {
+	job, err := u.compressionJobRepo.Claim(u.db, time.Now().Add(u.jobTimeout))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		log.Println(err)
+		return false, err
+	}
+
+	// claimed back from a worker that stopped while running its last attempt
+	if job.Attempts > job.MaxAttempts {
+		job.LastError = "worker stopped during the last attempt"
+		return true, u.finishJob(job, enum.CompressionJobStatusDead)
+	}
+
+	jobCtx, cancel := context.WithTimeout(ctx, u.jobTimeout)
+	defer cancel()
+
+	if err := u.runJob(jobCtx, job); err != nil {
+		log.Printf("compression job %s failed on attempt %d: %v", job.Id, job.Attempts, err)
+		job.LastError = err.Error()
+		if job.Attempts >= job.MaxAttempts {
+			return true, u.finishJob(job, enum.CompressionJobStatusDead)
+		}
+		job.RunAt = time.Now().Add(compressionBackoff(job.Attempts))
+		return true, u.finishJob(job, enum.CompressionJobStatusPending)
+	}
+
+	job.LastError = ""
+	return true, u.finishJob(job, enum.CompressionJobStatusDone)
+}
+
+func (u *compressionUsecase) finishJob(job *entity.CompressionJob, status enum.CompressionJobStatus) error {
+	job.Status = status
+	job.UpdatedAt = time.Now()
+	if err := u.compressionJobRepo.Finish(u.db, job); err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+// runJob renders the original unless an earlier attempt already did, then hands the compressed
+// rendition to the ai service.
+func (u *compressionUsecase) runJob(ctx context.Context, job *entity.CompressionJob) error {
+	if job.ResultFileKey == "" {
+		if err := u.compress(ctx, job); err != nil {
+			return err
+		}
+	}
+
+	fileUrl, err := u.storageAdapter.PresignFile(ctx, job.ResultFileKey, time.Hour)
+	if err != nil {
+		return err
+	}
+
+	switch job.Type {
+	case enum.CompressionJobTypePhoto:
+		err = u.aiAdapter.ProcessPhoto(ctx, job.PhotoId, fileUrl)
+	case enum.CompressionJobTypeFacecam:
+		err = u.aiAdapter.ProcessFacecam(ctx, job.UserId, fileUrl)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to send to ai: %w", err)
+	}
+
+	// facecam originals are only kept for the job, photo originals are the collection
+	if job.Type == enum.CompressionJobTypeFacecam {
+		if _, err := u.storageAdapter.DeleteFile(ctx, job.FileKey); err != nil {
+			log.Println(err)
+		}
+	}
+
+	return nil
+}
+
+// compress renders the original into every configured rendition, stores them all and only then
+// registers them with photo-svc. Files photo-svc does not reference yet are removed again when an
+// attempt fails so a retry does not leave them behind, and photo-svc keeps one row per rendition type
+// so registering again replaces the ones that were registered.
+func (u *compressionUsecase) compress(ctx context.Context, job *entity.CompressionJob) error {
+	original, err := u.storageAdapter.OpenFile(ctx, job.FileKey)
+	if err != nil {
+		return err
+	}
+	defer original.Close()
+
+	// facecams are only matched by the ai service, they never need the smaller renditions
+	renditions := u.renditions
+	var watermark *model.Watermark
+	if job.Type == enum.CompressionJobTypeFacecam {
+		renditions = nil
+		for _, rendition := range u.renditions {
+			if rendition.Type == enum.YourMomentTypeCompressed {
+				renditions = append(renditions, rendition)
+			}
+		}
+	} else {
+		watermark, err = loadWatermark(ctx, u.db, u.watermarkRepo, u.storageAdapter, u.platformWatermark, job.UserId)
+		if err != nil {
+			return fmt.Errorf("failed to load watermark: %w", err)
+		}
+	}
+
+	rendered, err := u.compressAdapter.RenderImage(original, renditions, watermark)
+	if err != nil {
+		return fmt.Errorf("failed to render image: %w", err)
+	}
+
+	// cleanup removes renditions photo-svc does not know about, registered ones are still referenced
+	cleanup := func(unregistered []*model.MinioFileResponse) {
+		for _, upload := range unregistered {
+			if _, err := u.storageAdapter.DeleteFile(ctx, upload.FileKey); err != nil {
+				log.Println(err)
+			}
+		}
+	}
+
+	baseName := strings.TrimSuffix(job.FileName, filepath.Ext(job.FileName))
+	uploads := make([]*model.MinioFileResponse, 0, len(rendered))
+	for _, rendition := range rendered {
+		fileName := fmt.Sprintf("%s_%s.%s", baseName, strings.ToLower(string(rendition.Type)), strings.ToLower(rendition.Format))
+
+		mimeHeader := make(textproto.MIMEHeader)
+		mimeHeader.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, fileName))
+		mimeHeader.Set("Content-Type", rendition.Mimetype)
+
+		fileHeader := &multipart.FileHeader{
+			Filename: fileName,
+			Header:   mimeHeader,
+			Size:     int64(len(rendition.Data)),
+		}
+
+		uploadPath := strings.ToLower(string(job.Type)) + "/" + strings.ToLower(string(rendition.Type))
+		upload, err := u.storageAdapter.UploadFile(ctx, fileHeader, nopReadSeekCloser{bytes.NewReader(rendition.Data)}, uploadPath)
+		if err != nil {
+			cleanup(uploads)
+			return err
+		}
+		uploads = append(uploads, upload)
+	}
+
+	now := time.Now()
+	for i, rendition := range rendered {
+		upload := uploads[i]
+		switch job.Type {
+		case enum.CompressionJobTypePhoto:
+			err = u.photoAdapter.UpdatePhotoDetail(ctx, &entity.PhotoDetail{
+				Id:              ulid.Make().String(),
+				PhotoId:         job.PhotoId,
+				FileName:        upload.Filename,
+				FileKey:         upload.FileKey,
+				Size:            upload.Size,
+				Type:            rendition.Format,
+				Checksum:        rendition.Checksum,
+				Width:           rendition.Width,
+				Height:          rendition.Height,
+				Url:             upload.URL,
+				YourMomentsType: rendition.Type,
+				CreatedAt:       now,
+				UpdatedAt:       now,
+			})
+		case enum.CompressionJobTypeFacecam:
+			err = u.photoAdapter.CreateFacecam(ctx, &entity.Facecam{
+				Id:         ulid.Make().String(),
+				UserId:     job.UserId,
+				FileName:   upload.Filename,
+				FileKey:    upload.FileKey,
+				Title:      upload.Filename,
+				Size:       upload.Size,
+				Checksum:   job.Checksum,
+				Url:        upload.URL,
+				OriginalAt: job.CreatedAt,
+				CreatedAt:  now,
+				UpdatedAt:  now,
+			})
+		}
+		if err != nil {
+			cleanup(uploads[i:])
+			return err
+		}
+
+		if rendition.Type == enum.YourMomentTypeCompressed {
+			job.ResultFileKey = upload.FileKey
+		}
+	}
+
+	job.UpdatedAt = now
+	if err := u.compressionJobRepo.SaveResult(u.db, job); err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
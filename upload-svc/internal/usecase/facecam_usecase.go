@@ -2,7 +2,8 @@ package usecase
 
 import (
 	"be-yourmoments/upload-svc/internal/adapter"
-	"be-yourmoments/upload-svc/internal/entity"
+	"be-yourmoments/upload-svc/internal/enum"
+	"be-yourmoments/upload-svc/internal/repository"
 	"bytes"
 	"context"
 	"crypto/sha256"
@@ -10,12 +11,9 @@ import (
 	"io"
 	"log"
 	"mime/multipart"
-	"net/textproto"
-	"os"
-	"time"
 
 	"github.com/gofiber/fiber"
-	"github.com/oklog/ulid/v2"
+	"github.com/jmoiron/sqlx"
 )
 
 type FacecamUseCase interface {
@@ -24,19 +22,17 @@ type FacecamUseCase interface {
 }
 
 type facecamUseCase struct {
-	aiAdapter       adapter.AiAdapter
-	photoAdapter    adapter.PhotoAdapter
-	storageAdapter  adapter.StorageAdapter
-	compressAdapter adapter.CompressAdapter
+	db                 *sqlx.DB
+	compressionJobRepo repository.CompressionJobRepository
+	storageAdapter     adapter.StorageAdapter
 }
 
-func NewFacecamUseCase(aiAdapter adapter.AiAdapter, photoAdapter adapter.PhotoAdapter,
-	storageAdapter adapter.StorageAdapter, compressAdapter adapter.CompressAdapter) FacecamUseCase {
+func NewFacecamUseCase(db *sqlx.DB, compressionJobRepo repository.CompressionJobRepository,
+	storageAdapter adapter.StorageAdapter) FacecamUseCase {
 	return &facecamUseCase{
-		aiAdapter:       aiAdapter,
-		photoAdapter:    photoAdapter,
-		storageAdapter:  storageAdapter,
-		compressAdapter: compressAdapter,
+		db:                 db,
+		compressionJobRepo: compressionJobRepo,
+		storageAdapter:     storageAdapter,
 	}
 }
 
@@ -59,70 +55,19 @@ func (u *facecamUseCase) UploadFacecam(ctx context.Context, file *multipart.File
 
 	checksum := fmt.Sprintf("%x", sha256.Sum256(data))
 
-	go func() {
-		_, filePath, err := u.compressAdapter.CompressImage(file, wrappedReader, "facecam")
-		if err != nil {
-			log.Printf("Error compressing images: %v", err)
-			return
-		}
-
-		fileComp, err := os.Open(filePath)
-		if err != nil {
-			log.Printf("Error opening file: %v", err)
-			return
-		}
-		defer fileComp.Close()
-
-		fileInfo, err := fileComp.Stat()
-		if err != nil {
-			log.Printf("Error stating file: %v", err)
-			return
-		}
-
-		mimeHeader := make(textproto.MIMEHeader)
-		mimeHeader.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, fileInfo.Name()))
-
-		fileHeader := &multipart.FileHeader{
-			Filename: fileInfo.Name(),
-			Header:   mimeHeader,
-			Size:     fileInfo.Size(),
-		}
-
-		uploadPath := "facecam/compressed"
-		compressedPhoto, err := u.storageAdapter.UploadFile(ctx, fileHeader, fileComp, uploadPath)
-		if err != nil {
-			log.Printf("Error uploading file: %v", err)
-			return
-		}
-
-		newFacecam := &entity.Facecam{
-			Id:         ulid.Make().String(),
-			UserId:     userId,
-			FileName:   compressedPhoto.Filename,
-			FileKey:    compressedPhoto.FileKey,
-			Title:      compressedPhoto.Filename,
-			Size:       compressedPhoto.Size,
-			Checksum:   checksum,
-			Url:        compressedPhoto.URL,
-			OriginalAt: time.Now(),
-			CreatedAt:  time.Now(),
-			UpdatedAt:  time.Now(),
-		}
-
-		if err := u.photoAdapter.CreateFacecam(ctx, newFacecam); err != nil {
-			log.Printf("Error creating facecam: %v", err)
-			return
-		}
-
-		if err := os.Remove(filePath); err != nil {
-			log.Printf("Gagal menghapus file: %v", err)
-		} else {
-			log.Printf("File sementara berhasil dihapus: %s", filePath)
-		}
+	// the original is only kept until the compression job has run
+	upload, err := u.storageAdapter.UploadFile(ctx, file, wrappedReader, "facecam/original")
+	if err != nil {
+		return err
+	}
 
-		u.aiAdapter.ProcessFacecam(ctx, userId, compressedPhoto.URL)
-	}()
+	job := newCompressionJob(enum.CompressionJobTypeFacecam, upload.FileKey, upload.Filename)
+	job.UserId = userId
+	job.Checksum = checksum
+	if err := u.compressionJobRepo.Create(u.db, job); err != nil {
+		log.Printf("Error queueing facecam compression: %v", err)
+		return err
+	}
 
 	return nil
-
 }
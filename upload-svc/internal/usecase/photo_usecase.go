@@ -1,49 +1,87 @@
 package usecase
 
 import (
+	"archive/zip"
 	"be-yourmoments/upload-svc/internal/adapter"
+	"be-yourmoments/upload-svc/internal/config"
 	"be-yourmoments/upload-svc/internal/entity"
 	"be-yourmoments/upload-svc/internal/enum"
+	"be-yourmoments/upload-svc/internal/model"
+	"be-yourmoments/upload-svc/internal/model/converter"
+	"be-yourmoments/upload-svc/internal/repository"
 	"bytes"
 	"context"
 	"crypto/sha256"
+	"database/sql"
+	"encoding"
+	"errors"
 	"fmt"
+	"hash"
 	"image"
 	"io"
 	"log"
 	"mime/multipart"
 	"net/textproto"
 	"os"
+	"path"
 	"strings"
+	"sync"
 	"time"
 
 	_ "image/jpeg"
 	_ "image/png"
 
-	"github.com/gofiber/fiber"
+	"github.com/gofiber/fiber/v2"
+	"github.com/jmoiron/sqlx"
 	"github.com/oklog/ulid/v2"
 )
 
 type PhotoUsecase interface {
-	UploadPhoto(ctx context.Context, file *multipart.FileHeader, priceStr string, price int) error
+	UploadPhoto(ctx context.Context, file *multipart.FileHeader, request *model.UploadPhotoRequest) error
+	// UploadBatch accepts every valid file of the batch in the background, GetUploadBatch reports
+	// what happened to each of them.
+	UploadBatch(ctx context.Context, request *model.UploadBatchRequest) (*model.UploadBatchResponse, error)
+	GetUploadBatch(ctx context.Context, request *model.GetUploadBatchRequest) (*model.UploadBatchResponse, error)
+	CreateUploadSession(ctx context.Context, request *model.CreateUploadSessionRequest) (*model.UploadSessionResponse, error)
+	GetUploadSession(ctx context.Context, request *model.UploadSessionRequest) (*model.UploadSessionResponse, error)
+	// UploadChunk stores the chunk at the session's offset and registers the photo once all bytes arrived.
+	UploadChunk(ctx context.Context, request *model.UploadChunkRequest) (*model.UploadSessionResponse, error)
+	AbortUploadSession(ctx context.Context, request *model.UploadSessionRequest) error
+	AbortExpiredUploadSessions(ctx context.Context)
 	// UpdateProcessedPhoto(ctx context.Context, req *model.RequestUpdateProcessedPhoto) (error, error)
 }
 
 type photoUsecase struct {
-	aiAdapter       adapter.AiAdapter
-	photoAdapter    adapter.PhotoAdapter
-	storageAdapter  adapter.StorageAdapter
-	compressAdapter adapter.CompressAdapter
+	db                 *sqlx.DB
+	compressionJobRepo repository.CompressionJobRepository
+	uploadBatchRepo    repository.UploadBatchRepository
+	uploadSessionRepo  repository.UploadSessionRepository
+	photoAdapter       adapter.PhotoAdapter
+	storageAdapter     adapter.StorageAdapter
+
+	photoMaxSize     int64
+	batchMaxFiles    int
+	resumableMaxSize int64
+	uploadChunkSize  int64
+	uploadSessionTTL time.Duration
 }
 
-func NewPhotoUsecase(aiAdapter adapter.AiAdapter, photoAdapter adapter.PhotoAdapter,
-	storageAdapter adapter.StorageAdapter,
-	compressAdapter adapter.CompressAdapter) PhotoUsecase {
+func NewPhotoUsecase(db *sqlx.DB, compressionJobRepo repository.CompressionJobRepository,
+	uploadBatchRepo repository.UploadBatchRepository, uploadSessionRepo repository.UploadSessionRepository,
+	photoAdapter adapter.PhotoAdapter, storageAdapter adapter.StorageAdapter, serverConfig config.ServerConfig) PhotoUsecase {
 	return &photoUsecase{
-		aiAdapter:       aiAdapter,
-		photoAdapter:    photoAdapter,
-		storageAdapter:  storageAdapter,
-		compressAdapter: compressAdapter,
+		db:                 db,
+		compressionJobRepo: compressionJobRepo,
+		uploadBatchRepo:    uploadBatchRepo,
+		uploadSessionRepo:  uploadSessionRepo,
+		photoAdapter:       photoAdapter,
+		storageAdapter:     storageAdapter,
+
+		photoMaxSize:     serverConfig.PhotoMaxSize,
+		batchMaxFiles:    serverConfig.BatchMaxFiles,
+		resumableMaxSize: serverConfig.ResumableMaxSize,
+		uploadChunkSize:  serverConfig.UploadChunkSize,
+		uploadSessionTTL: serverConfig.UploadSessionTTL,
 	}
 }
 
@@ -55,45 +93,51 @@ func (n nopReadSeekCloser) Close() error {
 	return nil
 }
 
-func (u *photoUsecase) UploadPhoto(ctx context.Context, file *multipart.FileHeader, priceStr string, price int) error {
+func (u *photoUsecase) UploadPhoto(ctx context.Context, file *multipart.FileHeader, request *model.UploadPhotoRequest) error {
+	if file.Size > u.photoMaxSize {
+		return fiber.NewError(fiber.StatusRequestEntityTooLarge, fmt.Sprintf("file must be at most %d MB", u.photoMaxSize>>20))
+	}
+
 	uploadFile, err := file.Open()
 	if err != nil {
 		log.Print("parse file error: " + err.Error())
 		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
 	}
+	defer uploadFile.Close()
 
-	data, err := io.ReadAll(uploadFile)
+	imgConfig, format, err := image.DecodeConfig(uploadFile)
 	if err != nil {
+		log.Print("image decode error:", err)
+		return fiber.NewError(fiber.StatusBadRequest, "Not a valid images")
+	}
+
+	// the file is read once for its checksum and once more by the upload, it is never held in memory
+	if _, err := uploadFile.Seek(0, io.SeekStart); err != nil {
 		log.Print("failed to read file: ", err)
 		return fiber.NewError(fiber.StatusInternalServerError, "internal error")
 	}
-	uploadFile.Close()
-
-	readerForUpload := bytes.NewReader(data)
-	wrappedReader := nopReadSeekCloser{readerForUpload}
-
-	upload, err := u.storageAdapter.UploadFile(ctx, file, wrappedReader, "photo")
-	if err != nil {
-		return err
+	digest := sha256.New()
+	if _, err := io.Copy(digest, uploadFile); err != nil {
+		log.Print("failed to read file: ", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "internal error")
 	}
-
-	newPhoto := &entity.Photo{
-		Id:            ulid.Make().String(),
-		CreatorId:     "test-create-photo-case-2",
-		Title:         upload.Filename,
-		CollectionUrl: upload.URL,
-		Price:         price,
-		PriceStr:      priceStr,
-		OriginalAt:    time.Now(),
-		CreatedAt:     time.Now(),
-		UpdatedAt:     time.Now(),
+	if _, err := uploadFile.Seek(0, io.SeekStart); err != nil {
+		log.Print("failed to read file: ", err)
+		return fiber.NewError(fiber.StatusInternalServerError, "internal error")
 	}
 
-	readerForDecode := bytes.NewReader(data)
-	imgConfig, format, err := image.DecodeConfig(readerForDecode)
+	_, err = u.createPhoto(ctx, file, uploadFile, fmt.Sprintf("%x", digest.Sum(nil)), imgConfig, format, request)
+	return err
+}
+
+// createPhoto stores a decoded original, registers it with photo-svc and queues its compression.
+// The stored original is removed again when photo-svc refuses it, and the photo is taken back from
+// photo-svc when its compression can not be queued, it would never get its renditions otherwise.
+func (u *photoUsecase) createPhoto(ctx context.Context, file *multipart.FileHeader, uploadFile multipart.File, checksum string,
+	imgConfig image.Config, format string, request *model.UploadPhotoRequest) (*entity.CompressionJob, error) {
+	upload, err := u.storageAdapter.UploadFile(ctx, file, uploadFile, "photo")
 	if err != nil {
-		log.Print("image decode error:", err)
-		return fiber.NewError(fiber.StatusBadRequest, "Not a valid images")
+		return nil, err
 	}
 
 	log.Println("Decoded image format:", format)
@@ -106,7 +150,42 @@ func (u *photoUsecase) UploadPhoto(ctx context.Context, file *multipart.FileHead
 		imageType = strings.ToUpper(format)
 	}
 
-	checksum := fmt.Sprintf("%x", sha256.Sum256(data))
+	newPhoto, newPhotoDetail := newPhotoFromUpload(upload, imageType, checksum, imgConfig.Width, imgConfig.Height, request)
+	if err := u.photoAdapter.CreatePhoto(ctx, newPhoto, newPhotoDetail); err != nil {
+		log.Printf("Error creating photo: %v", err)
+		if _, deleteErr := u.storageAdapter.DeleteFile(ctx, upload.FileKey); deleteErr != nil {
+			log.Println(deleteErr)
+		}
+		return nil, err
+	}
+
+	job, err := u.queuePhotoCompression(u.db, newPhoto, upload, checksum)
+	if err != nil {
+		// photo-svc removes the stored original together with the photo
+		if deleteErr := u.photoAdapter.DeletePhoto(ctx, newPhoto.Id, newPhoto.CreatorId); deleteErr != nil {
+			log.Printf("failed to delete photo %s after its compression could not be queued: %v", newPhoto.Id, deleteErr)
+		}
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// newPhotoFromUpload describes a stored original for photo-svc, it becomes the COLLECTION rendition.
+func newPhotoFromUpload(upload *model.MinioFileResponse, imageType string, checksum string, width int, height int,
+	request *model.UploadPhotoRequest) (*entity.Photo, *entity.PhotoDetail) {
+	newPhoto := &entity.Photo{
+		Id:            ulid.Make().String(),
+		CreatorId:     request.CreatorId,
+		Title:         upload.Filename,
+		CollectionUrl: upload.URL,
+		EventId:       request.EventId,
+		Price:         request.Price,
+		PriceStr:      request.PriceStr,
+		OriginalAt:    time.Now(),
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
 
 	newPhotoDetail := &entity.PhotoDetail{
 		Id:              ulid.Make().String(),
@@ -116,89 +195,744 @@ func (u *photoUsecase) UploadPhoto(ctx context.Context, file *multipart.FileHead
 		Size:            upload.Size,
 		Type:            imageType,
 		Checksum:        checksum,
-		Width:           imgConfig.Width,  // disesuaikan tipe data jika perlu
-		Height:          imgConfig.Height, // disesuaikan tipe data jika perlu
+		Width:           width,  // disesuaikan tipe data jika perlu
+		Height:          height, // disesuaikan tipe data jika perlu
 		Url:             upload.URL,
 		YourMomentsType: enum.YourMomentTypeCollection,
 		CreatedAt:       time.Now(),
 		UpdatedAt:       time.Now(),
 	}
 
-	if err := u.photoAdapter.CreatePhoto(ctx, newPhoto, newPhotoDetail); err != nil {
-		log.Printf("Error creating photo: %v", err)
-		return err
+	return newPhoto, newPhotoDetail
+}
+
+func (u *photoUsecase) queuePhotoCompression(tx repository.Querier, photo *entity.Photo, upload *model.MinioFileResponse,
+	checksum string) (*entity.CompressionJob, error) {
+	job := newCompressionJob(enum.CompressionJobTypePhoto, upload.FileKey, upload.Filename)
+	job.PhotoId = photo.Id
+	job.UserId = photo.CreatorId
+	job.Checksum = checksum
+	if err := u.compressionJobRepo.Create(tx, job); err != nil {
+		log.Printf("Error queueing photo compression: %v", err)
+		return nil, err
 	}
 
-	/* TO DO
-	1. Pastikan I/O untuk open file dilakukan secara efisien disini
-	2. Pastikan persitensy hasil kompres dilakukan dengan baik
+	return job, nil
+}
+
+// batchUploadConcurrency is how many accepted files of a batch are stored and registered at once,
+// it also bounds how many files are held in memory.
+const batchUploadConcurrency = 4
+
+// batchFile is one file of a batch upload, opened only when it is its turn.
+type batchFile struct {
+	header *multipart.FileHeader
+	open   func() (io.ReadCloser, error)
+}
+
+// UploadBatch copies the files of the batch and returns as soon as the batch is created, its items
+// are processed in the background and GetUploadBatch reports how far it got.
+func (u *photoUsecase) UploadBatch(ctx context.Context, request *model.UploadBatchRequest) (*model.UploadBatchResponse, error) {
+	files, closeFiles, err := u.batchFiles(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(files) == 0 {
+		closeFiles()
+		return nil, fiber.NewError(fiber.StatusBadRequest, "batch has no files")
+	}
+	if len(files) > u.batchMaxFiles {
+		closeFiles()
+		return nil, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("a batch holds at most %d files", u.batchMaxFiles))
+	}
+
+	now := time.Now()
+	batch := &entity.UploadBatch{
+		Id:         ulid.Make().String(),
+		CreatorId:  request.CreatorId,
+		EventId:    request.EventId,
+		TotalFiles: len(files),
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if err := u.uploadBatchRepo.Create(u.db, batch); err != nil {
+		log.Println(err)
+		closeFiles()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, "internal error")
+	}
 
-	*/
 	go func() {
-		_, filePath, err := u.compressAdapter.CompressImage(file, wrappedReader, "photo")
+		defer closeFiles()
+		u.processBatch(context.WithoutCancel(ctx), batch, files, &request.UploadPhotoRequest)
+	}()
+
+	return converter.UploadBatchToResponse(batch, &[]*entity.UploadBatchItem{}), nil
+}
+
+func (u *photoUsecase) processBatch(ctx context.Context, batch *entity.UploadBatch, files []*batchFile,
+	request *model.UploadPhotoRequest) {
+	seen := make(map[string]bool)
+	slots := make(chan struct{}, batchUploadConcurrency)
+	var wg sync.WaitGroup
+	for _, file := range files {
+		item := &entity.UploadBatchItem{
+			Id:        ulid.Make().String(),
+			BatchId:   batch.Id,
+			FileName:  file.header.Filename,
+			Size:      file.header.Size,
+			CreatedAt: time.Now(),
+		}
+
+		slots <- struct{}{}
+		data, imgConfig, format := u.readBatchFile(file, item)
+		if item.Status != "" {
+			u.saveBatchItem(item)
+			<-slots
+			continue
+		}
+
+		// the same photo twice in one batch, or one the photographer uploaded before
+		item.Checksum = fmt.Sprintf("%x", sha256.Sum256(data))
+		exists, err := u.compressionJobRepo.ExistsPhotoChecksum(u.db, request.CreatorId, item.Checksum)
 		if err != nil {
-			log.Printf("Error compressing images: %v", err)
-			return
+			log.Println(err)
+			item.Status = enum.UploadBatchItemStatusFailed
+			item.Error = "internal error"
+			u.saveBatchItem(item)
+			<-slots
+			continue
+		}
+		if exists || seen[item.Checksum] {
+			item.Status = enum.UploadBatchItemStatusDuplicate
+			u.saveBatchItem(item)
+			<-slots
+			continue
+		}
+		seen[item.Checksum] = true
+
+		wg.Add(1)
+		go func() {
+			defer func() {
+				u.saveBatchItem(item)
+				<-slots
+				wg.Done()
+			}()
+
+			job, err := u.createPhoto(ctx, file.header, nopReadSeekCloser{bytes.NewReader(data)}, item.Checksum,
+				imgConfig, format, request)
+			if err != nil {
+				item.Status = enum.UploadBatchItemStatusFailed
+				item.Error = err.Error()
+				return
+			}
+
+			item.Status = enum.UploadBatchItemStatusAccepted
+			item.PhotoId = job.PhotoId
+			item.CompressionJobId = job.Id
+			item.JobStatus = job.Status
+		}()
+	}
+	wg.Wait()
+}
+
+// saveBatchItem stores an item as soon as its outcome is known, so GetUploadBatch shows the batch
+// filling up and a failure later in the batch does not lose the items before it.
+func (u *photoUsecase) saveBatchItem(item *entity.UploadBatchItem) {
+	if err := u.uploadBatchRepo.CreateItem(u.db, item); err != nil {
+		log.Printf("failed to save item %s of batch %s: %v", item.Id, item.BatchId, err)
+	}
+}
+
+func (u *photoUsecase) GetUploadBatch(ctx context.Context, request *model.GetUploadBatchRequest) (*model.UploadBatchResponse, error) {
+	batch, err := u.uploadBatchRepo.FindById(u.db, request.BatchId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fiber.NewError(fiber.StatusNotFound, "batch not found")
 		}
+		log.Println(err)
+		return nil, fiber.NewError(fiber.StatusInternalServerError, "internal error")
+	}
+
+	if batch.CreatorId != request.CreatorId {
+		return nil, fiber.NewError(fiber.StatusNotFound, "batch not found")
+	}
+
+	items, err := u.uploadBatchRepo.FindItemsByBatchId(u.db, batch.Id)
+	if err != nil {
+		log.Println(err)
+		return nil, fiber.NewError(fiber.StatusInternalServerError, "internal error")
+	}
+
+	return converter.UploadBatchToResponse(batch, items), nil
+}
+
+// batchFiles lists the uploaded files followed by the files inside the archive. Folders, hidden
+// files and the __MACOSX folder of archives made on a Mac are left out. The files are copied to a
+// temporary folder first, the request's files are removed once the response is sent and the batch
+// is still being processed then. Closing the files removes the folder.
+func (u *photoUsecase) batchFiles(request *model.UploadBatchRequest) ([]*batchFile, func(), error) {
+	dir, err := os.MkdirTemp("", "upload-batch-")
+	if err != nil {
+		log.Println(err)
+		return nil, nil, fiber.NewError(fiber.StatusInternalServerError, "internal error")
+	}
+	removeDir := func() {
+		if err := os.RemoveAll(dir); err != nil {
+			log.Println(err)
+		}
+	}
 
-		fileComp, err := os.Open(filePath)
+	files := make([]*batchFile, 0, len(request.Files))
+	for _, header := range request.Files {
+		name, err := copyBatchFile(dir, header)
 		if err != nil {
-			log.Printf("Error opening file: %v", err)
-			return
+			removeDir()
+			return nil, nil, fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+		}
+
+		fileHeader := &multipart.FileHeader{
+			Filename: header.Filename,
+			Header:   make(textproto.MIMEHeader),
+			Size:     header.Size,
+		}
+		if contentType := header.Header.Get("Content-Type"); contentType != "" {
+			fileHeader.Header.Set("Content-Type", contentType)
+		}
+
+		files = append(files, &batchFile{
+			header: fileHeader,
+			open: func() (io.ReadCloser, error) {
+				return os.Open(name)
+			},
+		})
+	}
+
+	if request.Archive == nil {
+		return files, removeDir, nil
+	}
+
+	name, err := copyBatchFile(dir, request.Archive)
+	if err != nil {
+		removeDir()
+		return nil, nil, fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	archiveFile, err := os.Open(name)
+	if err != nil {
+		removeDir()
+		return nil, nil, fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+	closeFiles := func() {
+		archiveFile.Close()
+		removeDir()
+	}
+
+	archive, err := zip.NewReader(archiveFile, request.Archive.Size)
+	if err != nil {
+		closeFiles()
+		return nil, nil, fiber.NewError(fiber.StatusBadRequest, "archive is not a valid ZIP file")
+	}
+
+	for _, entry := range archive.File {
+		name := path.Base(entry.Name)
+		if entry.FileInfo().IsDir() || strings.HasPrefix(name, ".") || strings.HasPrefix(entry.Name, "__MACOSX/") {
+			continue
+		}
+
+		files = append(files, &batchFile{
+			header: &multipart.FileHeader{
+				Filename: name,
+				Header:   make(textproto.MIMEHeader),
+				Size:     int64(entry.UncompressedSize64),
+			},
+			open: entry.Open,
+		})
+	}
+
+	return files, closeFiles, nil
+}
+
+// copyBatchFile copies an uploaded file into dir and returns the name of the copy.
+func copyBatchFile(dir string, header *multipart.FileHeader) (string, error) {
+	src, err := header.Open()
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+
+	dst, err := os.CreateTemp(dir, "file-")
+	if err != nil {
+		return "", err
+	}
+	defer dst.Close()
+
+	if _, err := io.Copy(dst, src); err != nil {
+		return "", err
+	}
+
+	return dst.Name(), nil
+}
+
+// readBatchFile reads a file of a batch and sets the item status when it is rejected. Sizes are
+// checked on the bytes read as well, the size an archive entry claims cannot be trusted.
+func (u *photoUsecase) readBatchFile(file *batchFile, item *entity.UploadBatchItem) ([]byte, image.Config, string) {
+	if file.header.Size > u.photoMaxSize {
+		item.Status = enum.UploadBatchItemStatusTooLarge
+		return nil, image.Config{}, ""
+	}
+
+	reader, err := file.open()
+	if err != nil {
+		item.Status = enum.UploadBatchItemStatusFailed
+		item.Error = err.Error()
+		return nil, image.Config{}, ""
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(io.LimitReader(reader, u.photoMaxSize+1))
+	if err != nil {
+		item.Status = enum.UploadBatchItemStatusFailed
+		item.Error = err.Error()
+		return nil, image.Config{}, ""
+	}
+	if int64(len(data)) > u.photoMaxSize {
+		item.Status = enum.UploadBatchItemStatusTooLarge
+		return nil, image.Config{}, ""
+	}
+
+	imgConfig, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || (format != "jpeg" && format != "png") {
+		item.Status = enum.UploadBatchItemStatusInvalidFormat
+		return nil, image.Config{}, ""
+	}
+
+	item.Size = int64(len(data))
+	file.header.Size = item.Size
+	if file.header.Header.Get("Content-Type") == "" {
+		file.header.Header.Set("Content-Type", "image/"+format)
+	}
+
+	return data, imgConfig, format
+}
+
+// uploadFileTypes maps the extensions a resumable upload accepts to their photo_details type and
+// content type. Only JPG and PNG are checked when the first chunk arrives, RAW files are stored as
+// sent and only get renditions when the image library can read them.
+var uploadFileTypes = map[string][2]string{
+	".jpg":  {"JPG", "image/jpeg"},
+	".jpeg": {"JPG", "image/jpeg"},
+	".png":  {"PNG", "image/png"},
+	".tif":  {"TIFF", "image/tiff"},
+	".tiff": {"TIFF", "image/tiff"},
+	".heic": {"HEIF", "image/heif"},
+	".heif": {"HEIF", "image/heif"},
+	".webp": {"WEBP", "image/webp"},
+	".avif": {"AVIF", "image/avif"},
+	".dng":  {"DNG", "image/x-adobe-dng"},
+	".cr2":  {"CR2", "image/x-canon-cr2"},
+	".cr3":  {"CR3", "image/x-canon-cr3"},
+	".nef":  {"NEF", "image/x-nikon-nef"},
+	".nrw":  {"NRW", "image/x-nikon-nrw"},
+	".arw":  {"ARW", "image/x-sony-arw"},
+	".sr2":  {"SR2", "image/x-sony-sr2"},
+	".srf":  {"SRF", "image/x-sony-srf"},
+	".orf":  {"ORF", "image/x-olympus-orf"},
+	".rw2":  {"RW2", "image/x-panasonic-rw2"},
+	".pef":  {"PEF", "image/x-pentax-pef"},
+	".raf":  {"RAF", "image/x-fuji-raf"},
+}
+
+func (u *photoUsecase) CreateUploadSession(ctx context.Context, request *model.CreateUploadSessionRequest) (*model.UploadSessionResponse, error) {
+	fileName := path.Base(strings.TrimSpace(request.FileName))
+	fileType, ok := uploadFileTypes[strings.ToLower(path.Ext(fileName))]
+	if !ok {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "unsupported file type")
+	}
+	if request.Size <= 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "size is required")
+	}
+	if request.Size > u.resumableMaxSize {
+		return nil, fiber.NewError(fiber.StatusRequestEntityTooLarge, fmt.Sprintf("file must be at most %d MB", u.resumableMaxSize>>20))
+	}
+
+	fileKey, storageUploadId, err := u.storageAdapter.NewMultipartUpload(ctx, fileName, fileType[1], "photo")
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	session := &entity.UploadSession{
+		Id:              ulid.Make().String(),
+		CreatorId:       request.CreatorId,
+		EventId:         request.EventId,
+		Price:           request.Price,
+		PriceStr:        request.PriceStr,
+		FileName:        fileName,
+		FileType:        fileType[0],
+		ContentType:     fileType[1],
+		FileKey:         fileKey,
+		StorageUploadId: storageUploadId,
+		Size:            request.Size,
+		ChunkSize:       u.uploadChunkSize,
+		Status:          enum.UploadSessionStatusActive,
+		ExpiresAt:       now.Add(u.uploadSessionTTL),
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+	if err := u.uploadSessionRepo.Create(u.db, session); err != nil {
+		log.Println(err)
+		if abortErr := u.storageAdapter.AbortMultipartUpload(ctx, fileKey, storageUploadId); abortErr != nil {
+			log.Println(abortErr)
 		}
-		defer fileComp.Close()
+		return nil, fiber.NewError(fiber.StatusInternalServerError, "internal error")
+	}
+
+	return converter.UploadSessionToResponse(session), nil
+}
+
+func (u *photoUsecase) GetUploadSession(ctx context.Context, request *model.UploadSessionRequest) (*model.UploadSessionResponse, error) {
+	session, err := u.findUploadSession(u.db, request.UploadId, request.CreatorId, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.UploadSessionToResponse(session), nil
+}
+
+func (u *photoUsecase) UploadChunk(ctx context.Context, request *model.UploadChunkRequest) (*model.UploadSessionResponse, error) {
+	tx, err := u.db.Beginx()
+	if err != nil {
+		log.Println(err)
+		return nil, fiber.NewError(fiber.StatusInternalServerError, "internal error")
+	}
+
+	session, err := u.uploadChunk(ctx, tx, request)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Println(err)
+		return nil, fiber.NewError(fiber.StatusInternalServerError, "internal error")
+	}
+
+	if session.UploadOffset < session.Size {
+		return converter.UploadSessionToResponse(session), nil
+	}
 
-		fileInfo, err := fileComp.Stat()
+	// the chunks are committed on their own, if registering fails an empty chunk at the end of the
+	// file tries again without sending anything twice
+	tx, err = u.db.Beginx()
+	if err != nil {
+		log.Println(err)
+		return nil, fiber.NewError(fiber.StatusInternalServerError, "internal error")
+	}
+
+	session, err = u.completeUploadSession(ctx, tx, request)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Println(err)
+		return nil, fiber.NewError(fiber.StatusInternalServerError, "internal error")
+	}
+
+	if session.Status != enum.UploadSessionStatusCompleted {
+		return nil, fiber.NewError(fiber.StatusBadGateway, "photo could not be registered, send an empty chunk to try again")
+	}
+
+	return converter.UploadSessionToResponse(session), nil
+}
+
+func (u *photoUsecase) uploadChunk(ctx context.Context, tx *sqlx.Tx, request *model.UploadChunkRequest) (*entity.UploadSession, error) {
+	session, err := u.findUploadSession(tx, request.UploadId, request.CreatorId, true)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	switch {
+	case session.Status == enum.UploadSessionStatusCompleted:
+		return nil, fiber.NewError(fiber.StatusConflict, "upload is already completed")
+	case session.Status == enum.UploadSessionStatusAborted || session.ExpiresAt.Before(now):
+		return nil, fiber.NewError(fiber.StatusGone, "upload expired or was aborted")
+	case request.Offset != session.UploadOffset:
+		return nil, fiber.NewError(fiber.StatusConflict, fmt.Sprintf("upload continues at offset %d", session.UploadOffset))
+	}
+
+	if session.UploadOffset < session.Size {
+		if err := u.storeChunk(ctx, tx, session, request.Data); err != nil {
+			return nil, err
+		}
+	} else if len(request.Data) > 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "upload already has all its bytes")
+	}
+
+	session.ExpiresAt = now.Add(u.uploadSessionTTL)
+	session.UpdatedAt = now
+	if err := u.uploadSessionRepo.Update(tx, session); err != nil {
+		log.Println(err)
+		return nil, fiber.NewError(fiber.StatusInternalServerError, "internal error")
+	}
+
+	return session, nil
+}
+
+// storeChunk sends one chunk as one storage part, so every chunk but the last must be exactly the
+// session's chunk size. A chunk cut off on the way never reaches storage and is simply sent again.
+func (u *photoUsecase) storeChunk(ctx context.Context, tx *sqlx.Tx, session *entity.UploadSession, data []byte) error {
+	expected := min(session.ChunkSize, session.Size-session.UploadOffset)
+	if int64(len(data)) != expected {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("chunk must be %d bytes", expected))
+	}
+
+	if session.UploadOffset == 0 && (session.FileType == "JPG" || session.FileType == "PNG") {
+		imgConfig, _, err := image.DecodeConfig(bytes.NewReader(data))
 		if err != nil {
-			log.Printf("Error stating file: %v", err)
-			return
+			return fiber.NewError(fiber.StatusBadRequest, "Not a valid images")
 		}
+		session.Width = imgConfig.Width
+		session.Height = imgConfig.Height
+	}
 
-		mimeHeader := make(textproto.MIMEHeader)
-		mimeHeader.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, fileInfo.Name()))
+	digest, err := uploadSessionHash(session)
+	if err != nil {
+		log.Println(err)
+		return fiber.NewError(fiber.StatusInternalServerError, "internal error")
+	}
+	digest.Write(data)
+	if session.HashState, err = digest.(encoding.BinaryMarshaler).MarshalBinary(); err != nil {
+		log.Println(err)
+		return fiber.NewError(fiber.StatusInternalServerError, "internal error")
+	}
 
-		fileHeader := &multipart.FileHeader{
-			Filename: fileInfo.Name(),
-			Header:   mimeHeader,
-			Size:     fileInfo.Size(),
+	partNumber := int(session.UploadOffset/session.ChunkSize) + 1
+	etag, err := u.storageAdapter.PutObjectPart(ctx, session.FileKey, session.StorageUploadId, partNumber,
+		bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return err
+	}
+
+	part := &entity.UploadSessionPart{
+		SessionId:  session.Id,
+		PartNumber: partNumber,
+		ETag:       etag,
+		Size:       int64(len(data)),
+		CreatedAt:  time.Now(),
+	}
+	if err := u.uploadSessionRepo.SavePart(tx, part); err != nil {
+		log.Println(err)
+		return fiber.NewError(fiber.StatusInternalServerError, "internal error")
+	}
+
+	session.UploadOffset += int64(len(data))
+
+	return nil
+}
+
+// completeUploadSession joins the parts and registers the photo. Each step is recorded on the
+// session even when a later one fails, so a retry continues with the step that failed. Only a
+// session that could not be read or saved is an error, a failed step leaves it ACTIVE.
+func (u *photoUsecase) completeUploadSession(ctx context.Context, tx *sqlx.Tx, request *model.UploadChunkRequest) (*entity.UploadSession, error) {
+	session, err := u.findUploadSession(tx, request.UploadId, request.CreatorId, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// a concurrent request for the same last chunk got here first
+	if session.Status != enum.UploadSessionStatusActive {
+		return session, nil
+	}
+
+	if err := u.registerUploadSession(ctx, tx, session); err != nil {
+		log.Printf("failed to register upload %s: %v", session.Id, err)
+	}
+
+	session.UpdatedAt = time.Now()
+	if err := u.uploadSessionRepo.Update(tx, session); err != nil {
+		log.Println(err)
+		return nil, fiber.NewError(fiber.StatusInternalServerError, "internal error")
+	}
+
+	return session, nil
+}
+
+func (u *photoUsecase) registerUploadSession(ctx context.Context, tx *sqlx.Tx, session *entity.UploadSession) error {
+	if !session.Assembled {
+		parts, err := u.uploadSessionRepo.FindPartsBySessionId(tx, session.Id)
+		if err != nil {
+			return err
+		}
+		if err := u.storageAdapter.CompleteMultipartUpload(ctx, session.FileKey, session.StorageUploadId, parts); err != nil {
+			return err
+		}
+		session.Assembled = true
+	}
+
+	digest, err := uploadSessionHash(session)
+	if err != nil {
+		return err
+	}
+	checksum := fmt.Sprintf("%x", digest.Sum(nil))
+
+	fileUrl, err := u.storageAdapter.PresignFile(ctx, session.FileKey, time.Hour)
+	if err != nil {
+		return err
+	}
+	upload := &model.MinioFileResponse{
+		URL:      fileUrl,
+		FileKey:  session.FileKey,
+		Filename: session.FileName,
+		Mimetype: session.ContentType,
+		Size:     session.Size,
+	}
+
+	request := &model.UploadPhotoRequest{
+		CreatorId: session.CreatorId,
+		EventId:   session.EventId,
+		PriceStr:  session.PriceStr,
+		Price:     session.Price,
+	}
+	newPhoto, newPhotoDetail := newPhotoFromUpload(upload, session.FileType, checksum, session.Width, session.Height, request)
+	if session.PhotoId == "" {
+		if err := u.photoAdapter.CreatePhoto(ctx, newPhoto, newPhotoDetail); err != nil {
+			return err
 		}
+		session.PhotoId = newPhoto.Id
+	}
+	newPhoto.Id = session.PhotoId
+
+	// queued outside the session's transaction, a failed insert would otherwise also lose the photo id
+	if _, err := u.queuePhotoCompression(u.db, newPhoto, upload, checksum); err != nil {
+		return err
+	}
+
+	session.Status = enum.UploadSessionStatusCompleted
 
-		uploadPath := "photo/compressed"
-		compressedPhoto, err := u.storageAdapter.UploadFile(ctx, fileHeader, fileComp, uploadPath)
+	return nil
+}
+
+func (u *photoUsecase) AbortUploadSession(ctx context.Context, request *model.UploadSessionRequest) error {
+	tx, err := u.db.Beginx()
+	if err != nil {
+		log.Println(err)
+		return fiber.NewError(fiber.StatusInternalServerError, "internal error")
+	}
+
+	if err := u.abortUploadSession(ctx, tx, request.UploadId, request.CreatorId); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Println(err)
+		return fiber.NewError(fiber.StatusInternalServerError, "internal error")
+	}
+
+	return nil
+}
+
+// AbortExpiredUploadSessions frees the storage of uploads that stopped receiving chunks.
+func (u *photoUsecase) AbortExpiredUploadSessions(ctx context.Context) {
+	sessions, err := u.uploadSessionRepo.FindExpired(u.db, time.Now(), 100)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	for _, session := range *sessions {
+		tx, err := u.db.Beginx()
 		if err != nil {
-			log.Printf("Error uploading file: %v", err)
+			log.Println(err)
 			return
 		}
 
-		compressedPhotoDetail := &entity.PhotoDetail{
-			Id:              ulid.Make().String(),
-			PhotoId:         newPhoto.Id,
-			FileName:        compressedPhoto.Filename,
-			FileKey:         compressedPhoto.FileKey,
-			Size:            compressedPhoto.Size,
-			Url:             compressedPhoto.URL,
-			YourMomentsType: enum.YourMomentTypeCompressed,
-			CreatedAt:       time.Now(),
-			UpdatedAt:       time.Now(),
+		if err := u.abortUploadSession(ctx, tx, session.Id, session.CreatorId); err != nil {
+			log.Printf("failed to abort expired upload %s: %v", session.Id, err)
+			tx.Rollback()
+			continue
 		}
 
-		if err := u.photoAdapter.UpdatePhotoDetail(ctx, compressedPhotoDetail); err != nil {
-			log.Printf("Error creating photo: %v", err)
-			return
+		if err := tx.Commit(); err != nil {
+			log.Println(err)
 		}
+	}
+}
+
+// abortUploadSession drops the parts or the assembled file. Once photo-svc has the photo the file
+// is its original and is kept.
+func (u *photoUsecase) abortUploadSession(ctx context.Context, tx *sqlx.Tx, uploadId string, creatorId string) error {
+	session, err := u.findUploadSession(tx, uploadId, creatorId, true)
+	if err != nil {
+		return err
+	}
 
-		if err := os.Remove(filePath); err != nil {
-			log.Printf("Gagal menghapus file: %v", err)
-		} else {
-			log.Printf("File sementara berhasil dihapus: %s", filePath)
+	switch session.Status {
+	case enum.UploadSessionStatusAborted:
+		return nil
+	case enum.UploadSessionStatusCompleted:
+		return fiber.NewError(fiber.StatusConflict, "upload is already completed")
+	}
+
+	if !session.Assembled {
+		if err := u.storageAdapter.AbortMultipartUpload(ctx, session.FileKey, session.StorageUploadId); err != nil {
+			log.Println(err)
 		}
+	} else if session.PhotoId == "" {
+		if _, err := u.storageAdapter.DeleteFile(ctx, session.FileKey); err != nil {
+			log.Println(err)
+		}
+	}
 
-		u.aiAdapter.ProcessPhoto(ctx, newPhoto.Id, compressedPhoto.URL)
-	}()
+	session.Status = enum.UploadSessionStatusAborted
+	session.UpdatedAt = time.Now()
+	if err := u.uploadSessionRepo.Update(tx, session); err != nil {
+		log.Println(err)
+		return fiber.NewError(fiber.StatusInternalServerError, "internal error")
+	}
 
 	return nil
 }
 
+// findUploadSession hides sessions of other photographers behind the same 404 as missing ones.
+func (u *photoUsecase) findUploadSession(tx repository.Querier, uploadId string, creatorId string, forUpdate bool) (*entity.UploadSession, error) {
+	find := u.uploadSessionRepo.FindById
+	if forUpdate {
+		find = u.uploadSessionRepo.FindByIdForUpdate
+	}
+
+	session, err := find(tx, uploadId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fiber.NewError(fiber.StatusNotFound, "upload not found")
+		}
+		log.Println(err)
+		return nil, fiber.NewError(fiber.StatusInternalServerError, "internal error")
+	}
+
+	if session.CreatorId != creatorId {
+		return nil, fiber.NewError(fiber.StatusNotFound, "upload not found")
+	}
+
+	return session, nil
+}
+
+// uploadSessionHash restores the sha256 over the bytes the session received so far.
+func uploadSessionHash(session *entity.UploadSession) (hash.Hash, error) {
+	h := sha256.New()
+	if len(session.HashState) > 0 {
+		if err := h.(encoding.BinaryUnmarshaler).UnmarshalBinary(session.HashState); err != nil {
+			return nil, err
+		}
+	}
+
+	return h, nil
+}
+
 // func (u *photoUsecase) UpdateProcessedPhoto(ctx context.Context, req *model.RequestUpdateProcessedPhoto) (error, error) {
 
 // 	tx, err := u.db.Begin()
@@ -0,0 +1,236 @@
+package usecase
+
+import (
+	"be-yourmoments/upload-svc/internal/adapter"
+	"be-yourmoments/upload-svc/internal/entity"
+	"be-yourmoments/upload-svc/internal/model"
+	"be-yourmoments/upload-svc/internal/repository"
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"image"
+	"io"
+	"log"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	watermarkTextMaxLength = 100
+	watermarkLogoMaxSize   = 2 << 20
+)
+
+type WatermarkUsecase interface {
+	GetWatermark(ctx context.Context, creatorId string) (*model.WatermarkResponse, error)
+	UpdateWatermark(ctx context.Context, request *model.UpdateWatermarkRequest) (*model.WatermarkResponse, error)
+	// DeleteWatermark goes back to the platform watermark.
+	DeleteWatermark(ctx context.Context, creatorId string) error
+}
+
+type watermarkUsecase struct {
+	db                *sqlx.DB
+	watermarkRepo     repository.WatermarkRepository
+	storageAdapter    adapter.StorageAdapter
+	platformWatermark *model.Watermark
+}
+
+func NewWatermarkUsecase(db *sqlx.DB, watermarkRepo repository.WatermarkRepository,
+	storageAdapter adapter.StorageAdapter, platformWatermark *model.Watermark) WatermarkUsecase {
+	return &watermarkUsecase{
+		db:                db,
+		watermarkRepo:     watermarkRepo,
+		storageAdapter:    storageAdapter,
+		platformWatermark: platformWatermark,
+	}
+}
+
+func (u *watermarkUsecase) GetWatermark(ctx context.Context, creatorId string) (*model.WatermarkResponse, error) {
+	watermark, err := u.watermarkRepo.FindByCreatorId(u.db, creatorId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return &model.WatermarkResponse{
+				Text:    u.platformWatermark.Text,
+				Opacity: u.platformWatermark.Opacity,
+			}, nil
+		}
+		log.Println(err)
+		return nil, fiber.NewError(fiber.StatusInternalServerError, "internal error")
+	}
+
+	return u.toResponse(ctx, watermark)
+}
+
+// UpdateWatermark only affects photos rendered afterwards, photos already processed keep the
+// watermark they were rendered with.
+func (u *watermarkUsecase) UpdateWatermark(ctx context.Context, request *model.UpdateWatermarkRequest) (*model.WatermarkResponse, error) {
+	if utf8.RuneCountInString(request.Text) > watermarkTextMaxLength {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "watermark text is too long")
+	}
+	if request.Opacity <= 0 || request.Opacity > 1 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "opacity must be above 0 and at most 1")
+	}
+
+	now := time.Now()
+	watermark, err := u.watermarkRepo.FindByCreatorId(u.db, request.CreatorId)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			log.Println(err)
+			return nil, fiber.NewError(fiber.StatusInternalServerError, "internal error")
+		}
+		watermark = &entity.CreatorWatermark{
+			CreatorId: request.CreatorId,
+			CreatedAt: now,
+		}
+	}
+
+	previousLogoKey := watermark.LogoFileKey
+	if request.RemoveLogo {
+		watermark.LogoFileKey = ""
+	}
+
+	if request.Logo != nil {
+		logoKey, err := u.uploadLogo(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		watermark.LogoFileKey = logoKey
+	}
+
+	if request.Text == "" && watermark.LogoFileKey == "" {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "watermark needs a text or a logo")
+	}
+
+	watermark.Text = request.Text
+	watermark.Opacity = request.Opacity
+	watermark.UpdatedAt = now
+
+	if err := u.watermarkRepo.Upsert(u.db, watermark); err != nil {
+		log.Println(err)
+		if watermark.LogoFileKey != previousLogoKey {
+			u.deleteLogo(ctx, watermark.LogoFileKey)
+		}
+		return nil, fiber.NewError(fiber.StatusInternalServerError, "internal error")
+	}
+
+	if previousLogoKey != watermark.LogoFileKey {
+		u.deleteLogo(ctx, previousLogoKey)
+	}
+
+	return u.toResponse(ctx, watermark)
+}
+
+func (u *watermarkUsecase) DeleteWatermark(ctx context.Context, creatorId string) error {
+	watermark, err := u.watermarkRepo.FindByCreatorId(u.db, creatorId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		log.Println(err)
+		return fiber.NewError(fiber.StatusInternalServerError, "internal error")
+	}
+
+	if err := u.watermarkRepo.Delete(u.db, creatorId); err != nil {
+		log.Println(err)
+		return fiber.NewError(fiber.StatusInternalServerError, "internal error")
+	}
+
+	u.deleteLogo(ctx, watermark.LogoFileKey)
+
+	return nil
+}
+
+// uploadLogo stores a PNG or JPG logo, PNG keeps its transparency on the photo.
+func (u *watermarkUsecase) uploadLogo(ctx context.Context, request *model.UpdateWatermarkRequest) (string, error) {
+	if request.Logo.Size > watermarkLogoMaxSize {
+		return "", fiber.NewError(fiber.StatusBadRequest, "logo must be at most 2 MB")
+	}
+
+	logoFile, err := request.Logo.Open()
+	if err != nil {
+		return "", fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+	data, err := io.ReadAll(logoFile)
+	logoFile.Close()
+	if err != nil {
+		log.Print("failed to read file: ", err)
+		return "", fiber.NewError(fiber.StatusInternalServerError, "internal error")
+	}
+
+	if _, format, err := image.DecodeConfig(bytes.NewReader(data)); err != nil || (format != "png" && format != "jpeg") {
+		return "", fiber.NewError(fiber.StatusBadRequest, "logo must be a PNG or JPG image")
+	}
+
+	upload, err := u.storageAdapter.UploadFile(ctx, request.Logo, nopReadSeekCloser{bytes.NewReader(data)}, "watermark/logo")
+	if err != nil {
+		return "", err
+	}
+
+	return upload.FileKey, nil
+}
+
+func (u *watermarkUsecase) deleteLogo(ctx context.Context, fileKey string) {
+	if fileKey == "" {
+		return
+	}
+	if _, err := u.storageAdapter.DeleteFile(ctx, fileKey); err != nil {
+		log.Println(err)
+	}
+}
+
+func (u *watermarkUsecase) toResponse(ctx context.Context, watermark *entity.CreatorWatermark) (*model.WatermarkResponse, error) {
+	response := &model.WatermarkResponse{
+		Custom:    true,
+		Text:      watermark.Text,
+		Opacity:   watermark.Opacity,
+		UpdatedAt: &watermark.UpdatedAt,
+	}
+
+	if watermark.LogoFileKey != "" {
+		logoUrl, err := u.storageAdapter.PresignFile(ctx, watermark.LogoFileKey, time.Hour)
+		if err != nil {
+			return nil, err
+		}
+		response.LogoUrl = logoUrl
+	}
+
+	return response, nil
+}
+
+// loadWatermark gives the creator's own watermark or the platform one. The logo is read from
+// storage for every job so a changed logo applies to the next upload.
+func loadWatermark(ctx context.Context, db *sqlx.DB, watermarkRepo repository.WatermarkRepository,
+	storageAdapter adapter.StorageAdapter, platformWatermark *model.Watermark, creatorId string) (*model.Watermark, error) {
+	if creatorId == "" {
+		return platformWatermark, nil
+	}
+
+	watermark, err := watermarkRepo.FindByCreatorId(db, creatorId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return platformWatermark, nil
+		}
+		return nil, err
+	}
+
+	result := &model.Watermark{
+		Text:    watermark.Text,
+		Opacity: watermark.Opacity,
+	}
+	if watermark.LogoFileKey != "" {
+		logoFile, err := storageAdapter.OpenFile(ctx, watermark.LogoFileKey)
+		if err != nil {
+			return nil, err
+		}
+		defer logoFile.Close()
+
+		if result.Logo, err = io.ReadAll(logoFile); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,204 @@
+package usecase
+
+import (
+	"be-yourmoments/upload-svc/internal/entity"
+	"be-yourmoments/upload-svc/internal/enum"
+	mockadapter "be-yourmoments/upload-svc/internal/mocks/adapter"
+	mockrepository "be-yourmoments/upload-svc/internal/mocks/repository"
+	"be-yourmoments/upload-svc/internal/model"
+	"be-yourmoments/upload-svc/internal/repository"
+	"be-yourmoments/upload-svc/internal/usecase"
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+)
+
+func newMockDB(t *testing.T) (*sqlx.DB, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	return sqlx.NewDb(db, "postgres"), mock
+}
+
+// memFile is a stored file served from memory.
+type memFile struct {
+	*bytes.Reader
+}
+
+func (memFile) Close() error {
+	return nil
+}
+
+func TestProcessNextJob(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	db, _ := newMockDB(t)
+
+	mockCompressionJobRepo := mockrepository.NewMockCompressionJobRepository(ctrl)
+	mockWatermarkRepo := mockrepository.NewMockWatermarkRepository(ctrl)
+	mockAiAdapter := mockadapter.NewMockAiAdapter(ctrl)
+	mockPhotoAdapter := mockadapter.NewMockPhotoAdapter(ctrl)
+	mockStorageAdapter := mockadapter.NewMockStorageAdapter(ctrl)
+	mockCompressAdapter := mockadapter.NewMockCompressAdapter(ctrl)
+
+	compressionUC := usecase.NewCompressionUsecase(db, mockCompressionJobRepo, mockAiAdapter, mockPhotoAdapter,
+		mockStorageAdapter, mockCompressAdapter, mockWatermarkRepo, nil, nil, time.Minute)
+
+	// the job was already rendered by an earlier attempt, so only handing it to the ai service is left
+	newJob := func(attempts int) *entity.CompressionJob {
+		return &entity.CompressionJob{
+			Id:            "job-1",
+			Type:          enum.CompressionJobTypePhoto,
+			Status:        enum.CompressionJobStatusProcessing,
+			PhotoId:       "photo-1",
+			FileKey:       "photo/original.jpg",
+			ResultFileKey: "photo/compressed/original_compressed.jpg",
+			Attempts:      attempts,
+			MaxAttempts:   5,
+		}
+	}
+	expectFinish := func() *entity.CompressionJob {
+		finished := new(entity.CompressionJob)
+		mockCompressionJobRepo.EXPECT().Finish(db, gomock.Any()).
+			DoAndReturn(func(tx repository.Querier, job *entity.CompressionJob) error {
+				*finished = *job
+				return nil
+			})
+		return finished
+	}
+	expectAiFailure := func() {
+		mockStorageAdapter.EXPECT().PresignFile(gomock.Any(), "photo/compressed/original_compressed.jpg", time.Hour).
+			Return("http://minio/compressed", nil)
+		mockAiAdapter.EXPECT().ProcessPhoto(gomock.Any(), "photo-1", "http://minio/compressed").Return(errors.New("ai unavailable"))
+	}
+
+	t.Run("No job is due", func(t *testing.T) {
+		mockCompressionJobRepo.EXPECT().Claim(db, gomock.Any()).Return(nil, sql.ErrNoRows)
+
+		processed, err := compressionUC.ProcessNextJob(ctx)
+		assert.NoError(t, err)
+		assert.False(t, processed)
+	})
+
+	t.Run("Successful job is done", func(t *testing.T) {
+		job := newJob(1)
+		job.LastError = "ai unavailable"
+		mockCompressionJobRepo.EXPECT().Claim(db, gomock.Any()).Return(job, nil)
+		mockStorageAdapter.EXPECT().PresignFile(gomock.Any(), job.ResultFileKey, time.Hour).Return("http://minio/compressed", nil)
+		mockAiAdapter.EXPECT().ProcessPhoto(gomock.Any(), "photo-1", "http://minio/compressed").Return(nil)
+		finished := expectFinish()
+
+		processed, err := compressionUC.ProcessNextJob(ctx)
+		assert.NoError(t, err)
+		assert.True(t, processed)
+		assert.Equal(t, enum.CompressionJobStatusDone, finished.Status)
+		assert.Empty(t, finished.LastError)
+	})
+
+	t.Run("Failed attempt is retried with a growing backoff", func(t *testing.T) {
+		backoffs := map[int]time.Duration{
+			1: 30 * time.Second,
+			2: time.Minute,
+			4: 4 * time.Minute,
+		}
+		for attempts, backoff := range backoffs {
+			mockCompressionJobRepo.EXPECT().Claim(db, gomock.Any()).Return(newJob(attempts), nil)
+			expectAiFailure()
+			finished := expectFinish()
+
+			processed, err := compressionUC.ProcessNextJob(ctx)
+			assert.NoError(t, err)
+			assert.True(t, processed)
+			assert.Equal(t, enum.CompressionJobStatusPending, finished.Status)
+			assert.Contains(t, finished.LastError, "ai unavailable")
+			assert.WithinDuration(t, time.Now().Add(backoff), finished.RunAt, 5*time.Second, "attempt %d", attempts)
+		}
+	})
+
+	t.Run("Backoff is capped at an hour", func(t *testing.T) {
+		job := newJob(10)
+		job.MaxAttempts = 20
+		mockCompressionJobRepo.EXPECT().Claim(db, gomock.Any()).Return(job, nil)
+		expectAiFailure()
+		finished := expectFinish()
+
+		_, err := compressionUC.ProcessNextJob(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, enum.CompressionJobStatusPending, finished.Status)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), finished.RunAt, 5*time.Second)
+	})
+
+	t.Run("Last attempt failing leaves the job dead", func(t *testing.T) {
+		mockCompressionJobRepo.EXPECT().Claim(db, gomock.Any()).Return(newJob(5), nil)
+		expectAiFailure()
+		finished := expectFinish()
+
+		processed, err := compressionUC.ProcessNextJob(ctx)
+		assert.NoError(t, err)
+		assert.True(t, processed)
+		assert.Equal(t, enum.CompressionJobStatusDead, finished.Status)
+		assert.Contains(t, finished.LastError, "ai unavailable")
+	})
+
+	t.Run("Job claimed back after its last attempt is dead without running", func(t *testing.T) {
+		mockCompressionJobRepo.EXPECT().Claim(db, gomock.Any()).Return(newJob(6), nil)
+		finished := expectFinish()
+
+		processed, err := compressionUC.ProcessNextJob(ctx)
+		assert.NoError(t, err)
+		assert.True(t, processed)
+		assert.Equal(t, enum.CompressionJobStatusDead, finished.Status)
+		assert.NotEmpty(t, finished.LastError)
+	})
+
+	t.Run("Failed registration only removes renditions photo-svc does not have", func(t *testing.T) {
+		job := newJob(1)
+		job.ResultFileKey = ""
+		mockCompressionJobRepo.EXPECT().Claim(db, gomock.Any()).Return(job, nil)
+		mockStorageAdapter.EXPECT().OpenFile(gomock.Any(), "photo/original.jpg").Return(memFile{bytes.NewReader([]byte("original"))}, nil)
+		mockCompressAdapter.EXPECT().RenderImage(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*model.ImageRendition{
+			{Type: enum.YourMomentTypeThumbnail, Format: "JPG"},
+			{Type: enum.YourMomentTypePreview, Format: "JPG"},
+			{Type: enum.YourMomentTypeCompressed, Format: "JPG"},
+		}, nil)
+		for _, fileKey := range []string{"photo/thumbnail.jpg", "photo/preview.jpg", "photo/compressed.jpg"} {
+			mockStorageAdapter.EXPECT().UploadFile(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&model.MinioFileResponse{FileKey: fileKey}, nil)
+		}
+		// the thumbnail is registered, the preview is not
+		mockPhotoAdapter.EXPECT().UpdatePhotoDetail(gomock.Any(), gomock.Any()).Return(nil)
+		mockPhotoAdapter.EXPECT().UpdatePhotoDetail(gomock.Any(), gomock.Any()).Return(errors.New("photo-svc unavailable"))
+		mockStorageAdapter.EXPECT().DeleteFile(gomock.Any(), "photo/preview.jpg").Return(true, nil)
+		mockStorageAdapter.EXPECT().DeleteFile(gomock.Any(), "photo/compressed.jpg").Return(true, nil)
+		finished := expectFinish()
+
+		processed, err := compressionUC.ProcessNextJob(ctx)
+		assert.NoError(t, err)
+		assert.True(t, processed)
+		assert.Equal(t, enum.CompressionJobStatusPending, finished.Status)
+		assert.Contains(t, finished.LastError, "photo-svc unavailable")
+	})
+
+	t.Run("Queue can not be updated", func(t *testing.T) {
+		mockCompressionJobRepo.EXPECT().Claim(db, gomock.Any()).Return(newJob(1), nil)
+		expectAiFailure()
+		mockCompressionJobRepo.EXPECT().Finish(db, gomock.Any()).Return(errors.New("connection reset"))
+
+		processed, err := compressionUC.ProcessNextJob(ctx)
+		assert.Error(t, err)
+		assert.True(t, processed)
+	})
+}
@@ -0,0 +1,452 @@
+package usecase
+
+import (
+	"be-yourmoments/upload-svc/internal/config"
+	"be-yourmoments/upload-svc/internal/entity"
+	"be-yourmoments/upload-svc/internal/enum"
+	mockadapter "be-yourmoments/upload-svc/internal/mocks/adapter"
+	mockrepository "be-yourmoments/upload-svc/internal/mocks/repository"
+	"be-yourmoments/upload-svc/internal/model"
+	"be-yourmoments/upload-svc/internal/repository"
+	"be-yourmoments/upload-svc/internal/usecase"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"mime/multipart"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func newPng(t *testing.T, c color.Color) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	img.Set(0, 0, c)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// newFileHeaders sends the files through a multipart form, the only way to get readable file headers.
+func newFileHeaders(t *testing.T, files map[string][]byte, order []string) []*multipart.FileHeader {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	for _, name := range order {
+		part, err := writer.CreateFormFile("files", name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		part.Write(files[name])
+	}
+	writer.Close()
+
+	form, err := multipart.NewReader(&body, writer.Boundary()).ReadForm(32 << 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { form.RemoveAll() })
+
+	return form.File["files"]
+}
+
+func checksumOf(data []byte) string {
+	return fmt.Sprintf("%x", sha256.Sum256(data))
+}
+
+func TestUploadBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	db, _ := newMockDB(t)
+
+	mockCompressionJobRepo := mockrepository.NewMockCompressionJobRepository(ctrl)
+	mockUploadBatchRepo := mockrepository.NewMockUploadBatchRepository(ctrl)
+	mockUploadSessionRepo := mockrepository.NewMockUploadSessionRepository(ctrl)
+	mockPhotoAdapter := mockadapter.NewMockPhotoAdapter(ctrl)
+	mockStorageAdapter := mockadapter.NewMockStorageAdapter(ctrl)
+
+	photoUC := usecase.NewPhotoUsecase(db, mockCompressionJobRepo, mockUploadBatchRepo, mockUploadSessionRepo,
+		mockPhotoAdapter, mockStorageAdapter, config.ServerConfig{PhotoMaxSize: 1 << 20, BatchMaxFiles: 10})
+
+	red := newPng(t, color.RGBA{R: 255, A: 255})
+	blue := newPng(t, color.RGBA{B: 255, A: 255})
+	files := map[string][]byte{
+		"red.png":       red,
+		"red-copy.png":  red,
+		"blue.png":      blue,
+		"notes.txt":     []byte("not an image"),
+		"too-large.png": bytes.Repeat([]byte{1}, 2<<20),
+	}
+	request := &model.UploadBatchRequest{
+		UploadPhotoRequest: model.UploadPhotoRequest{CreatorId: "creator-1", EventId: "event-1"},
+		Files:              newFileHeaders(t, files, []string{"red.png", "red-copy.png", "blue.png", "notes.txt", "too-large.png"}),
+	}
+
+	t.Run("Duplicates in the batch and of earlier uploads are not stored again", func(t *testing.T) {
+		mockUploadBatchRepo.EXPECT().Create(db, gomock.Any()).Return(nil)
+		mockCompressionJobRepo.EXPECT().ExistsPhotoChecksum(db, "creator-1", checksumOf(red)).Return(false, nil).Times(2)
+		// blue was uploaded by the photographer before
+		mockCompressionJobRepo.EXPECT().ExistsPhotoChecksum(db, "creator-1", checksumOf(blue)).Return(true, nil)
+
+		// the upload waits until the response was returned, the batch is processed after it
+		responded := make(chan struct{})
+		mockStorageAdapter.EXPECT().UploadFile(gomock.Any(), gomock.Any(), gomock.Any(), "photo").
+			DoAndReturn(func(ctx context.Context, file *multipart.FileHeader, uploadFile multipart.File, path string) (*model.MinioFileResponse, error) {
+				<-responded
+				data, err := io.ReadAll(uploadFile)
+				assert.NoError(t, err)
+				assert.Equal(t, red, data)
+				return &model.MinioFileResponse{FileKey: "photo/red.png", Filename: "red.png"}, nil
+			})
+		mockPhotoAdapter.EXPECT().CreatePhoto(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, photo *entity.Photo, detail *entity.PhotoDetail) error {
+				assert.Equal(t, checksumOf(red), detail.Checksum)
+				return nil
+			})
+		mockCompressionJobRepo.EXPECT().Create(db, gomock.Any()).Return(nil)
+
+		var mu sync.Mutex
+		var processed sync.WaitGroup
+		processed.Add(len(files))
+		saved := make(map[string]*entity.UploadBatchItem)
+		mockUploadBatchRepo.EXPECT().CreateItem(db, gomock.Any()).
+			DoAndReturn(func(tx repository.Querier, item *entity.UploadBatchItem) error {
+				mu.Lock()
+				defer mu.Unlock()
+				saved[item.FileName] = item
+				processed.Done()
+				return nil
+			}).Times(len(files))
+
+		resp, err := photoUC.UploadBatch(ctx, request)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, resp.BatchId)
+		assert.Equal(t, len(files), resp.TotalFiles)
+		assert.Empty(t, resp.Items)
+
+		close(responded)
+		processed.Wait()
+
+		assert.Equal(t, enum.UploadBatchItemStatusAccepted, saved["red.png"].Status)
+		assert.NotEmpty(t, saved["red.png"].PhotoId)
+		assert.Equal(t, enum.UploadBatchItemStatusDuplicate, saved["red-copy.png"].Status)
+		assert.Equal(t, enum.UploadBatchItemStatusDuplicate, saved["blue.png"].Status)
+		assert.Equal(t, enum.UploadBatchItemStatusInvalidFormat, saved["notes.txt"].Status)
+		assert.Equal(t, enum.UploadBatchItemStatusTooLarge, saved["too-large.png"].Status)
+	})
+
+	t.Run("Batch has too many files", func(t *testing.T) {
+		headers := make(map[string][]byte)
+		var order []string
+		for i := 0; i < 11; i++ {
+			name := fmt.Sprintf("photo-%d.png", i)
+			headers[name] = red
+			order = append(order, name)
+		}
+
+		resp, err := photoUC.UploadBatch(ctx, &model.UploadBatchRequest{
+			UploadPhotoRequest: model.UploadPhotoRequest{CreatorId: "creator-1"},
+			Files:              newFileHeaders(t, headers, order),
+		})
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.Equal(t, fiber.StatusBadRequest, err.(*fiber.Error).Code)
+	})
+}
+
+func TestUploadPhoto(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	db, _ := newMockDB(t)
+
+	mockCompressionJobRepo := mockrepository.NewMockCompressionJobRepository(ctrl)
+	mockPhotoAdapter := mockadapter.NewMockPhotoAdapter(ctrl)
+	mockStorageAdapter := mockadapter.NewMockStorageAdapter(ctrl)
+
+	photoUC := usecase.NewPhotoUsecase(db, mockCompressionJobRepo, mockrepository.NewMockUploadBatchRepository(ctrl),
+		mockrepository.NewMockUploadSessionRepository(ctrl), mockPhotoAdapter, mockStorageAdapter,
+		config.ServerConfig{PhotoMaxSize: 1 << 20})
+
+	red := newPng(t, color.RGBA{R: 255, A: 255})
+	request := &model.UploadPhotoRequest{CreatorId: "creator-1", EventId: "event-1"}
+
+	t.Run("Photo larger than the limit is not read", func(t *testing.T) {
+		file := newFileHeaders(t, map[string][]byte{"large.png": bytes.Repeat([]byte{1}, 2<<20)}, []string{"large.png"})[0]
+
+		err := photoUC.UploadPhoto(ctx, file, request)
+		assert.Error(t, err)
+		assert.Equal(t, fiber.StatusRequestEntityTooLarge, err.(*fiber.Error).Code)
+	})
+
+	t.Run("Stored photo gets the checksum of the whole file", func(t *testing.T) {
+		file := newFileHeaders(t, map[string][]byte{"red.png": red}, []string{"red.png"})[0]
+
+		mockStorageAdapter.EXPECT().UploadFile(ctx, file, gomock.Any(), "photo").
+			DoAndReturn(func(ctx context.Context, file *multipart.FileHeader, uploadFile multipart.File, path string) (*model.MinioFileResponse, error) {
+				data, err := io.ReadAll(uploadFile)
+				assert.NoError(t, err)
+				assert.Equal(t, red, data, "the upload starts at the beginning of the file")
+				return &model.MinioFileResponse{FileKey: "photo/red.png", Filename: "red.png"}, nil
+			})
+		mockPhotoAdapter.EXPECT().CreatePhoto(ctx, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, photo *entity.Photo, detail *entity.PhotoDetail) error {
+				assert.Equal(t, checksumOf(red), detail.Checksum)
+				return nil
+			})
+		mockCompressionJobRepo.EXPECT().Create(db, gomock.Any()).Return(nil)
+
+		err := photoUC.UploadPhoto(ctx, file, request)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Photo is taken back when its compression can not be queued", func(t *testing.T) {
+		file := newFileHeaders(t, map[string][]byte{"red.png": red}, []string{"red.png"})[0]
+
+		mockStorageAdapter.EXPECT().UploadFile(ctx, file, gomock.Any(), "photo").
+			Return(&model.MinioFileResponse{FileKey: "photo/red.png", Filename: "red.png"}, nil)
+		var photoId string
+		mockPhotoAdapter.EXPECT().CreatePhoto(ctx, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, photo *entity.Photo, detail *entity.PhotoDetail) error {
+				photoId = photo.Id
+				return nil
+			})
+		mockCompressionJobRepo.EXPECT().Create(db, gomock.Any()).Return(errors.New("db down"))
+		mockPhotoAdapter.EXPECT().DeletePhoto(ctx, gomock.Any(), "creator-1").
+			DoAndReturn(func(ctx context.Context, id string, creatorId string) error {
+				assert.Equal(t, photoId, id)
+				return nil
+			})
+
+		err := photoUC.UploadPhoto(ctx, file, request)
+		assert.Error(t, err)
+	})
+}
+
+// hashStateOf is the stored sha256 state of a session that received data so far.
+func hashStateOf(t *testing.T, data []byte) []byte {
+	digest := sha256.New()
+	digest.Write(data)
+	state, err := digest.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return state
+}
+
+func TestUploadChunk(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	db, mock := newMockDB(t)
+
+	mockCompressionJobRepo := mockrepository.NewMockCompressionJobRepository(ctrl)
+	mockUploadBatchRepo := mockrepository.NewMockUploadBatchRepository(ctrl)
+	mockUploadSessionRepo := mockrepository.NewMockUploadSessionRepository(ctrl)
+	mockPhotoAdapter := mockadapter.NewMockPhotoAdapter(ctrl)
+	mockStorageAdapter := mockadapter.NewMockStorageAdapter(ctrl)
+
+	photoUC := usecase.NewPhotoUsecase(db, mockCompressionJobRepo, mockUploadBatchRepo, mockUploadSessionRepo,
+		mockPhotoAdapter, mockStorageAdapter, config.ServerConfig{UploadSessionTTL: time.Hour})
+
+	// a RAW file of two full chunks of 4 bytes and a last chunk of 2 bytes
+	data := []byte("0123456789")
+	const chunkSize = 4
+
+	// stored is the session row, every read returns it and every update changes it
+	var stored entity.UploadSession
+	newSession := func(offset int64) {
+		stored = entity.UploadSession{
+			Id:              "upload-1",
+			CreatorId:       "creator-1",
+			FileName:        "photo.dng",
+			FileType:        "DNG",
+			ContentType:     "image/x-adobe-dng",
+			FileKey:         "photo/photo.dng",
+			StorageUploadId: "storage-upload-1",
+			Size:            int64(len(data)),
+			UploadOffset:    offset,
+			ChunkSize:       chunkSize,
+			HashState:       hashStateOf(t, data[:offset]),
+			Status:          enum.UploadSessionStatusActive,
+			ExpiresAt:       time.Now().Add(time.Hour),
+		}
+	}
+	expectFind := func(times int) {
+		mockUploadSessionRepo.EXPECT().FindByIdForUpdate(gomock.Any(), "upload-1").
+			DoAndReturn(func(tx repository.Querier, id string) (*entity.UploadSession, error) {
+				session := stored
+				return &session, nil
+			}).Times(times)
+	}
+	expectUpdate := func(times int) {
+		mockUploadSessionRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(tx repository.Querier, session *entity.UploadSession) error {
+				stored = *session
+				return nil
+			}).Times(times)
+	}
+	expectPart := func(partNumber int, chunk []byte) {
+		mockStorageAdapter.EXPECT().PutObjectPart(gomock.Any(), "photo/photo.dng", "storage-upload-1", partNumber, gomock.Any(), int64(len(chunk))).
+			DoAndReturn(func(ctx context.Context, fileKey, uploadId string, partNumber int, reader io.Reader, size int64) (string, error) {
+				sent, _ := io.ReadAll(reader)
+				assert.Equal(t, chunk, sent)
+				return fmt.Sprintf("etag-%d", partNumber), nil
+			})
+		mockUploadSessionRepo.EXPECT().SavePart(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(tx repository.Querier, part *entity.UploadSessionPart) error {
+				assert.Equal(t, partNumber, part.PartNumber)
+				assert.Equal(t, fmt.Sprintf("etag-%d", partNumber), part.ETag)
+				return nil
+			})
+	}
+
+	t.Run("Chunk at the session offset is stored as the matching part", func(t *testing.T) {
+		newSession(chunkSize)
+		mock.ExpectBegin()
+		expectFind(1)
+		expectPart(2, data[4:8])
+		expectUpdate(1)
+		mock.ExpectCommit()
+
+		resp, err := photoUC.UploadChunk(ctx, &model.UploadChunkRequest{UploadId: "upload-1", CreatorId: "creator-1", Offset: 4, Data: data[4:8]})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(8), resp.Offset)
+		assert.Equal(t, enum.UploadSessionStatusActive, resp.Status)
+		assert.Equal(t, hashStateOf(t, data[:8]), stored.HashState)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Chunk at the wrong offset", func(t *testing.T) {
+		newSession(chunkSize)
+		mock.ExpectBegin()
+		expectFind(1)
+		mock.ExpectRollback()
+
+		resp, err := photoUC.UploadChunk(ctx, &model.UploadChunkRequest{UploadId: "upload-1", CreatorId: "creator-1", Offset: 0, Data: data[:4]})
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.Equal(t, fiber.StatusConflict, err.(*fiber.Error).Code)
+		assert.Contains(t, err.Error(), "offset 4")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Chunk that is not a full part", func(t *testing.T) {
+		newSession(0)
+		mock.ExpectBegin()
+		expectFind(1)
+		mock.ExpectRollback()
+
+		_, err := photoUC.UploadChunk(ctx, &model.UploadChunkRequest{UploadId: "upload-1", CreatorId: "creator-1", Offset: 0, Data: data[:3]})
+		assert.Error(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, err.(*fiber.Error).Code)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Last chunk assembles the parts and registers the photo", func(t *testing.T) {
+		newSession(8)
+		mock.ExpectBegin()
+		expectFind(2)
+		expectPart(3, data[8:])
+		expectUpdate(2)
+		mock.ExpectCommit()
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		parts := &[]*entity.UploadSessionPart{{PartNumber: 1}, {PartNumber: 2}, {PartNumber: 3}}
+		mockUploadSessionRepo.EXPECT().FindPartsBySessionId(gomock.Any(), "upload-1").Return(parts, nil)
+		mockStorageAdapter.EXPECT().CompleteMultipartUpload(gomock.Any(), "photo/photo.dng", "storage-upload-1", parts).Return(nil)
+		mockStorageAdapter.EXPECT().PresignFile(gomock.Any(), "photo/photo.dng", time.Hour).Return("http://minio/photo.dng", nil)
+		mockPhotoAdapter.EXPECT().CreatePhoto(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, photo *entity.Photo, detail *entity.PhotoDetail) error {
+				// the checksum covers every chunk, including the ones sent in earlier requests
+				assert.Equal(t, checksumOf(data), detail.Checksum)
+				return nil
+			})
+		mockCompressionJobRepo.EXPECT().Create(db, gomock.Any()).Return(nil)
+
+		resp, err := photoUC.UploadChunk(ctx, &model.UploadChunkRequest{UploadId: "upload-1", CreatorId: "creator-1", Offset: 8, Data: data[8:]})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(10), resp.Offset)
+		assert.Equal(t, enum.UploadSessionStatusCompleted, resp.Status)
+		assert.NotEmpty(t, resp.PhotoId)
+		assert.True(t, stored.Assembled)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Empty chunk retries registering without assembling or creating the photo again", func(t *testing.T) {
+		newSession(int64(len(data)))
+		stored.Assembled = true
+		stored.PhotoId = "photo-1"
+		mock.ExpectBegin()
+		expectFind(2)
+		expectUpdate(2)
+		mock.ExpectCommit()
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		mockStorageAdapter.EXPECT().PresignFile(gomock.Any(), "photo/photo.dng", time.Hour).Return("http://minio/photo.dng", nil)
+		mockCompressionJobRepo.EXPECT().Create(db, gomock.Any()).
+			DoAndReturn(func(tx repository.Querier, job *entity.CompressionJob) error {
+				assert.Equal(t, "photo-1", job.PhotoId)
+				assert.Equal(t, checksumOf(data), job.Checksum)
+				return nil
+			})
+
+		resp, err := photoUC.UploadChunk(ctx, &model.UploadChunkRequest{UploadId: "upload-1", CreatorId: "creator-1", Offset: 10})
+		assert.NoError(t, err)
+		assert.Equal(t, enum.UploadSessionStatusCompleted, resp.Status)
+		assert.Equal(t, "photo-1", resp.PhotoId)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failed registration keeps the session open", func(t *testing.T) {
+		newSession(int64(len(data)))
+		stored.Assembled = true
+		mock.ExpectBegin()
+		expectFind(2)
+		expectUpdate(2)
+		mock.ExpectCommit()
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		mockStorageAdapter.EXPECT().PresignFile(gomock.Any(), "photo/photo.dng", time.Hour).Return("http://minio/photo.dng", nil)
+		mockPhotoAdapter.EXPECT().CreatePhoto(gomock.Any(), gomock.Any(), gomock.Any()).Return(fiber.ErrServiceUnavailable)
+
+		resp, err := photoUC.UploadChunk(ctx, &model.UploadChunkRequest{UploadId: "upload-1", CreatorId: "creator-1", Offset: 10})
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.Equal(t, fiber.StatusBadGateway, err.(*fiber.Error).Code)
+		assert.Equal(t, enum.UploadSessionStatusActive, stored.Status)
+		assert.Empty(t, stored.PhotoId)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Upload of another photographer", func(t *testing.T) {
+		newSession(0)
+		mock.ExpectBegin()
+		expectFind(1)
+		mock.ExpectRollback()
+
+		_, err := photoUC.UploadChunk(ctx, &model.UploadChunkRequest{UploadId: "upload-1", CreatorId: "creator-2", Offset: 0, Data: data[:4]})
+		assert.Error(t, err)
+		assert.Equal(t, fiber.StatusNotFound, err.(*fiber.Error).Code)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -3,12 +3,14 @@ package main
 import (
 	"be-yourmoments/user-svc/internal/adapter"
 	"be-yourmoments/user-svc/internal/config"
+	grpcHandler "be-yourmoments/user-svc/internal/delivery/grpc"
 	http "be-yourmoments/user-svc/internal/delivery/http/controller"
 	"be-yourmoments/user-svc/internal/delivery/http/middleware"
 	"be-yourmoments/user-svc/internal/delivery/http/route"
 	"be-yourmoments/user-svc/internal/helper"
 	"be-yourmoments/user-svc/internal/helper/discovery"
 	"log"
+	"net"
 	"os"
 	"os/signal"
 	"syscall"
@@ -22,6 +24,9 @@ import (
 	"fmt"
 	"strconv"
 	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
 )
 
 var logs = logger.New("main")
@@ -40,19 +45,19 @@ func webServer() error {
 		return err
 	}
 
-	// GRPCserviceID := discovery.GenerateServiceID(serverConfig.Name + "-grpc")
+	GRPCserviceID := discovery.GenerateServiceID(serverConfig.Name + "-grpc")
 	HTTPserviceID := discovery.GenerateServiceID(serverConfig.Name + "-http")
 
-	// grpcPortInt, _ := strconv.Atoi(serverConfig.GRPCPort)
+	grpcPortInt, _ := strconv.Atoi(serverConfig.GRPCPort)
 	httpPortInt, _ := strconv.Atoi(serverConfig.HTTPPort)
 
 	ctx := context.Background()
 
-	// err = registry.RegisterService(ctx, serverConfig.Name+"-grpc", GRPCserviceID, serverConfig.GRPCAddr, grpcPortInt, []string{"grpc"})
-	// if err != nil {
-	// 	logs.Error("Failed to register gRPC book service to consul")
-	// 	return err
-	// }
+	err = registry.RegisterService(ctx, serverConfig.Name+"-grpc", GRPCserviceID, serverConfig.GRPCAddr, grpcPortInt, []string{"grpc"})
+	if err != nil {
+		logs.Error("Failed to register gRPC user service to consul")
+		return err
+	}
 
 	err = registry.RegisterService(ctx, serverConfig.Name+"-http", HTTPserviceID, serverConfig.HTTPAddr, httpPortInt, []string{"http"})
 	if err != nil {
@@ -60,25 +65,25 @@ func webServer() error {
 		return err
 	}
 
-	// go func() {
-	// 	failureCount := 0
-	// 	const maxFailures = 5
-	// 	for {
-	// 		err := registry.HealthCheck(GRPCserviceID, serverConfig.Name+"-grpc")
-	// 		if err != nil {
-	// 			logs.Error(fmt.Sprintf("Failed to perform health check for gRPC service: %v", err))
-	// 			failureCount++
-	// 			if failureCount >= maxFailures {
-	// 				logs.Error("Max health check failures reached for gRPC service. Exiting health check loop.")
-	// 				break
-	// 			}
-	// 		} else {
-	// 			failureCount = 0
-	// 		}
-	// 		time.Sleep(time.Second * 2)
-	// 	}
-	// }()
-	// defer registry.DeregisterService(ctx, GRPCserviceID)
+	go func() {
+		failureCount := 0
+		const maxFailures = 5
+		for {
+			err := registry.HealthCheck(GRPCserviceID, serverConfig.Name+"-grpc")
+			if err != nil {
+				logs.Error(fmt.Sprintf("Failed to perform health check for gRPC service: %v", err))
+				failureCount++
+				if failureCount >= maxFailures {
+					logs.Error("Max health check failures reached for gRPC service. Exiting health check loop.")
+					break
+				}
+			} else {
+				failureCount = 0
+			}
+			time.Sleep(time.Second * 2)
+		}
+	}()
+	defer registry.DeregisterService(ctx, GRPCserviceID)
 
 	go func() {
 		failureCount := 0
@@ -107,6 +112,11 @@ func webServer() error {
 	securityAdapter := adapter.NewSecurityAdapter()
 	uploadAdapter := adapter.NewUploadAdapter(minioConfig)
 	customValidator := helper.NewCustomValidator()
+	photoAdapter, err := adapter.NewPhotoAdapter(ctx, registry)
+	if err != nil {
+		logs.Error("Failed to connect to photo service" + err.Error())
+		return err
+	}
 
 	logs.Log(fmt.Sprintf("Succsess connected http service at port: %v", serverConfig.HTTP))
 
@@ -134,7 +144,8 @@ func webServer() error {
 
 	authUseCase := usecase.NewAuthUseCase(dbConfig, userRepository, userProfileRepository, emailVerificationRepository, resetPasswordRepository,
 		googleTokenAdapter, emailAdapter, jwtAdapter, securityAdapter, cacheAdapter)
-	userUseCase := usecase.NewUserUseCase(dbConfig, userRepository, userProfileRepository, userImageRepository, uploadAdapter)
+	userUseCase := usecase.NewUserUseCase(dbConfig, userRepository, userProfileRepository, userImageRepository, uploadAdapter,
+		photoAdapter)
 
 	authController := http.NewAuthController(authUseCase, customValidator)
 	userController := http.NewUserController(userUseCase, customValidator)
@@ -150,23 +161,24 @@ func webServer() error {
 
 	routeConfig.Setup()
 
-	// go func() {
-	// 	grpcServer := grpc.NewServer()
-	// 	reflection.Register(grpcServer)
+	go func() {
+		grpcServer := grpc.NewServer()
+		reflection.Register(grpcServer)
 
-	// 	l, err := net.Listen("tcp", serverConfig.GRPC)
-	// 	if err != nil {
-	// 		logs.Error(fmt.Sprintf("Failed to listen: %v", err))
-	// 	}
-	// 	logs.Log(fmt.Sprintf("gRPC server started on %s", serverConfig.GRPC))
-	// 	defer l.Close()
+		l, err := net.Listen("tcp", serverConfig.GRPC)
+		if err != nil {
+			logs.Error(fmt.Sprintf("Failed to listen: %v", err))
+			return
+		}
+		logs.Log(fmt.Sprintf("gRPC server started on %s", serverConfig.GRPC))
+		defer l.Close()
 
-	// 	// grpcHandler.NewPhotoGRPCHandler(grpcServer, photoUsecase, faceCamUseCase, userSimilarPhotoUsecase)
+		grpcHandler.NewUserGRPCHandler(grpcServer, authUseCase)
 
-	// 	if err := grpcServer.Serve(l); err != nil {
-	// 		logs.Error(fmt.Sprintf("Failed to start gRPC category server: %v", err))
-	// 	}
-	// }()
+		if err := grpcServer.Serve(l); err != nil {
+			logs.Error(fmt.Sprintf("Failed to start gRPC user server: %v", err))
+		}
+	}()
 
 	// photoController.Route(app)
 	logs.Log(fmt.Sprintf("Succsess connected http service at port: %v", serverConfig.HTTP))
@@ -0,0 +1,10 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+-- photo-svc stores matches with levels 1 to 8, a user can set any of them as their threshold
+ALTER TYPE similarity_level ADD VALUE IF NOT EXISTS '7';
+
+ALTER TYPE similarity_level ADD VALUE IF NOT EXISTS '8';
+
+-- +goose Down
+-- enum values can not be dropped, 7 and 8 are left in place
+SELECT 1;
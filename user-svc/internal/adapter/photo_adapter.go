@@ -0,0 +1,51 @@
+package adapter
+
+import (
+	"be-yourmoments/user-svc/internal/helper/discovery"
+	"be-yourmoments/user-svc/internal/pb"
+	"context"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PhotoAdapter interface {
+	// UpdateUserSimilarity pushes the user's similarity threshold to photo-svc, which filters
+	// the matched photos feed with its own copy.
+	UpdateUserSimilarity(ctx context.Context, userId string, similarity int, updatedAt time.Time) error
+}
+
+type photoAdapter struct {
+	client pb.PhotoServiceClient
+}
+
+func NewPhotoAdapter(ctx context.Context, registry discovery.Registry) (PhotoAdapter, error) {
+	conn, err := discovery.ServiceConnection(ctx, "photo-svc-grpc", registry)
+	if err != nil {
+		return nil, err
+	}
+
+	return &photoAdapter{
+		client: pb.NewPhotoServiceClient(conn),
+	}, nil
+}
+
+func (a *photoAdapter) UpdateUserSimilarity(ctx context.Context, userId string, similarity int, updatedAt time.Time) error {
+	pbRequest := &pb.UpdateUserSimilarityRequest{
+		UserId:     userId,
+		Similarity: int32(similarity),
+		UpdatedAt:  timestamppb.New(updatedAt),
+	}
+
+	res, err := a.client.UpdateUserSimilarity(ctx, pbRequest)
+	if err != nil {
+		return err
+	}
+
+	if res.Status >= 400 || res.Error != "" {
+		return fiber.NewError(int(res.Status), res.Error)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package grpc
+
+import (
+	"be-yourmoments/user-svc/internal/model"
+	"be-yourmoments/user-svc/internal/pb"
+	"be-yourmoments/user-svc/internal/usecase"
+	"context"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type UserGRPCHandler struct {
+	authUseCase usecase.AuthUseCase
+	pb.UnimplementedUserServiceServer
+}
+
+func NewUserGRPCHandler(server *grpc.Server, authUseCase usecase.AuthUseCase) {
+	handler := &UserGRPCHandler{
+		authUseCase: authUseCase,
+	}
+
+	pb.RegisterUserServiceServer(server, handler)
+}
+
+// VerifyToken lets the other services authenticate requests with the access tokens issued here,
+// a token of a user that signed out is rejected like an expired one.
+func (h *UserGRPCHandler) VerifyToken(ctx context.Context, pbReq *pb.VerifyTokenRequest) (*pb.VerifyTokenResponse, error) {
+	auth, err := h.authUseCase.Verify(ctx, &model.VerifyUserRequest{Token: pbReq.GetToken()})
+	if err != nil {
+		return &pb.VerifyTokenResponse{
+			Status: errorStatus(err),
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.VerifyTokenResponse{
+		Status:    http.StatusOK,
+		UserId:    auth.UserId,
+		Username:  auth.Username,
+		Email:     auth.Email,
+		ExpiresAt: timestamppb.New(auth.ExpiresAt),
+	}, nil
+}
+
+// errorStatus keeps 401 for the token errors the usecase reports, anything else failed on our side.
+func errorStatus(err error) int64 {
+	if fiberErr, ok := err.(*fiber.Error); ok {
+		return int64(fiberErr.Code)
+	}
+	return http.StatusInternalServerError
+}
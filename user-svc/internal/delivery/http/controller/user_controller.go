@@ -13,6 +13,7 @@ import (
 type UserController interface {
 	GetUserProfile(ctx *fiber.Ctx) error
 	UpdateUserProfile(ctx *fiber.Ctx) error
+	UpdateSimilarity(ctx *fiber.Ctx) error
 	UpdateUserProfileImage(ctx *fiber.Ctx) error
 	UpdateUserCoverImage(ctx *fiber.Ctx) error
 }
@@ -68,6 +69,34 @@ func (c *userController) UpdateUserProfile(ctx *fiber.Ctx) error {
 	})
 }
 
+func (c *userController) UpdateSimilarity(ctx *fiber.Ctx) error {
+	request := new(model.RequestUpdateSimilarity)
+	if err := ctx.BodyParser(request); err != nil {
+		return fiber.NewError(http.StatusBadRequest, err.Error())
+	}
+
+	auth := middleware.GetUser(ctx)
+	request.UserId = auth.UserId
+
+	if validatonErrs := c.customValidator.ValidateUseCase(request); validatonErrs != nil {
+		return ctx.Status(http.StatusUnprocessableEntity).JSON(model.ValidationErrorResponse{
+			Success: false,
+			Errors:  validatonErrs.GetValidationErrors(),
+			Message: "validation error",
+		})
+	}
+
+	response, err := c.userUseCase.UpdateSimilarity(ctx.Context(), request)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(http.StatusOK).JSON(model.WebResponse[*model.UserProfileResponse]{
+		Success: true,
+		Data:    response,
+	})
+}
+
 func (c *userController) UpdateUserProfileImage(ctx *fiber.Ctx) error {
 
 	userProfId := ctx.Params("userProfId", "")
@@ -8,6 +8,7 @@ func (c *RouteConfig) SetupUserRoute() {
 
 	userRoutes.Get("/profile", c.UserController.GetUserProfile)
 	userRoutes.Put("/profile", c.UserController.UpdateUserProfile)
+	userRoutes.Put("/profile/similarity", c.UserController.UpdateSimilarity)
 	userRoutes.Patch("/profile/:userProfId", c.UserController.UpdateUserProfileImage)
 	userRoutes.Patch("/profile/cover/:userProfId", c.UserController.UpdateUserCoverImage)
 }
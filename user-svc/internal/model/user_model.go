@@ -15,6 +15,13 @@ type RequestUpdateUserProfile struct {
 	Biography string     `json:"biography" validate:"required"`
 }
 
+// RequestUpdateSimilarity sets the lowest similarity level of the photos matched to the user. The
+// levels are the 1 to 8 photo-svc stores its matches with.
+type RequestUpdateSimilarity struct {
+	UserId     string `validate:"required"`
+	Similarity int    `json:"similarity" validate:"required,min=1,max=8"`
+}
+
 type UserProfileResponse struct {
 	Id              string     `json:"id"`
 	UserId          string     `json:"user_id"`
@@ -36,6 +36,7 @@ type UserProfileRepository interface {
 	CreateWithProfileUrl(ctx context.Context, tx Querier, userProfile *entity.UserProfile) (*entity.UserProfile, error)
 	Create(ctx context.Context, tx Querier, userProfile *entity.UserProfile) (*entity.UserProfile, error)
 	Update(ctx context.Context, tx Querier, userProfile *entity.UserProfile) (*entity.UserProfile, error)
+	UpdateSimilarity(ctx context.Context, tx Querier, userProfile *entity.UserProfile) (*entity.UserProfile, error)
 	FindByUserId(ctx context.Context, userId string) (*entity.UserProfile, error)
 
 	// UpdateUserProfileImage(ctx context.Context, tx Querier, userProfile *entity.UserProfile) (*entity.UserProfile, error)
@@ -116,6 +117,18 @@ func (r *userProfileRepository) Update(ctx context.Context, tx Querier, userProf
 	return userProfile, nil
 }
 
+func (r *userProfileRepository) UpdateSimilarity(ctx context.Context, tx Querier, userProfile *entity.UserProfile) (*entity.UserProfile, error) {
+	query := `UPDATE user_profiles set similarity = $1, updated_at = $2 WHERE user_id = $3 RETURNING *`
+
+	row := tx.QueryRowxContext(ctx, query, userProfile.Similarity, userProfile.UpdatedAt, userProfile.UserId)
+	if err := row.StructScan(userProfile); err != nil {
+		log.Println("failed to update user similarity", err)
+		return nil, fmt.Errorf("failed to update user similarity: %w", err)
+	}
+
+	return userProfile, nil
+}
+
 func (r *userProfileRepository) FindByUserId(ctx context.Context, userId string) (*entity.UserProfile, error) {
 	userProfile := new(entity.UserProfile)
 	log.Print(userId)
@@ -684,9 +684,13 @@ func (u *authUseCase) Verify(ctx context.Context, request *model.VerifyUserReque
 	}
 
 	user, err := u.userRepository.FindById(ctx, accessTokenDetail.UserId)
-	if err != nil && errors.Is(sql.ErrNoRows, err) {
-		log.Printf("failed to verify access token : %+v", err)
-		return nil, fiber.ErrUnauthorized
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Printf("failed to verify access token : %+v", err)
+			return nil, fiber.ErrUnauthorized
+		}
+		log.Printf("failed to find user : %+v", err)
+		return nil, fiber.ErrInternalServerError
 	}
 
 	authResponse := &model.AuthResponse{
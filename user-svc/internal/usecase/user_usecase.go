@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"log"
 	"mime/multipart"
+	"strconv"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
@@ -22,6 +23,7 @@ import (
 type UserUseCase interface {
 	GetUserProfile(ctx context.Context, userId string) (*model.UserProfileResponse, error)
 	UpdateUserProfile(ctx context.Context, request *model.RequestUpdateUserProfile) (*model.UserProfileResponse, error)
+	UpdateSimilarity(ctx context.Context, request *model.RequestUpdateSimilarity) (*model.UserProfileResponse, error)
 	UpdateUserProfileImage(ctx context.Context, file *multipart.FileHeader, userProfId string) (bool, error)
 	UpdateUserCoverImage(ctx context.Context, file *multipart.FileHeader, userProfId string) (bool, error)
 }
@@ -32,16 +34,18 @@ type userUseCase struct {
 	userProfileRepository repository.UserProfileRepository
 	userImageRepository   repository.UserImageRepository
 	uploadAdapter         adapter.UploadAdapter
+	photoAdapter          adapter.PhotoAdapter
 }
 
 func NewUserUseCase(db repository.BeginTx, userRepository repository.UserRepository, userProfileRepository repository.UserProfileRepository,
-	userImageRepository repository.UserImageRepository, uploadAdapter adapter.UploadAdapter) UserUseCase {
+	userImageRepository repository.UserImageRepository, uploadAdapter adapter.UploadAdapter, photoAdapter adapter.PhotoAdapter) UserUseCase {
 	return &userUseCase{
 		db:                    db,
 		userRepository:        userRepository,
 		userProfileRepository: userProfileRepository,
 		userImageRepository:   userImageRepository,
 		uploadAdapter:         uploadAdapter,
+		photoAdapter:          photoAdapter,
 	}
 }
 
@@ -76,10 +80,12 @@ func (u *userUseCase) getUserImageUrl(ctx context.Context, userImages *[]*entity
 		if userImage.ImageType == enum.ImageTypeProfile {
 			profileUrl, err = u.uploadAdapter.GetPresignedUrl(ctx, userImage.FileName, userImage.FileKey)
 			if err != nil {
+				return "", "", err
 			}
 		} else if userImage.ImageType == enum.ImageTypeCover {
 			coverUrl, err = u.uploadAdapter.GetPresignedUrl(ctx, userImage.FileName, userImage.FileKey)
 			if err != nil {
+				return "", "", err
 			}
 		}
 	}
@@ -134,6 +140,66 @@ func (u *userUseCase) UpdateUserProfile(ctx context.Context, request *model.Requ
 	return converter.UserProfileToResponse(userProfile, profileUrl, coverUrl), nil
 }
 
+// UpdateSimilarity is only committed once photo-svc has the new threshold, so both services
+// always agree on which matches the user sees.
+func (u *userUseCase) UpdateSimilarity(ctx context.Context, request *model.RequestUpdateSimilarity) (*model.UserProfileResponse, error) {
+	now := time.Now()
+	userProfile := &entity.UserProfile{
+		UserId: request.UserId,
+		Similarity: sql.NullString{
+			String: strconv.Itoa(request.Similarity),
+			Valid:  true,
+		},
+		UpdatedAt: &now,
+	}
+
+	tx, err := u.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	userProfile, err = u.userProfileRepository.UpdateSimilarity(ctx, tx, userProfile)
+	if err != nil {
+		log.Println(err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fiber.ErrNotFound
+		}
+		return nil, err
+	}
+
+	err = u.photoAdapter.UpdateUserSimilarity(ctx, request.UserId, request.Similarity, now)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	// the similarity is already saved at this point, failures below are on our side
+	userImages, err := u.userImageRepository.FindByUserProfId(ctx, userProfile.Id)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		log.Println(err)
+		return nil, fiber.NewError(fiber.StatusInternalServerError, "internal error")
+	}
+
+	profileUrl, coverUrl, err := u.getUserImageUrl(ctx, userImages)
+	if err != nil {
+		log.Println(err)
+		return nil, fiber.NewError(fiber.StatusInternalServerError, "internal error")
+	}
+
+	return converter.UserProfileToResponse(userProfile, profileUrl, coverUrl), nil
+}
+
 func (u *userUseCase) UpdateUserProfileImage(ctx context.Context, file *multipart.FileHeader, userProfId string) (bool, error) {
 	ok, err := u.updateUserImage(ctx, file, userProfId, enum.ImageTypeProfile)
 	if err != nil {